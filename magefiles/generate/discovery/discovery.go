@@ -13,8 +13,18 @@ import (
 )
 
 type ServiceInfo struct {
-	Name    string
-	Methods []MethodInfo
+	Name        string
+	PackageName string
+	Methods     []MethodInfo
+}
+
+// Get the import path of the Go package holding the messages of the service.
+func (s ServiceInfo) ImportPath() string {
+	if s.PackageName == "protos" {
+		return "github.com/mlflow/mlflow-go/pkg/protos"
+	}
+
+	return "github.com/mlflow/mlflow-go/pkg/protos/" + s.PackageName
 }
 
 type MethodInfo struct {
@@ -71,7 +81,11 @@ func GetServiceInfos() ([]ServiceInfo, error) {
 			return nil, fmt.Errorf("service %s not found", service.Name)
 		}
 
-		serviceInfo := ServiceInfo{Name: service.Name, Methods: make([]MethodInfo, 0)}
+		serviceInfo := ServiceInfo{
+			Name:        service.Name,
+			PackageName: service.PackageName,
+			Methods:     make([]MethodInfo, 0),
+		}
 
 		methods := serviceDescriptor.Methods()
 		for mIdx := range methods.Len() {
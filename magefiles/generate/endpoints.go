@@ -33,14 +33,14 @@ var ServiceInfoMap = map[string]ServiceGenerationInfo{
 			"searchRuns",
 			// "listArtifacts",
 			"getMetricHistory",
-			// "getMetricHistoryBulkInterval",
+			"getMetricHistoryBulkInterval",
 			"logBatch",
 			// "logModel",
 			"logInputs",
 			"startTrace",
 			"endTrace",
 			"getTraceInfo",
-			// "searchTraces",
+			"searchTraces",
 			"deleteTraces",
 		},
 	},
@@ -48,40 +48,36 @@ var ServiceInfoMap = map[string]ServiceGenerationInfo{
 		FileNameWithoutExtension: "model_registry",
 		ServiceName:              "ModelRegistryService",
 		ImplementedEndpoints: []string{
-			// "createRegisteredModel",
+			"createRegisteredModel",
 			"renameRegisteredModel",
 			"updateRegisteredModel",
 			"deleteRegisteredModel",
 			"getRegisteredModel",
-			// "searchRegisteredModels",
+			"searchRegisteredModels",
 			"getLatestVersions",
-			// "createModelVersion",
+			"createModelVersion",
 			"updateModelVersion",
-			// "transitionModelVersionStage",
+			"transitionModelVersionStage",
 			"deleteModelVersion",
-			// "getModelVersion",
-			// "searchModelVersions",
-			// "getModelVersionDownloadUri",
-			// "setRegisteredModelTag",
-			// "setModelVersionTag",
-			// "deleteRegisteredModelTag",
-			// "deleteModelVersionTag",
-			// "setRegisteredModelAlias",
-			// "deleteRegisteredModelAlias",
-			// "getModelVersionByAlias",
+			"getModelVersion",
+			"searchModelVersions",
+			"getModelVersionDownloadUri",
+			"setRegisteredModelTag",
+			"setModelVersionTag",
+			"deleteRegisteredModelTag",
+			"deleteModelVersionTag",
+			"setRegisteredModelAlias",
+			"deleteRegisteredModelAlias",
+			"getModelVersionByAlias",
 		},
 	},
 	"MlflowArtifactsService": {
 		FileNameWithoutExtension: "artifacts",
 		ServiceName:              "ArtifactsService",
-		ImplementedEndpoints:     []string{
-			// "downloadArtifact",
-			// "uploadArtifact",
-			// "listArtifacts",
-			// "deleteArtifact",
-			// "createMultipartUpload",
-			// "completeMultipartUpload",
-			// "abortMultipartUpload",
+		// The other endpoints take the artifact path from the URL and may stream their body,
+		// they are registered in pkg/server/routes/artifacts.go.
+		ImplementedEndpoints: []string{
+			"listArtifacts",
 		},
 	},
 }
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
 
 	"github.com/iancoleman/strcase"
 
@@ -257,7 +258,7 @@ func generateServices(
 	if len(endpoints) > 0 {
 		importStatements = []string{
 			`"context"`,
-			`"github.com/mlflow/mlflow-go/pkg/protos"`,
+			strconv.Quote(serviceInfo.ImportPath()),
 			`"github.com/mlflow/mlflow-go/pkg/contract"`,
 		}
 	}
@@ -293,7 +294,7 @@ func generateRouteRegistrations(
 		importStatements = append(
 			importStatements,
 			`"github.com/mlflow/mlflow-go/pkg/utils"`,
-			`"github.com/mlflow/mlflow-go/pkg/protos"`,
+			strconv.Quote(serviceInfo.ImportPath()),
 		)
 	}
 
@@ -351,7 +352,7 @@ func mkCEndpointBody(serviceName string, method discovery.MethodInfo) *ast.Block
 				mkCallExpr(
 					ast.NewIdent("invokeServiceMethod"),
 					mkSelectorExpr("service", strcase.ToCamel(method.Name)),
-					mkCallExpr(ast.NewIdent("new"), mkSelectorExpr("protos", method.Input)),
+					mkCallExpr(ast.NewIdent("new"), mkSelectorExpr(method.PackageName, method.Input)),
 					ast.NewIdent("requestData"),
 					ast.NewIdent("requestSize"),
 					ast.NewIdent("responseSize"),
@@ -421,7 +422,7 @@ func generateEndpoints(
 			decls,
 			mkImportStatements(
 				`"unsafe"`,
-				`"github.com/mlflow/mlflow-go/pkg/protos"`,
+				strconv.Quote(serviceInfo.ImportPath()),
 			),
 		)
 
@@ -1,51 +1,96 @@
 package generate
 
 var validations = map[string]string{
-	"GetExperiment_ExperimentId":         "required,stringAsPositiveInteger",
-	"CreateExperiment_Name":              "required,max=500",
-	"CreateExperiment_ArtifactLocation":  "omitempty,uriWithoutFragmentsOrParamsOrDotDotInQuery",
-	"SearchRuns_RunViewType":             "omitempty",
-	"SearchRuns_MaxResults":              "gt=0,max=50000",
-	"DeleteExperiment_ExperimentId":      "required,stringAsPositiveInteger",
-	"LogParam_Key":                       "required,max=250,validMetricParamOrTagName,pathIsUnique",
-	"LogParam_Value":                     "omitempty,truncate=6000",
-	"LogBatch_RunId":                     "required,runId",
-	"LogBatch_Params":                    "omitempty,uniqueParams,max=100,dive",
-	"LogBatch_Metrics":                   "max=1000,dive",
-	"LogBatch_Tags":                      "max=100",
-	"RunTag_Key":                         "required,max=250,validMetricParamOrTagName,pathIsUnique",
-	"RunTag_Value":                       "omitempty,max=5000",
-	"Param_Key":                          "required,max=250,validMetricParamOrTagName,pathIsUnique",
-	"Param_Value":                        "omitempty,truncate=6000",
-	"Metric_Key":                         "required,max=250,validMetricParamOrTagName,pathIsUnique",
-	"Metric_Timestamp":                   "required",
-	"Metric_Value":                       "required",
-	"CreateRun_ExperimentId":             "required,stringAsPositiveInteger",
-	"GetExperimentByName_ExperimentName": "required",
-	"GetLatestVersions_Name":             "required",
-	"LogMetric_RunId":                    "required",
-	"LogMetric_Key":                      "required",
-	"LogMetric_Value":                    "required",
-	"LogMetric_Timestamp":                "required",
-	"SetTraceTag_Key":                    "required,max=250,validMetricParamOrTagName,pathIsUnique",
-	"SetTraceTag_Value":                  "omitempty,truncate=8000",
-	"DeleteTag_RunId":                    "required",
-	"DeleteTag_Key":                      "required",
-	"SetExperimentTag_ExperimentId":      "required",
-	"SetExperimentTag_Key":               "required,max=250,validMetricParamOrTagName",
-	"SetExperimentTag_Value":             "max=5000",
-	"SearchExperiments_MaxResults":       "positiveNonZeroInteger,max=50000",
-	"SetTag_Key":                         "required,max=1000,validMetricParamOrTagName,pathIsUnique",
-	"SetTag_Value":                       "omitempty,truncate=8000",
-	"LogInputs_RunId":                    "required,runId",
-	"LogInputs_Datasets":                 "required",
-	"DatasetInput_Dataset":               "required",
-	"Dataset_Name":                       "required,max=500",
-	"Dataset_Digest":                     "required,max=36",
-	"Dataset_SourceType":                 "required",
-	"Dataset_Source":                     "required,max=65535",
-	"Dataset_Profile":                    "max:16777215",
-	"Dataset_Schema":                     "max:1048575",
-	"InputTag_Key":                       "required,max=255",
-	"InputTag_Value":                     "required,max=500",
+	"GetExperiment_ExperimentId":              "required,stringAsPositiveInteger",
+	"CreateExperiment_Name":                   "required,max=500",
+	"CreateExperiment_ArtifactLocation":       "omitempty,uriWithoutFragmentsOrParamsOrDotDotInQuery",
+	"SearchRuns_RunViewType":                  "omitempty",
+	"SearchRuns_MaxResults":                   "gt=0,max=50000",
+	"DeleteExperiment_ExperimentId":           "required,stringAsPositiveInteger",
+	"LogParam_Key":                            "required,max=250,validMetricParamOrTagName,pathIsUnique",
+	"LogParam_Value":                          "omitempty,truncate=6000",
+	"LogBatch_RunId":                          "required,runId",
+	"LogBatch_Params":                         "omitempty,uniqueParams,max=100,dive",
+	"LogBatch_Metrics":                        "max=1000,dive",
+	"LogBatch_Tags":                           "max=100",
+	"RunTag_Key":                              "required,max=250,validMetricParamOrTagName,pathIsUnique",
+	"RunTag_Value":                            "omitempty,max=5000",
+	"Param_Key":                               "required,max=250,validMetricParamOrTagName,pathIsUnique",
+	"Param_Value":                             "omitempty,truncate=6000",
+	"Metric_Key":                              "required,max=250,validMetricParamOrTagName,pathIsUnique",
+	"Metric_Timestamp":                        "required",
+	"Metric_Value":                            "required",
+	"CreateRun_ExperimentId":                  "required,stringAsPositiveInteger",
+	"GetExperimentByName_ExperimentName":      "required",
+	"GetLatestVersions_Name":                  "required",
+	"CreateRegisteredModel_Name":              "required",
+	"CreateRegisteredModel_Tags":              "omitempty,dive",
+	"RegisteredModelTag_Key":                  "required,max=250,validMetricParamOrTagName,pathIsUnique",
+	"RegisteredModelTag_Value":                "omitempty,max=5000",
+	"SetRegisteredModelTag_Name":              "required",
+	"SetRegisteredModelTag_Key":               "required,max=250,validMetricParamOrTagName,pathIsUnique",
+	"SetRegisteredModelTag_Value":             "omitempty,max=5000",
+	"DeleteRegisteredModelTag_Name":           "required",
+	"DeleteRegisteredModelTag_Key":            "required",
+	"CreateModelVersion_Name":                 "required",
+	"CreateModelVersion_Source":               "required",
+	"CreateModelVersion_Tags":                 "omitempty,dive",
+	"ModelVersionTag_Key":                     "required,max=250,validMetricParamOrTagName,pathIsUnique",
+	"ModelVersionTag_Value":                   "omitempty,max=5000",
+	"GetModelVersion_Name":                    "required",
+	"GetModelVersion_Version":                 "required,stringAsPositiveInteger",
+	"GetModelVersionDownloadUri_Name":         "required",
+	"GetModelVersionDownloadUri_Version":      "required,stringAsPositiveInteger",
+	"TransitionModelVersionStage_Name":        "required",
+	"TransitionModelVersionStage_Version":     "required,stringAsPositiveInteger",
+	"TransitionModelVersionStage_Stage":       "required",
+	"SetModelVersionTag_Name":                 "required",
+	"SetModelVersionTag_Version":              "required,stringAsPositiveInteger",
+	"SetModelVersionTag_Key":                  "required,max=250,validMetricParamOrTagName,pathIsUnique",
+	"SetModelVersionTag_Value":                "omitempty,max=5000",
+	"DeleteModelVersionTag_Name":              "required",
+	"DeleteModelVersionTag_Version":           "required,stringAsPositiveInteger",
+	"DeleteModelVersionTag_Key":               "required",
+	"SetRegisteredModelAlias_Name":            "required",
+	"SetRegisteredModelAlias_Version":         "required,stringAsPositiveInteger",
+	"DeleteRegisteredModelAlias_Name":         "required",
+	"GetModelVersionByAlias_Name":             "required",
+	"LogMetric_RunId":                         "required",
+	"LogMetric_Key":                           "required",
+	"LogMetric_Value":                         "required",
+	"LogMetric_Timestamp":                     "required",
+	"SetTraceTag_Key":                         "required,max=250,validMetricParamOrTagName,pathIsUnique",
+	"SetTraceTag_Value":                       "omitempty,truncate=8000",
+	"DeleteTag_RunId":                         "required",
+	"DeleteTag_Key":                           "required",
+	"SetExperimentTag_ExperimentId":           "required",
+	"SetExperimentTag_Key":                    "required,max=250,validMetricParamOrTagName",
+	"SetExperimentTag_Value":                  "max=5000",
+	"SearchExperiments_MaxResults":            "positiveNonZeroInteger,max=50000",
+	"SetTag_Key":                              "required,max=1000,validMetricParamOrTagName,pathIsUnique",
+	"SetTag_Value":                            "omitempty,truncate=8000",
+	"LogInputs_RunId":                         "required,runId",
+	"LogInputs_Datasets":                      "required",
+	"DatasetInput_Dataset":                    "required",
+	"Dataset_Name":                            "required,max=500",
+	"Dataset_Digest":                          "required,max=36",
+	"Dataset_SourceType":                      "required",
+	"Dataset_Source":                          "required,max=65535",
+	"Dataset_Profile":                         "max:16777215",
+	"Dataset_Schema":                          "max:1048575",
+	"InputTag_Key":                            "required,max=255",
+	"InputTag_Value":                          "required,max=500",
+	"SearchTraces_ExperimentIds":              "required",
+	"SearchTraces_MaxResults":                 "omitempty,gt=0,max=500",
+	"CreateMultipartUpload_Path":              "required",
+	"CreateMultipartUpload_NumParts":          "required,gt=0,max=10000",
+	"CompleteMultipartUpload_Path":            "required",
+	"CompleteMultipartUpload_UploadId":        "required",
+	"AbortMultipartUpload_Path":               "required",
+	"AbortMultipartUpload_UploadId":           "required",
+	"ListArtifacts_Path":                      "omitempty,pathIsUnique",
+	"GetMetricHistory_MaxResults":             "omitempty,gt=0,max=25000",
+	"GetMetricHistoryBulkInterval_RunIds":     "required,max=100",
+	"GetMetricHistoryBulkInterval_MetricKey":  "required",
+	"GetMetricHistoryBulkInterval_MaxResults": "omitempty,gt=0,max=2500",
 }
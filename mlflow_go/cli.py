@@ -1,4 +1,5 @@
 import json
+import os
 import pathlib
 import shlex
 
@@ -63,19 +64,36 @@ def _get_commands():
         tracking_store_uri = kwargs["backend_store_uri"]
         config = {
             "address": f'{kwargs["host"]}:{kwargs["port"]}',
+            "artifacts_destination": kwargs["artifacts_destination"]
+            if kwargs["serve_artifacts"]
+            else "",
+            "artifacts_upload_read_timeout": opts.get("artifacts_upload_read_timeout", "1h"),
+            "database_connection_max_lifetime": opts.get("database_connection_max_lifetime", "0s"),
+            "database_max_idle_connections": int(opts.get("database_max_idle_connections", 0)),
+            "database_max_open_connections": int(opts.get("database_max_open_connections", 0)),
+            "database_max_retries": int(opts.get("database_max_retries", 0)),
+            "database_retry_backoff": opts.get("database_retry_backoff", "0s"),
+            "database_statement_timeout": opts.get("database_statement_timeout", "0s"),
             "default_artifact_root": mlflow.cli.resolve_default_artifact_root(
                 kwargs["serve_artifacts"], kwargs["default_artifact_root"], tracking_store_uri
             ),
             "log_level": opts.get("log_level", "DEBUG" if kwargs["dev"] else "INFO"),
             "python_address": python_address,
             "python_command": python_command,
+            "s3_endpoint_url": os.environ.get("MLFLOW_S3_ENDPOINT_URL", ""),
+            "schema_mismatch": opts.get("schema_mismatch", "fail"),
+            "search_runs_keyset_pagination": opts.get("search_runs_keyset_pagination") == "true",
             "shutdown_timeout": opts.get("shutdown_timeout", "1m"),
             "static_folder": pathlib.Path(mlflow.server.__file__)
             .parent.joinpath(mlflow.server.REL_STATIC_DIR)
             .resolve()
             .as_posix(),
             "tracking_store_uri": tracking_store_uri,
+            "tracking_store_read_replica_uri": opts.get("tracking_store_read_replica_uri", ""),
             "model_registry_store_uri": kwargs["registry_store_uri"] or tracking_store_uri,
+            "model_registry_store_read_replica_uri": opts.get(
+                "model_registry_store_read_replica_uri", ""
+            ),
             "version": mlflow.version.VERSION,
         }
         config_bytes = json.dumps(config).encode("utf-8")
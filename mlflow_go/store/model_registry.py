@@ -3,14 +3,29 @@ import logging
 
 from mlflow.entities.model_registry import ModelVersion, RegisteredModel
 from mlflow.protos.model_registry_pb2 import (
+    CreateModelVersion,
+    CreateRegisteredModel,
     DeleteModelVersion,
+    DeleteModelVersionTag,
     DeleteRegisteredModel,
+    DeleteRegisteredModelAlias,
+    DeleteRegisteredModelTag,
     GetLatestVersions,
+    GetModelVersion,
+    GetModelVersionByAlias,
+    GetModelVersionDownloadUri,
     GetRegisteredModel,
     RenameRegisteredModel,
+    SearchModelVersions,
+    SearchRegisteredModels,
+    SetModelVersionTag,
+    SetRegisteredModelAlias,
+    SetRegisteredModelTag,
+    TransitionModelVersionStage,
     UpdateModelVersion,
     UpdateRegisteredModel,
 )
+from mlflow.store.entities import PagedList
 
 from mlflow_go import is_go_enabled
 from mlflow_go.lib import get_lib
@@ -35,6 +50,17 @@ class _ModelRegistryStore:
         if hasattr(self, "service"):
             get_lib().DestroyModelRegistryService(self.service.id)
 
+    def create_registered_model(self, name, tags=None, description=None):
+        request = CreateRegisteredModel(
+            name=name,
+            tags=[tag.to_proto() for tag in tags] if tags else [],
+            description=description,
+        )
+        response = self.service.call_endpoint(
+            get_lib().ModelRegistryServiceCreateRegisteredModel, request
+        )
+        return RegisteredModel.from_proto(response.registered_model)
+
     def get_latest_versions(self, name, stages=None):
         request = GetLatestVersions(
             name=name,
@@ -63,6 +89,21 @@ class _ModelRegistryStore:
         request = DeleteRegisteredModel(name=name)
         self.service.call_endpoint(get_lib().ModelRegistryServiceDeleteRegisteredModel, request)
 
+    def search_registered_models(
+        self, filter_string=None, max_results=None, order_by=None, page_token=None
+    ):
+        request = SearchRegisteredModels(
+            filter=filter_string,
+            max_results=max_results,
+            order_by=order_by,
+            page_token=page_token,
+        )
+        response = self.service.call_endpoint(
+            get_lib().ModelRegistryServiceSearchRegisteredModels, request
+        )
+        registered_models = [RegisteredModel.from_proto(rm) for rm in response.registered_models]
+        return PagedList(registered_models, (response.next_page_token or None))
+
     def get_registered_model(self, name):
         request = GetRegisteredModel(name=name)
         response = self.service.call_endpoint(
@@ -81,6 +122,14 @@ class _ModelRegistryStore:
 
         return entity
 
+    def set_registered_model_tag(self, name, tag):
+        request = SetRegisteredModelTag(name=name, key=tag.key, value=tag.value)
+        self.service.call_endpoint(get_lib().ModelRegistryServiceSetRegisteredModelTag, request)
+
+    def delete_registered_model_tag(self, name, key):
+        request = DeleteRegisteredModelTag(name=name, key=key)
+        self.service.call_endpoint(get_lib().ModelRegistryServiceDeleteRegisteredModelTag, request)
+
     def delete_model_version(self, name, version):
         request = DeleteModelVersion(name=name, version=str(version))
         self.service.call_endpoint(get_lib().ModelRegistryServiceDeleteModelVersion, request)
@@ -89,6 +138,95 @@ class _ModelRegistryStore:
         request = UpdateModelVersion(name=name, version=str(version), description=description)
         self.service.call_endpoint(get_lib().ModelRegistryServiceUpdateModelVersion, request)
 
+    def create_model_version(
+        self,
+        name,
+        source,
+        run_id=None,
+        tags=None,
+        run_link=None,
+        description=None,
+        local_model_path=None,
+    ):
+        request = CreateModelVersion(
+            name=name,
+            source=source,
+            run_id=run_id,
+            tags=[tag.to_proto() for tag in tags] if tags else [],
+            run_link=run_link,
+            description=description,
+        )
+        response = self.service.call_endpoint(
+            get_lib().ModelRegistryServiceCreateModelVersion, request
+        )
+        return ModelVersion.from_proto(response.model_version)
+
+    def get_model_version(self, name, version):
+        request = GetModelVersion(name=name, version=str(version))
+        response = self.service.call_endpoint(
+            get_lib().ModelRegistryServiceGetModelVersion, request
+        )
+        return ModelVersion.from_proto(response.model_version)
+
+    def get_model_version_download_uri(self, name, version):
+        request = GetModelVersionDownloadUri(name=name, version=str(version))
+        response = self.service.call_endpoint(
+            get_lib().ModelRegistryServiceGetModelVersionDownloadUri, request
+        )
+        return response.artifact_uri
+
+    def search_model_versions(
+        self, filter_string=None, max_results=None, order_by=None, page_token=None
+    ):
+        request = SearchModelVersions(
+            filter=filter_string,
+            max_results=max_results,
+            order_by=order_by,
+            page_token=page_token,
+        )
+        response = self.service.call_endpoint(
+            get_lib().ModelRegistryServiceSearchModelVersions, request
+        )
+        model_versions = [ModelVersion.from_proto(mv) for mv in response.model_versions]
+        return PagedList(model_versions, (response.next_page_token or None))
+
+    def transition_model_version_stage(self, name, version, stage, archive_existing_versions):
+        request = TransitionModelVersionStage(
+            name=name,
+            version=str(version),
+            stage=stage,
+            archive_existing_versions=archive_existing_versions,
+        )
+        response = self.service.call_endpoint(
+            get_lib().ModelRegistryServiceTransitionModelVersionStage, request
+        )
+        return ModelVersion.from_proto(response.model_version)
+
+    def set_model_version_tag(self, name, version, tag):
+        request = SetModelVersionTag(name=name, version=str(version), key=tag.key, value=tag.value)
+        self.service.call_endpoint(get_lib().ModelRegistryServiceSetModelVersionTag, request)
+
+    def delete_model_version_tag(self, name, version, key):
+        request = DeleteModelVersionTag(name=name, version=str(version), key=key)
+        self.service.call_endpoint(get_lib().ModelRegistryServiceDeleteModelVersionTag, request)
+
+    def set_registered_model_alias(self, name, alias, version):
+        request = SetRegisteredModelAlias(name=name, alias=alias, version=str(version))
+        self.service.call_endpoint(get_lib().ModelRegistryServiceSetRegisteredModelAlias, request)
+
+    def delete_registered_model_alias(self, name, alias):
+        request = DeleteRegisteredModelAlias(name=name, alias=alias)
+        self.service.call_endpoint(
+            get_lib().ModelRegistryServiceDeleteRegisteredModelAlias, request
+        )
+
+    def get_model_version_by_alias(self, name, alias):
+        request = GetModelVersionByAlias(name=name, alias=alias)
+        response = self.service.call_endpoint(
+            get_lib().ModelRegistryServiceGetModelVersionByAlias, request
+        )
+        return ModelVersion.from_proto(response.model_version)
+
 
 def ModelRegistryStore(cls):
     return type(cls.__name__, (_ModelRegistryStore, cls), {})
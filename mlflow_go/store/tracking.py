@@ -35,6 +35,7 @@ from mlflow.protos.service_pb2 import (
     RestoreRun,
     SearchExperiments,
     SearchRuns,
+    SearchTraces,
     SetTag,
     SetTraceTag,
     StartTrace,
@@ -297,6 +298,30 @@ class _TrackingStore:
             entity.execution_time_ms = None
         return entity
 
+    def search_traces(
+        self,
+        experiment_ids: list[str],
+        filter_string: Optional[str] = None,
+        max_results: int = 100,
+        order_by: Optional[list[str]] = None,
+        page_token: Optional[str] = None,
+    ):
+        request = SearchTraces(
+            experiment_ids=experiment_ids,
+            filter=filter_string,
+            max_results=max_results,
+            order_by=order_by,
+            page_token=page_token,
+        )
+        response = self.service.call_endpoint(get_lib().TrackingServiceSearchTraces, request)
+        traces = []
+        for trace_info in response.traces:
+            entity = TraceInfo.from_proto(trace_info)
+            if entity.execution_time_ms == 0:
+                entity.execution_time_ms = None
+            traces.append(entity)
+        return traces, (response.next_page_token or None)
+
     def delete_traces(
         self,
         experiment_id: str,
@@ -318,7 +343,10 @@ class _TrackingStore:
             run_id=run_id, metric_key=metric_key, max_results=max_results, page_token=page_token
         )
         response = self.service.call_endpoint(get_lib().TrackingServiceGetMetricHistory, request)
-        return PagedList([Metric.from_proto(metric) for metric in response.metrics], None)
+        return PagedList(
+            [Metric.from_proto(metric) for metric in response.metrics],
+            (response.next_page_token or None),
+        )
 
 
 def TrackingStore(cls):
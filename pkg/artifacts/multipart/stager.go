@@ -0,0 +1,349 @@
+package multipart
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec // only used as ETag, like S3 does.
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/utils"
+)
+
+const (
+	// Same upper bound as S3.
+	MaxParts = 10000
+
+	directoryPermissions = 0o700
+	filePermissions      = 0o600
+	uploadFileName       = "upload.json"
+)
+
+var uploadIDRegex = regexp.MustCompile(`^[0-9a-f]{32}$`)
+
+type upload struct {
+	Path     string `json:"path"`
+	NumParts int64  `json:"num_parts"`
+}
+
+type Part struct {
+	PartNumber int64
+	ETag       string
+}
+
+// Stager keeps the parts of multipart uploads on local disk until they are completed or aborted.
+// Every upload lives in its own directory below dir, named after the upload ID.
+type Stager struct {
+	dir string
+	ttl time.Duration
+}
+
+func NewStager(dir string, ttl time.Duration) (*Stager, error) {
+	if err := os.MkdirAll(dir, directoryPermissions); err != nil {
+		return nil, fmt.Errorf("failed to create multipart upload directory %q: %w", dir, err)
+	}
+
+	return &Stager{dir: dir, ttl: ttl}, nil
+}
+
+func newUploadNotFoundError(uploadID string) *contract.Error {
+	return contract.NewError(
+		protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+		fmt.Sprintf("Multipart upload %q does not exist", uploadID),
+	)
+}
+
+func (s Stager) uploadDir(uploadID string) string {
+	return filepath.Join(s.dir, uploadID)
+}
+
+func (s Stager) partFile(uploadID string, partNumber int64) string {
+	return filepath.Join(s.uploadDir(uploadID), "part-"+strconv.FormatInt(partNumber, 10))
+}
+
+func (s Stager) getUpload(uploadID string) (*upload, *contract.Error) {
+	if !uploadIDRegex.MatchString(uploadID) {
+		return nil, newUploadNotFoundError(uploadID)
+	}
+
+	content, err := os.ReadFile(filepath.Join(s.uploadDir(uploadID), uploadFileName))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, newUploadNotFoundError(uploadID)
+		}
+
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to read multipart upload %q", uploadID),
+			err,
+		)
+	}
+
+	var result upload
+	if err := json.Unmarshal(content, &result); err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to read multipart upload %q", uploadID),
+			err,
+		)
+	}
+
+	return &result, nil
+}
+
+// Create registers a new upload of numParts parts for the artifact at path and returns its ID.
+func (s Stager) Create(path string, numParts int64) (string, *contract.Error) {
+	if numParts < 1 || numParts > MaxParts {
+		return "", contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("num_parts must be between 1 and %d, got %d", MaxParts, numParts),
+		)
+	}
+
+	uploadID := utils.NewUUID()
+
+	if err := os.Mkdir(s.uploadDir(uploadID), directoryPermissions); err != nil {
+		return "", contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR, "failed to create multipart upload", err,
+		)
+	}
+
+	content, err := json.Marshal(upload{Path: path, NumParts: numParts})
+	if err != nil {
+		return "", contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR, "failed to create multipart upload", err,
+		)
+	}
+
+	if err := os.WriteFile(
+		filepath.Join(s.uploadDir(uploadID), uploadFileName), content, filePermissions,
+	); err != nil {
+		return "", contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR, "failed to create multipart upload", err,
+		)
+	}
+
+	return uploadID, nil
+}
+
+// WritePart stores one part of an upload and returns its ETag.
+// Uploading the same part twice replaces the previous content.
+func (s Stager) WritePart(uploadID string, partNumber int64, reader io.Reader) (string, *contract.Error) {
+	upload, contractError := s.getUpload(uploadID)
+	if contractError != nil {
+		return "", contractError
+	}
+
+	if partNumber < 1 || partNumber > upload.NumParts {
+		return "", contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("part_number must be between 1 and %d, got %d", upload.NumParts, partNumber),
+		)
+	}
+
+	file, err := os.CreateTemp(s.uploadDir(uploadID), ".part-*")
+	if err != nil {
+		return "", contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR, fmt.Sprintf("failed to write part %d", partNumber), err,
+		)
+	}
+	defer os.Remove(file.Name())
+
+	hash := md5.New() //nolint:gosec
+
+	_, err = io.Copy(io.MultiWriter(file, hash), reader)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err == nil {
+		err = os.Rename(file.Name(), s.partFile(uploadID, partNumber))
+	}
+
+	if err != nil {
+		return "", contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR, fmt.Sprintf("failed to write part %d", partNumber), err,
+		)
+	}
+
+	return strconv.Quote(hex.EncodeToString(hash.Sum(nil))), nil
+}
+
+type partsReader struct {
+	io.Reader
+	files []*os.File
+}
+
+func (r partsReader) Close() error {
+	errs := make([]error, 0, len(r.files))
+	for _, file := range r.files {
+		errs = append(errs, file.Close())
+	}
+
+	return errors.Join(errs...)
+}
+
+// Open checks that parts describe every part of the upload of path, in order and with matching ETags,
+// and returns a reader over the assembled artifact.
+//
+//nolint:funlen
+func (s Stager) Open(uploadID, path string, parts []Part) (io.ReadCloser, *contract.Error) {
+	upload, contractError := s.getUpload(uploadID)
+	if contractError != nil {
+		return nil, contractError
+	}
+
+	if upload.Path != path {
+		return nil, newUploadNotFoundError(uploadID)
+	}
+
+	if int64(len(parts)) != upload.NumParts {
+		return nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("expected %d parts, got %d", upload.NumParts, len(parts)),
+		)
+	}
+
+	reader := partsReader{files: make([]*os.File, 0, len(parts))}
+	readers := make([]io.Reader, 0, len(parts))
+
+	for index, part := range parts {
+		if part.PartNumber != int64(index+1) {
+			reader.Close()
+
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("parts must be ordered by part_number, got %d at position %d", part.PartNumber, index+1),
+			)
+		}
+
+		file, err := os.Open(s.partFile(uploadID, part.PartNumber))
+		if err != nil {
+			reader.Close()
+
+			return nil, contract.NewErrorWith(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("part %d has not been uploaded", part.PartNumber),
+				err,
+			)
+		}
+
+		reader.files = append(reader.files, file)
+
+		hash := md5.New() //nolint:gosec
+		if _, err := io.Copy(hash, file); err != nil {
+			reader.Close()
+
+			return nil, contract.NewErrorWith(
+				protos.ErrorCode_INTERNAL_ERROR, fmt.Sprintf("failed to read part %d", part.PartNumber), err,
+			)
+		}
+
+		// Like S3, accept the ETag with or without the surrounding quotes.
+		etag := hex.EncodeToString(hash.Sum(nil))
+		if part.ETag != "" && strings.Trim(part.ETag, `"`) != etag {
+			reader.Close()
+
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("etag of part %d does not match, expected %q", part.PartNumber, etag),
+			)
+		}
+
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			reader.Close()
+
+			return nil, contract.NewErrorWith(
+				protos.ErrorCode_INTERNAL_ERROR, fmt.Sprintf("failed to read part %d", part.PartNumber), err,
+			)
+		}
+
+		readers = append(readers, file)
+	}
+
+	reader.Reader = io.MultiReader(readers...)
+
+	return reader, nil
+}
+
+// Remove deletes the upload and all of its parts.
+func (s Stager) Remove(uploadID, path string) *contract.Error {
+	upload, contractError := s.getUpload(uploadID)
+	if contractError != nil {
+		return contractError
+	}
+
+	if upload.Path != path {
+		return newUploadNotFoundError(uploadID)
+	}
+
+	if err := os.RemoveAll(s.uploadDir(uploadID)); err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to remove multipart upload %q", uploadID),
+			err,
+		)
+	}
+
+	return nil
+}
+
+// Cleanup removes the uploads that haven't been touched for longer than the TTL.
+func (s Stager) Cleanup(ctx context.Context) {
+	logger := utils.GetLoggerFromContext(ctx)
+
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		logger.Errorf("Failed to list multipart uploads in %q: %v", s.dir, err)
+
+		return
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() || !uploadIDRegex.MatchString(entry.Name()) {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil || time.Since(info.ModTime()) < s.ttl {
+			continue
+		}
+
+		logger.Debugf("Removing expired multipart upload %s", entry.Name())
+
+		if err := os.RemoveAll(filepath.Join(s.dir, entry.Name())); err != nil {
+			logger.Errorf("Failed to remove expired multipart upload %s: %v", entry.Name(), err)
+		}
+	}
+}
+
+// RunCleanup calls Cleanup periodically until ctx is done.
+func (s Stager) RunCleanup(ctx context.Context) {
+	interval := s.ttl
+	if interval > time.Hour {
+		interval = time.Hour
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.Cleanup(ctx)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package multipart_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go/pkg/artifacts/multipart"
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+)
+
+func TestStager(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	stager, err := multipart.NewStager(dir, time.Hour)
+	require.NoError(t, err)
+
+	uploadID, contractErr := stager.Create("model/weights.bin", 2)
+	require.Nil(t, contractErr)
+
+	// Parts can be uploaded in any order.
+	etag2, contractErr := stager.WritePart(uploadID, 2, strings.NewReader("world"))
+	require.Nil(t, contractErr)
+	etag1, contractErr := stager.WritePart(uploadID, 1, strings.NewReader("hello "))
+	require.Nil(t, contractErr)
+
+	_, contractErr = stager.WritePart(uploadID, 3, strings.NewReader("!"))
+	require.NotNil(t, contractErr)
+	require.Equal(t, contract.ErrorCode(protos.ErrorCode_INVALID_PARAMETER_VALUE), contractErr.Code)
+
+	_, contractErr = stager.Open(uploadID, "model/weights.bin", []multipart.Part{
+		{PartNumber: 1, ETag: etag2},
+		{PartNumber: 2, ETag: etag2},
+	})
+	require.NotNil(t, contractErr)
+	require.Equal(t, contract.ErrorCode(protos.ErrorCode_INVALID_PARAMETER_VALUE), contractErr.Code)
+
+	_, contractErr = stager.Open(uploadID, "other.bin", nil)
+	require.NotNil(t, contractErr)
+	require.Equal(t, contract.ErrorCode(protos.ErrorCode_RESOURCE_DOES_NOT_EXIST), contractErr.Code)
+
+	reader, contractErr := stager.Open(uploadID, "model/weights.bin", []multipart.Part{
+		{PartNumber: 1, ETag: etag1},
+		{PartNumber: 2, ETag: strings.Trim(etag2, `"`)},
+	})
+	require.Nil(t, contractErr)
+
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	require.Equal(t, "hello world", string(content))
+
+	require.Nil(t, stager.Remove(uploadID, "model/weights.bin"))
+
+	_, contractErr = stager.WritePart(uploadID, 1, strings.NewReader("hello "))
+	require.NotNil(t, contractErr)
+	require.Equal(t, contract.ErrorCode(protos.ErrorCode_RESOURCE_DOES_NOT_EXIST), contractErr.Code)
+}
+
+func TestStagerCleanup(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	stager, err := multipart.NewStager(dir, time.Minute)
+	require.NoError(t, err)
+
+	expiredID, contractErr := stager.Create("expired.bin", 1)
+	require.Nil(t, contractErr)
+
+	activeID, contractErr := stager.Create("active.bin", 1)
+	require.Nil(t, contractErr)
+
+	past := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(dir+"/"+expiredID, past, past))
+
+	stager.Cleanup(context.Background())
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, activeID, entries[0].Name())
+}
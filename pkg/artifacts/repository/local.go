@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/entities"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+)
+
+const (
+	localDirectoryPermissions = 0o755
+	// localFilePermissions are given to the uploaded artifacts, the temporary files they're written to being private.
+	localFilePermissions = 0o644
+)
+
+// LocalRepository stores artifacts on the local filesystem, below root.
+type LocalRepository struct {
+	root string
+}
+
+func NewLocalRepository(root string) (*LocalRepository, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve artifacts root %q: %w", root, err)
+	}
+
+	return &LocalRepository{root: root}, nil
+}
+
+func (r LocalRepository) fullPath(artifactPath string) string {
+	return filepath.Join(r.root, filepath.FromSlash(artifactPath))
+}
+
+func (r LocalRepository) List(_ context.Context, artifactPath string) ([]*entities.FileInfo, *contract.Error) {
+	directory := r.fullPath(artifactPath)
+
+	// Mirror LocalArtifactRepository.list_artifacts: files and missing paths have no children.
+	if info, err := os.Stat(directory); err != nil || !info.IsDir() {
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, contract.NewErrorWith(
+				protos.ErrorCode_INTERNAL_ERROR,
+				fmt.Sprintf("failed to list artifacts in %q", artifactPath),
+				err,
+			)
+		}
+
+		return make([]*entities.FileInfo, 0), nil
+	}
+
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to list artifacts in %q", artifactPath),
+			err,
+		)
+	}
+
+	fileInfos := make([]*entities.FileInfo, 0, len(entries))
+
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil {
+			return nil, contract.NewErrorWith(
+				protos.ErrorCode_INTERNAL_ERROR,
+				fmt.Sprintf("failed to stat artifact %q", entry.Name()),
+				err,
+			)
+		}
+
+		fileInfo := &entities.FileInfo{
+			Path:  path.Join(artifactPath, entry.Name()),
+			IsDir: entry.IsDir(),
+		}
+		if !fileInfo.IsDir {
+			fileInfo.FileSize = info.Size()
+		}
+
+		fileInfos = append(fileInfos, fileInfo)
+	}
+
+	sort.Slice(fileInfos, func(i, j int) bool {
+		return fileInfos[i].Path < fileInfos[j].Path
+	})
+
+	return fileInfos, nil
+}
+
+func (r LocalRepository) Download(_ context.Context, artifactPath string) (io.ReadCloser, *contract.Error) {
+	file, err := os.Open(r.fullPath(artifactPath))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, newNotFoundError(artifactPath)
+		}
+
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to open artifact %q", artifactPath),
+			err,
+		)
+	}
+
+	info, err := file.Stat()
+	if err != nil || info.IsDir() {
+		file.Close()
+
+		return nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("Artifact %q is not a file", artifactPath),
+		)
+	}
+
+	return file, nil
+}
+
+// Upload writes to a temporary file next to the destination and renames it,
+// so a failed or interrupted upload never leaves a partial artifact behind.
+func (r LocalRepository) Upload(_ context.Context, artifactPath string, reader io.Reader) *contract.Error {
+	destination := r.fullPath(artifactPath)
+
+	if err := os.MkdirAll(filepath.Dir(destination), localDirectoryPermissions); err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to create directory for artifact %q", artifactPath),
+			err,
+		)
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(destination), "."+filepath.Base(destination)+".*")
+	if err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to create artifact %q", artifactPath),
+			err,
+		)
+	}
+
+	defer os.Remove(file.Name())
+
+	if _, err := io.Copy(file, reader); err != nil {
+		file.Close()
+
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to write artifact %q", artifactPath),
+			err,
+		)
+	}
+
+	if err := file.Close(); err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to write artifact %q", artifactPath),
+			err,
+		)
+	}
+
+	if err := os.Chmod(file.Name(), localFilePermissions); err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to write artifact %q", artifactPath),
+			err,
+		)
+	}
+
+	if err := os.Rename(file.Name(), destination); err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to move artifact %q in place", artifactPath),
+			err,
+		)
+	}
+
+	return nil
+}
+
+func (r LocalRepository) Delete(_ context.Context, artifactPath string) *contract.Error {
+	if err := os.RemoveAll(r.fullPath(artifactPath)); err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to delete artifact %q", artifactPath),
+			err,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package repository_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go/pkg/artifacts/repository"
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+)
+
+func TestLocalRepository(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	repo, err := repository.NewLocalRepository(t.TempDir())
+	require.NoError(t, err)
+
+	require.Nil(t, repo.Upload(ctx, "model/weights.bin", strings.NewReader("weights")))
+	require.Nil(t, repo.Upload(ctx, "model/MLmodel", strings.NewReader("flavors")))
+	require.Nil(t, repo.Upload(ctx, "README.md", strings.NewReader("readme")))
+
+	files, contractErr := repo.List(ctx, "")
+	require.Nil(t, contractErr)
+	require.Len(t, files, 2)
+	require.Equal(t, "README.md", files[0].Path)
+	require.Equal(t, "model", files[1].Path)
+	require.True(t, files[1].IsDir)
+
+	files, contractErr = repo.List(ctx, "model")
+	require.Nil(t, contractErr)
+	require.Len(t, files, 2)
+	require.Equal(t, "model/MLmodel", files[0].Path)
+	require.Equal(t, int64(len("flavors")), files[0].FileSize)
+
+	// Files and missing paths have no children.
+	files, contractErr = repo.List(ctx, "README.md")
+	require.Nil(t, contractErr)
+	require.Empty(t, files)
+
+	reader, contractErr := repo.Download(ctx, "model/weights.bin")
+	require.Nil(t, contractErr)
+
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	require.Equal(t, "weights", string(content))
+
+	require.Nil(t, repo.Delete(ctx, "model"))
+
+	_, contractErr = repo.Download(ctx, "model/weights.bin")
+	require.NotNil(t, contractErr)
+	require.Equal(t, contract.ErrorCode(protos.ErrorCode_RESOURCE_DOES_NOT_EXIST), contractErr.Code)
+}
+
+func TestLocalRepositoryUploadPermissions(t *testing.T) {
+	t.Parallel()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("Windows has no permission bits for the group and the others")
+	}
+
+	root := t.TempDir()
+
+	repo, err := repository.NewLocalRepository(root)
+	require.NoError(t, err)
+
+	require.Nil(t, repo.Upload(context.Background(), "model/MLmodel", strings.NewReader("flavors")))
+
+	// The artifacts are readable by the other users of the artifacts root.
+	info, err := os.Stat(filepath.Join(root, "model", "MLmodel"))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o644), info.Mode().Perm())
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"runtime"
+
+	"github.com/mlflow/mlflow-go/pkg/artifacts/multipart"
+	"github.com/mlflow/mlflow-go/pkg/config"
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/entities"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+)
+
+// Repository stores the artifacts served by the mlflow-artifacts service.
+// All paths are relative to the root of the repository and use forward slashes.
+type Repository interface {
+	// List returns the direct children of path, sorted by path.
+	// A path that doesn't exist or isn't a directory has no children.
+	List(ctx context.Context, path string) ([]*entities.FileInfo, *contract.Error)
+	// Download opens the artifact at path for reading. The caller closes the reader.
+	Download(ctx context.Context, path string) (io.ReadCloser, *contract.Error)
+	// Upload stores the content of reader at path, replacing any existing artifact.
+	Upload(ctx context.Context, path string, reader io.Reader) *contract.Error
+	// Delete removes the artifact or directory at path.
+	Delete(ctx context.Context, path string) *contract.Error
+}
+
+// MultipartRepository is implemented by repositories with native multipart uploads.
+// Other repositories get their parts staged on local disk by the artifacts service.
+type MultipartRepository interface {
+	Repository
+	// CreateMultipartUpload starts an upload to path and returns its ID and the URL to send each part to.
+	CreateMultipartUpload(ctx context.Context, path string, numParts int64) (string, []string, *contract.Error)
+	// CompleteMultipartUpload assembles the parts of the upload into the artifact at path.
+	CompleteMultipartUpload(ctx context.Context, path, uploadID string, parts []multipart.Part) *contract.Error
+	// AbortMultipartUpload discards the upload and its parts.
+	AbortMultipartUpload(ctx context.Context, path, uploadID string) *contract.Error
+}
+
+// NewRepository returns the repository matching the scheme of the artifacts destination.
+//
+//nolint:ireturn
+func NewRepository(ctx context.Context, cfg *config.Config) (Repository, error) {
+	return NewRepositoryFromURI(ctx, cfg, cfg.ArtifactsDestination)
+}
+
+// NewRepositoryFromURI returns the repository matching the scheme of location,
+// with location as root. It's used to read artifacts outside of the artifacts destination,
+// like the ones of a model version.
+//
+//nolint:ireturn
+func NewRepositoryFromURI(ctx context.Context, cfg *config.Config, location string) (Repository, error) {
+	uri, err := url.Parse(location)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse artifact location %q: %w", location, err)
+	}
+
+	switch uri.Scheme {
+	case "", "file":
+		return NewLocalRepository(uri.Path)
+	case "s3":
+		return NewS3Repository(ctx, cfg, uri.Host, uri.Path)
+	default:
+		// Windows paths like C:\mlartifacts are parsed with the drive as scheme.
+		if runtime.GOOS == "windows" && len(uri.Scheme) == 1 {
+			return NewLocalRepository(location)
+		}
+
+		return nil, fmt.Errorf("unsupported artifact location scheme %q", uri.Scheme) //nolint:err113
+	}
+}
+
+func newNotFoundError(path string) *contract.Error {
+	return contract.NewError(
+		protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+		fmt.Sprintf("Artifact %q does not exist", path),
+	)
+}
@@ -0,0 +1,301 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+
+	"github.com/mlflow/mlflow-go/pkg/artifacts/multipart"
+	"github.com/mlflow/mlflow-go/pkg/config"
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/entities"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+)
+
+const (
+	// Same default as boto3 when no region is configured.
+	defaultS3Region = "us-east-1"
+	// Maximum number of keys accepted by a single DeleteObjects call.
+	maxDeleteObjects = 1000
+	// Lifetime of the presigned URLs returned for multipart uploads.
+	presignExpiration = time.Hour
+)
+
+// S3Repository stores artifacts in an S3 bucket, below an optional key prefix.
+type S3Repository struct {
+	client    *s3.Client
+	presigner *s3.PresignClient
+	bucket    string
+	prefix    string
+}
+
+// NewS3Repository creates a repository for s3://<bucket>/<prefix>.
+// Settings missing from cfg are loaded from the standard AWS environment variables and shared config files.
+func NewS3Repository(ctx context.Context, cfg *config.Config, bucket, prefix string) (*S3Repository, error) {
+	options := []func(*awsconfig.LoadOptions) error{}
+
+	if cfg.S3Region != "" {
+		options = append(options, awsconfig.WithRegion(cfg.S3Region))
+	}
+
+	if cfg.S3AccessKeyID != "" {
+		options = append(options, awsconfig.WithCredentialsProvider(
+			credentials.NewStaticCredentialsProvider(cfg.S3AccessKeyID, cfg.S3SecretAccessKey, cfg.S3SessionToken),
+		))
+	}
+
+	awsConfig, err := awsconfig.LoadDefaultConfig(ctx, options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+
+	if awsConfig.Region == "" {
+		awsConfig.Region = defaultS3Region
+	}
+
+	client := s3.NewFromConfig(awsConfig, func(o *s3.Options) {
+		// S3 compatible stores like MinIO don't support virtual hosted buckets.
+		if cfg.S3EndpointURL != "" {
+			o.BaseEndpoint = aws.String(cfg.S3EndpointURL)
+			o.UsePathStyle = true
+		}
+	})
+
+	return &S3Repository{
+		client:    client,
+		presigner: s3.NewPresignClient(client),
+		bucket:    bucket,
+		prefix:    strings.Trim(prefix, "/"),
+	}, nil
+}
+
+func (r S3Repository) key(artifactPath string) string {
+	return strings.TrimPrefix(path.Join(r.prefix, artifactPath), "/")
+}
+
+// dirKey returns the prefix shared by all keys below artifactPath.
+func (r S3Repository) dirKey(artifactPath string) string {
+	if key := r.key(artifactPath); key != "" {
+		return key + "/"
+	}
+
+	return ""
+}
+
+func (r S3Repository) relativePath(key string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(key, r.prefix), "/")
+}
+
+func newS3Error(message string, err error) *contract.Error {
+	var (
+		noSuchKey    *types.NoSuchKey
+		noSuchUpload *types.NoSuchUpload
+	)
+
+	if errors.As(err, &noSuchKey) || errors.As(err, &noSuchUpload) {
+		return contract.NewErrorWith(protos.ErrorCode_RESOURCE_DOES_NOT_EXIST, message, err)
+	}
+
+	return contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, message, err)
+}
+
+func (r S3Repository) List(ctx context.Context, artifactPath string) ([]*entities.FileInfo, *contract.Error) {
+	prefix := r.dirKey(artifactPath)
+	files := make([]*entities.FileInfo, 0)
+
+	paginator := s3.NewListObjectsV2Paginator(r.client, &s3.ListObjectsV2Input{
+		Bucket:    aws.String(r.bucket),
+		Prefix:    aws.String(prefix),
+		Delimiter: aws.String("/"),
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, newS3Error(fmt.Sprintf("failed to list artifacts in %q", artifactPath), err)
+		}
+
+		for _, commonPrefix := range page.CommonPrefixes {
+			files = append(files, &entities.FileInfo{
+				Path:  strings.TrimSuffix(r.relativePath(aws.ToString(commonPrefix.Prefix)), "/"),
+				IsDir: true,
+			})
+		}
+
+		for _, object := range page.Contents {
+			// Some tools create empty objects to mark directories.
+			if aws.ToString(object.Key) == prefix {
+				continue
+			}
+
+			files = append(files, &entities.FileInfo{
+				Path:     r.relativePath(aws.ToString(object.Key)),
+				FileSize: aws.ToInt64(object.Size),
+			})
+		}
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Path < files[j].Path
+	})
+
+	return files, nil
+}
+
+func (r S3Repository) Download(ctx context.Context, artifactPath string) (io.ReadCloser, *contract.Error) {
+	output, err := r.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(r.bucket),
+		Key:    aws.String(r.key(artifactPath)),
+	})
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, newNotFoundError(artifactPath)
+		}
+
+		return nil, newS3Error(fmt.Sprintf("failed to download artifact %q", artifactPath), err)
+	}
+
+	return output.Body, nil
+}
+
+// Upload streams reader to S3, switching to a multipart upload for large artifacts.
+func (r S3Repository) Upload(ctx context.Context, artifactPath string, reader io.Reader) *contract.Error {
+	if _, err := manager.NewUploader(r.client).Upload(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(r.bucket),
+		Key:    aws.String(r.key(artifactPath)),
+		Body:   reader,
+	}); err != nil {
+		return newS3Error(fmt.Sprintf("failed to upload artifact %q", artifactPath), err)
+	}
+
+	return nil
+}
+
+// Delete removes the object at artifactPath and every object below it.
+func (r S3Repository) Delete(ctx context.Context, artifactPath string) *contract.Error {
+	objects := []types.ObjectIdentifier{{Key: aws.String(r.key(artifactPath))}}
+
+	paginator := s3.NewListObjectsV2Paginator(r.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(r.bucket),
+		Prefix: aws.String(r.dirKey(artifactPath)),
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return newS3Error(fmt.Sprintf("failed to list artifacts in %q", artifactPath), err)
+		}
+
+		for _, object := range page.Contents {
+			objects = append(objects, types.ObjectIdentifier{Key: object.Key})
+		}
+	}
+
+	for start := 0; start < len(objects); start += maxDeleteObjects {
+		end := min(start+maxDeleteObjects, len(objects))
+
+		output, err := r.client.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(r.bucket),
+			Delete: &types.Delete{Objects: objects[start:end], Quiet: aws.Bool(true)},
+		})
+		if err != nil {
+			return newS3Error(fmt.Sprintf("failed to delete artifact %q", artifactPath), err)
+		}
+
+		if len(output.Errors) > 0 {
+			return contract.NewError(
+				protos.ErrorCode_INTERNAL_ERROR,
+				fmt.Sprintf(
+					"failed to delete artifact %q: %s",
+					artifactPath,
+					aws.ToString(output.Errors[0].Message),
+				),
+			)
+		}
+	}
+
+	return nil
+}
+
+// CreateMultipartUpload starts a native S3 multipart upload
+// and returns a presigned URL for every part, so clients upload them directly to S3.
+func (r S3Repository) CreateMultipartUpload(
+	ctx context.Context, artifactPath string, numParts int64,
+) (string, []string, *contract.Error) {
+	output, err := r.client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket: aws.String(r.bucket),
+		Key:    aws.String(r.key(artifactPath)),
+	})
+	if err != nil {
+		return "", nil, newS3Error(
+			fmt.Sprintf("failed to create multipart upload for %q", artifactPath), err,
+		)
+	}
+
+	urls := make([]string, 0, numParts)
+
+	for partNumber := int32(1); int64(partNumber) <= numParts; partNumber++ {
+		request, err := r.presigner.PresignUploadPart(ctx, &s3.UploadPartInput{
+			Bucket:     aws.String(r.bucket),
+			Key:        aws.String(r.key(artifactPath)),
+			UploadId:   output.UploadId,
+			PartNumber: aws.Int32(partNumber),
+		}, s3.WithPresignExpires(presignExpiration))
+		if err != nil {
+			return "", nil, newS3Error(fmt.Sprintf("failed to presign part %d", partNumber), err)
+		}
+
+		urls = append(urls, request.URL)
+	}
+
+	return aws.ToString(output.UploadId), urls, nil
+}
+
+func (r S3Repository) CompleteMultipartUpload(
+	ctx context.Context, artifactPath, uploadID string, parts []multipart.Part,
+) *contract.Error {
+	completedParts := make([]types.CompletedPart, len(parts))
+	for i, part := range parts {
+		completedParts[i] = types.CompletedPart{
+			ETag:       aws.String(part.ETag),
+			PartNumber: aws.Int32(int32(part.PartNumber)), //nolint:gosec // validated by S3.
+		}
+	}
+
+	if _, err := r.client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(r.bucket),
+		Key:             aws.String(r.key(artifactPath)),
+		UploadId:        aws.String(uploadID),
+		MultipartUpload: &types.CompletedMultipartUpload{Parts: completedParts},
+	}); err != nil {
+		return newS3Error(fmt.Sprintf("failed to complete multipart upload %q", uploadID), err)
+	}
+
+	return nil
+}
+
+func (r S3Repository) AbortMultipartUpload(ctx context.Context, artifactPath, uploadID string) *contract.Error {
+	if _, err := r.client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(r.bucket),
+		Key:      aws.String(r.key(artifactPath)),
+		UploadId: aws.String(uploadID),
+	}); err != nil {
+		return newS3Error(fmt.Sprintf("failed to abort multipart upload %q", uploadID), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,323 @@
+package repository_test
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go/pkg/artifacts/multipart"
+	"github.com/mlflow/mlflow-go/pkg/artifacts/repository"
+	"github.com/mlflow/mlflow-go/pkg/config"
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+)
+
+const testBucket = "bucket"
+
+type listBucketResult struct {
+	XMLName        xml.Name `xml:"ListBucketResult"`
+	Name           string   `xml:"Name"`
+	Prefix         string   `xml:"Prefix"`
+	IsTruncated    bool     `xml:"IsTruncated"`
+	Contents       []listObject
+	CommonPrefixes []commonPrefix
+}
+
+type listObject struct {
+	XMLName xml.Name `xml:"Contents"`
+	Key     string   `xml:"Key"`
+	Size    int      `xml:"Size"`
+}
+
+type commonPrefix struct {
+	XMLName xml.Name `xml:"CommonPrefixes"`
+	Prefix  string   `xml:"Prefix"`
+}
+
+type deleteRequest struct {
+	Objects []struct {
+		Key string `xml:"Key"`
+	} `xml:"Object"`
+}
+
+type completeMultipartUploadRequest struct {
+	Parts []multipart.Part `xml:"Part"`
+}
+
+// fakeS3 is an S3 server keeping the objects of a bucket in memory, with the multipart uploads they're part of.
+type fakeS3 struct {
+	mutex     sync.Mutex
+	objects   map[string]string
+	uploads   map[string]string
+	completed map[string][]multipart.Part
+}
+
+func writeS3Error(w http.ResponseWriter, status int, code string) {
+	w.WriteHeader(status)
+	fmt.Fprintf(w, "<Error><Code>%s</Code><Message>%s</Message></Error>", code, code)
+}
+
+func writeXML(t *testing.T, w http.ResponseWriter, value any) {
+	t.Helper()
+
+	w.Header().Set("Content-Type", "application/xml")
+	require.NoError(t, xml.NewEncoder(w).Encode(value))
+}
+
+func (s *fakeS3) list(t *testing.T, w http.ResponseWriter, query url.Values) {
+	t.Helper()
+
+	result := listBucketResult{Name: testBucket, Prefix: query.Get("prefix")}
+	prefixes := make(map[string]bool)
+
+	for key, content := range s.objects {
+		rest, ok := strings.CutPrefix(key, result.Prefix)
+		if !ok {
+			continue
+		}
+
+		if dir, _, isDir := strings.Cut(rest, query.Get("delimiter")); isDir && query.Get("delimiter") != "" {
+			prefixes[result.Prefix+dir+query.Get("delimiter")] = true
+
+			continue
+		}
+
+		result.Contents = append(result.Contents, listObject{Key: key, Size: len(content)})
+	}
+
+	for prefix := range prefixes {
+		result.CommonPrefixes = append(result.CommonPrefixes, commonPrefix{Prefix: prefix})
+	}
+
+	// S3 lists the keys in order.
+	sort.Slice(result.Contents, func(i, j int) bool { return result.Contents[i].Key < result.Contents[j].Key })
+
+	writeXML(t, w, result)
+}
+
+func (s *fakeS3) decode(t *testing.T, r *http.Request, value any) {
+	t.Helper()
+
+	require.NoError(t, xml.NewDecoder(r.Body).Decode(value))
+}
+
+//nolint:cyclop,funlen
+func (s *fakeS3) handler(t *testing.T) http.HandlerFunc {
+	t.Helper()
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
+		bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+		assert.Equal(t, testBucket, bucket)
+
+		query := r.URL.Query()
+
+		switch {
+		case r.Method == http.MethodGet && key == "":
+			s.list(t, w, query)
+		case r.Method == http.MethodGet:
+			content, ok := s.objects[key]
+			if !ok {
+				writeS3Error(w, http.StatusNotFound, "NoSuchKey")
+
+				return
+			}
+
+			fmt.Fprint(w, content)
+		case r.Method == http.MethodPut:
+			content, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+
+			s.objects[key] = string(content)
+		case r.Method == http.MethodPost && query.Has("delete"):
+			var request deleteRequest
+			s.decode(t, r, &request)
+
+			for _, object := range request.Objects {
+				delete(s.objects, object.Key)
+			}
+
+			writeXML(t, w, struct {
+				XMLName xml.Name `xml:"DeleteResult"`
+			}{})
+		case r.Method == http.MethodPost && query.Has("uploads"):
+			uploadID := fmt.Sprintf("upload-%d", len(s.uploads)+1)
+			s.uploads[uploadID] = key
+
+			writeXML(t, w, struct {
+				XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+				Bucket   string   `xml:"Bucket"`
+				Key      string   `xml:"Key"`
+				UploadID string   `xml:"UploadId"`
+			}{Bucket: bucket, Key: key, UploadID: uploadID})
+		case query.Has("uploadId"):
+			if s.uploads[query.Get("uploadId")] != key {
+				writeS3Error(w, http.StatusNotFound, "NoSuchUpload")
+
+				return
+			}
+
+			delete(s.uploads, query.Get("uploadId"))
+
+			if r.Method == http.MethodDelete {
+				w.WriteHeader(http.StatusNoContent)
+
+				return
+			}
+
+			var request completeMultipartUploadRequest
+			s.decode(t, r, &request)
+			s.completed[key] = request.Parts
+
+			writeXML(t, w, struct {
+				XMLName xml.Name `xml:"CompleteMultipartUploadResult"`
+				Key     string   `xml:"Key"`
+			}{Key: key})
+		default:
+			t.Errorf("unexpected S3 request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+	}
+}
+
+func newTestS3Repository(t *testing.T, prefix string) (*repository.S3Repository, *fakeS3) {
+	t.Helper()
+
+	fake := &fakeS3{
+		objects:   make(map[string]string),
+		uploads:   make(map[string]string),
+		completed: make(map[string][]multipart.Part),
+	}
+
+	server := httptest.NewServer(fake.handler(t))
+	t.Cleanup(server.Close)
+
+	repo, err := repository.NewS3Repository(context.Background(), &config.Config{
+		S3AccessKeyID:     "access-key",
+		S3EndpointURL:     server.URL,
+		S3Region:          "eu-west-1",
+		S3SecretAccessKey: "secret-key",
+	}, testBucket, prefix)
+	require.NoError(t, err)
+
+	return repo, fake
+}
+
+func TestS3Repository(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo, fake := newTestS3Repository(t, "/1/abc/artifacts/")
+
+	require.Nil(t, repo.Upload(ctx, "model/weights.bin", strings.NewReader("weights")))
+	require.Nil(t, repo.Upload(ctx, "model/MLmodel", strings.NewReader("flavors")))
+	require.Nil(t, repo.Upload(ctx, "README.md", strings.NewReader("readme")))
+
+	// The keys are below the prefix, without its slashes.
+	assert.Equal(t, map[string]string{
+		"1/abc/artifacts/model/weights.bin": "weights",
+		"1/abc/artifacts/model/MLmodel":     "flavors",
+		"1/abc/artifacts/README.md":         "readme",
+	}, fake.objects)
+
+	// Objects marking directories aren't listed.
+	fake.objects["1/abc/artifacts/model/"] = ""
+
+	files, contractErr := repo.List(ctx, "")
+	require.Nil(t, contractErr)
+	require.Len(t, files, 2)
+	assert.Equal(t, "README.md", files[0].Path)
+	assert.Equal(t, int64(len("readme")), files[0].FileSize)
+	assert.Equal(t, "model", files[1].Path)
+	assert.True(t, files[1].IsDir)
+
+	files, contractErr = repo.List(ctx, "model")
+	require.Nil(t, contractErr)
+	require.Len(t, files, 2)
+	assert.Equal(t, "model/MLmodel", files[0].Path)
+	assert.Equal(t, "model/weights.bin", files[1].Path)
+
+	reader, contractErr := repo.Download(ctx, "model/weights.bin")
+	require.Nil(t, contractErr)
+
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	assert.Equal(t, "weights", string(content))
+
+	require.Nil(t, repo.Delete(ctx, "model"))
+	assert.Equal(t, map[string]string{"1/abc/artifacts/README.md": "readme"}, fake.objects)
+
+	_, contractErr = repo.Download(ctx, "model/weights.bin")
+	require.NotNil(t, contractErr)
+	assert.Equal(t, contract.ErrorCode(protos.ErrorCode_RESOURCE_DOES_NOT_EXIST), contractErr.Code)
+}
+
+func TestS3RepositoryWithoutPrefix(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo, fake := newTestS3Repository(t, "")
+
+	require.Nil(t, repo.Upload(ctx, "model/MLmodel", strings.NewReader("flavors")))
+	assert.Equal(t, map[string]string{"model/MLmodel": "flavors"}, fake.objects)
+
+	files, contractErr := repo.List(ctx, "")
+	require.Nil(t, contractErr)
+	require.Len(t, files, 1)
+	assert.Equal(t, "model", files[0].Path)
+	assert.True(t, files[0].IsDir)
+}
+
+func TestS3RepositoryMultipartUpload(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo, fake := newTestS3Repository(t, "1/abc/artifacts")
+
+	uploadID, urls, contractErr := repo.CreateMultipartUpload(ctx, "model/weights.bin", 3)
+	require.Nil(t, contractErr)
+	assert.Equal(t, "upload-1", uploadID)
+	assert.Equal(t, "1/abc/artifacts/model/weights.bin", fake.uploads[uploadID])
+	require.Len(t, urls, 3)
+
+	// The parts are uploaded to S3 with URLs signed for an hour.
+	for index, presignedURL := range urls {
+		parsedURL, err := url.Parse(presignedURL)
+		require.NoError(t, err)
+
+		query := parsedURL.Query()
+		assert.Equal(t, "/bucket/1/abc/artifacts/model/weights.bin", parsedURL.Path)
+		assert.Equal(t, uploadID, query.Get("uploadId"))
+		assert.Equal(t, fmt.Sprint(index+1), query.Get("partNumber"))
+		assert.Equal(t, "3600", query.Get("X-Amz-Expires"))
+		assert.NotEmpty(t, query.Get("X-Amz-Signature"))
+	}
+
+	parts := []multipart.Part{{PartNumber: 1, ETag: `"a"`}, {PartNumber: 2, ETag: `"b"`}, {PartNumber: 3, ETag: `"c"`}}
+	require.Nil(t, repo.CompleteMultipartUpload(ctx, "model/weights.bin", uploadID, parts))
+	assert.Equal(t, parts, fake.completed["1/abc/artifacts/model/weights.bin"])
+
+	// The upload is gone once completed.
+	contractErr = repo.AbortMultipartUpload(ctx, "model/weights.bin", uploadID)
+	require.NotNil(t, contractErr)
+	assert.Equal(t, contract.ErrorCode(protos.ErrorCode_RESOURCE_DOES_NOT_EXIST), contractErr.Code)
+
+	uploadID, _, contractErr = repo.CreateMultipartUpload(ctx, "data.csv", 1)
+	require.Nil(t, contractErr)
+	require.Nil(t, repo.AbortMultipartUpload(ctx, "data.csv", uploadID))
+	assert.Empty(t, fake.uploads)
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"io"
+	"path"
+
+	"github.com/mlflow/mlflow-go/pkg/artifacts/repository"
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/protos/artifacts"
+)
+
+//nolint:ireturn
+func (as ArtifactsService) getRepository() (repository.Repository, *contract.Error) {
+	if as.repository == nil {
+		return nil, contract.NewError(
+			protos.ErrorCode_ENDPOINT_NOT_FOUND,
+			"Artifacts are not served: the server has no artifacts destination configured.",
+		)
+	}
+
+	return as.repository, nil
+}
+
+func (as ArtifactsService) ListArtifacts(
+	ctx context.Context, input *artifacts.ListArtifacts,
+) (*artifacts.ListArtifacts_Response, *contract.Error) {
+	repository, err := as.getRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	fileInfos, err := repository.List(ctx, input.GetPath())
+	if err != nil {
+		return nil, err
+	}
+
+	response := artifacts.ListArtifacts_Response{
+		Files: make([]*artifacts.FileInfo, len(fileInfos)),
+	}
+
+	// The mlflow-artifacts service only returns the base names.
+	for i, fileInfo := range fileInfos {
+		fileInfo.Path = path.Base(fileInfo.Path)
+		response.Files[i] = fileInfo.ToProto()
+	}
+
+	return &response, nil
+}
+
+func (as ArtifactsService) DownloadArtifact(
+	ctx context.Context, artifactPath string,
+) (io.ReadCloser, *contract.Error) {
+	repository, err := as.getRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	return repository.Download(ctx, artifactPath)
+}
+
+func (as ArtifactsService) UploadArtifact(
+	ctx context.Context, artifactPath string, reader io.Reader,
+) (*artifacts.UploadArtifact_Response, *contract.Error) {
+	repository, err := as.getRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repository.Upload(ctx, artifactPath, reader); err != nil {
+		return nil, err
+	}
+
+	return &artifacts.UploadArtifact_Response{}, nil
+}
+
+func (as ArtifactsService) DeleteArtifact(
+	ctx context.Context, artifactPath string,
+) (*artifacts.DeleteArtifact_Response, *contract.Error) {
+	repository, err := as.getRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repository.Delete(ctx, artifactPath); err != nil {
+		return nil, err
+	}
+
+	return &artifacts.DeleteArtifact_Response{}, nil
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/mlflow/mlflow-go/pkg/artifacts/multipart"
+	"github.com/mlflow/mlflow-go/pkg/artifacts/repository"
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/protos/artifacts"
+	"github.com/mlflow/mlflow-go/pkg/utils"
+)
+
+// The artifact path of multipart requests is the destination directory,
+// the file name is taken from the path of the request body.
+func multipartArtifactPath(artifactPath, localPath string) string {
+	return path.Join(artifactPath, path.Base(localPath))
+}
+
+// CreateMultipartUpload returns one credential per part. Repositories with native multipart uploads
+// provide the part URLs, otherwise they point to partsURL/<upload_id>/<part_number>.
+func (as ArtifactsService) CreateMultipartUpload(
+	ctx context.Context, artifactPath string, input *artifacts.CreateMultipartUpload, partsURL string,
+) (*artifacts.CreateMultipartUpload_Response, *contract.Error) {
+	repo, err := as.getRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	artifactPath = multipartArtifactPath(artifactPath, input.GetPath())
+
+	var (
+		uploadID string
+		urls     []string
+	)
+
+	if multipartRepo, ok := repo.(repository.MultipartRepository); ok {
+		uploadID, urls, err = multipartRepo.CreateMultipartUpload(ctx, artifactPath, input.GetNumParts())
+	} else if uploadID, err = as.stager.Create(artifactPath, input.GetNumParts()); err == nil {
+		for partNumber := int64(1); partNumber <= input.GetNumParts(); partNumber++ {
+			urls = append(urls, fmt.Sprintf("%s/%s/%d", partsURL, uploadID, partNumber))
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	response := artifacts.CreateMultipartUpload_Response{
+		UploadId:    &uploadID,
+		Credentials: make([]*artifacts.MultipartUploadCredential, len(urls)),
+	}
+
+	for i, url := range urls {
+		response.Credentials[i] = &artifacts.MultipartUploadCredential{
+			Url:        utils.PtrTo(url),
+			PartNumber: utils.PtrTo(int64(i + 1)),
+		}
+	}
+
+	return &response, nil
+}
+
+// UploadMultipartPart stores a part sent to one of the credentials of CreateMultipartUpload.
+func (as ArtifactsService) UploadMultipartPart(
+	_ context.Context, uploadID string, partNumber int64, reader io.Reader,
+) (string, *contract.Error) {
+	if _, err := as.getRepository(); err != nil {
+		return "", err
+	}
+
+	// Parts of native multipart uploads are sent to the repository directly.
+	if as.stager == nil {
+		return "", contract.NewError(
+			protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+			fmt.Sprintf("Multipart upload %q does not exist", uploadID),
+		)
+	}
+
+	return as.stager.WritePart(uploadID, partNumber, reader)
+}
+
+func (as ArtifactsService) CompleteMultipartUpload(
+	ctx context.Context, artifactPath string, input *artifacts.CompleteMultipartUpload,
+) (*artifacts.CompleteMultipartUpload_Response, *contract.Error) {
+	repo, err := as.getRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	artifactPath = multipartArtifactPath(artifactPath, input.GetPath())
+
+	parts := make([]multipart.Part, len(input.GetParts()))
+	for i, part := range input.GetParts() {
+		parts[i] = multipart.Part{PartNumber: part.GetPartNumber(), ETag: part.GetEtag()}
+	}
+
+	if multipartRepo, ok := repo.(repository.MultipartRepository); ok {
+		if err := multipartRepo.CompleteMultipartUpload(ctx, artifactPath, input.GetUploadId(), parts); err != nil {
+			return nil, err
+		}
+
+		return &artifacts.CompleteMultipartUpload_Response{}, nil
+	}
+
+	reader, err := as.stager.Open(input.GetUploadId(), artifactPath, parts)
+	if err != nil {
+		return nil, err
+	}
+
+	// The repository only exposes the artifact once it has been written completely.
+	err = repo.Upload(ctx, artifactPath, reader)
+	reader.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := as.stager.Remove(input.GetUploadId(), artifactPath); err != nil {
+		return nil, err
+	}
+
+	return &artifacts.CompleteMultipartUpload_Response{}, nil
+}
+
+func (as ArtifactsService) AbortMultipartUpload(
+	ctx context.Context, artifactPath string, input *artifacts.AbortMultipartUpload,
+) (*artifacts.AbortMultipartUpload_Response, *contract.Error) {
+	repo, err := as.getRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	artifactPath = multipartArtifactPath(artifactPath, input.GetPath())
+
+	if multipartRepo, ok := repo.(repository.MultipartRepository); ok {
+		err = multipartRepo.AbortMultipartUpload(ctx, artifactPath, input.GetUploadId())
+	} else {
+		err = as.stager.Remove(input.GetUploadId(), artifactPath)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &artifacts.AbortMultipartUpload_Response{}, nil
+}
@@ -2,20 +2,58 @@ package service
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/mlflow/mlflow-go/pkg/artifacts/multipart"
+	"github.com/mlflow/mlflow-go/pkg/artifacts/repository"
 	"github.com/mlflow/mlflow-go/pkg/config"
 )
 
 type ArtifactsService struct {
-	config *config.Config
+	config       *config.Config
+	repository   repository.Repository
+	stager       *multipart.Stager
+	stopStagerGC context.CancelFunc
 }
 
-func NewArtifactsService(_ context.Context, config *config.Config) (*ArtifactsService, error) {
-	return &ArtifactsService{
-		config: config,
-	}, nil
+func NewArtifactsService(ctx context.Context, config *config.Config) (*ArtifactsService, error) {
+	service := ArtifactsService{
+		config:       config,
+		stopStagerGC: func() {},
+	}
+
+	if config.ArtifactsDestination != "" {
+		repo, err := repository.NewRepository(ctx, config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create artifact repository: %w", err)
+		}
+
+		service.repository = repo
+
+		// Repositories with native multipart uploads don't need parts to be staged locally.
+		if _, ok := repo.(repository.MultipartRepository); ok {
+			return &service, nil
+		}
+
+		stager, err := multipart.NewStager(config.MultipartUploadDir, config.MultipartUploadTTL.Duration)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create multipart upload stager: %w", err)
+		}
+
+		service.stager = stager
+
+		// Parts of abandoned uploads are removed once they are older than the TTL.
+		stagerCtx, cancel := context.WithCancel(ctx)
+		service.stopStagerGC = cancel
+
+		go stager.RunCleanup(stagerCtx)
+	}
+
+	return &service, nil
 }
 
 func (as ArtifactsService) Destroy() error {
+	as.stopStagerGC()
+
 	return nil
 }
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"time"
 )
 
@@ -38,19 +40,47 @@ func (d *Duration) UnmarshalJSON(b []byte) error {
 	}
 }
 
+// The schema_mismatch option tells what the SQL stores do with a database whose schema revision isn't supported,
+// the stores failing to be created by default. The newer revisions which only add tables and optional columns
+// to the schema are supported.
+const (
+	SchemaMismatchFail     = "fail"
+	SchemaMismatchReadOnly = "read_only"
+)
+
 type Config struct {
-	Address               string                 `json:"address"`
-	DefaultArtifactRoot   string                 `json:"default_artifact_root"`
-	LogLevel              string                 `json:"log_level"`
-	ModelRegistryStoreURI string                 `json:"model_registry_store_uri"`
-	PythonEnv             []string               `json:"python_env"`
-	PythonAddress         string                 `json:"python_address"`
-	PythonCommand         []string               `json:"python_command"`
-	PythonTestsENV        map[string]interface{} `json:"python_tests_env"`
-	ShutdownTimeout       Duration               `json:"shutdown_timeout"`
-	StaticFolder          string                 `json:"static_folder"`
-	TrackingStoreURI      string                 `json:"tracking_store_uri"`
-	Version               string                 `json:"version"`
+	Address                          string                 `json:"address"`
+	ArtifactsDestination             string                 `json:"artifacts_destination"`
+	ArtifactsUploadReadTimeout       Duration               `json:"artifacts_upload_read_timeout"`
+	DatabaseConnectionMaxLifetime    Duration               `json:"database_connection_max_lifetime"`
+	DatabaseMaxIdleConnections       int                    `json:"database_max_idle_connections"`
+	DatabaseMaxOpenConnections       int                    `json:"database_max_open_connections"`
+	DatabaseMaxRetries               int                    `json:"database_max_retries"`
+	DatabaseRetryBackoff             Duration               `json:"database_retry_backoff"`
+	DatabaseStatementTimeout         Duration               `json:"database_statement_timeout"`
+	DefaultArtifactRoot              string                 `json:"default_artifact_root"`
+	LogLevel                         string                 `json:"log_level"`
+	CreateDatabaseSchema             bool                   `json:"create_database_schema"`
+	ModelRegistryStoreReadReplicaURI string                 `json:"model_registry_store_read_replica_uri"`
+	ModelRegistryStoreURI            string                 `json:"model_registry_store_uri"`
+	MultipartUploadDir               string                 `json:"multipart_upload_dir"`
+	MultipartUploadTTL               Duration               `json:"multipart_upload_ttl"`
+	PythonEnv                        []string               `json:"python_env"`
+	PythonAddress                    string                 `json:"python_address"`
+	PythonCommand                    []string               `json:"python_command"`
+	PythonTestsENV                   map[string]interface{} `json:"python_tests_env"`
+	S3AccessKeyID                    string                 `json:"s3_access_key_id"`
+	S3EndpointURL                    string                 `json:"s3_endpoint_url"`
+	S3Region                         string                 `json:"s3_region"`
+	S3SecretAccessKey                string                 `json:"s3_secret_access_key"`
+	S3SessionToken                   string                 `json:"s3_session_token"`
+	SchemaMismatch                   string                 `json:"schema_mismatch"`
+	SearchRunsKeysetPagination       bool                   `json:"search_runs_keyset_pagination"`
+	ShutdownTimeout                  Duration               `json:"shutdown_timeout"`
+	StaticFolder                     string                 `json:"static_folder"`
+	TrackingStoreReadReplicaURI      string                 `json:"tracking_store_read_replica_uri"`
+	TrackingStoreURI                 string                 `json:"tracking_store_uri"`
+	Version                          string                 `json:"version"`
 }
 
 func NewConfigFromBytes(cfgBytes []byte) (*Config, error) {
@@ -77,6 +107,14 @@ func (c *Config) applyDefaults() {
 		c.Address = "localhost:5000"
 	}
 
+	if c.ArtifactsUploadReadTimeout.Duration == 0 {
+		c.ArtifactsUploadReadTimeout.Duration = time.Hour
+	}
+
+	if c.DatabaseMaxRetries > 0 && c.DatabaseRetryBackoff.Duration == 0 {
+		c.DatabaseRetryBackoff.Duration = 50 * time.Millisecond
+	}
+
 	if c.DefaultArtifactRoot == "" {
 		c.DefaultArtifactRoot = "mlflow-artifacts:/"
 	}
@@ -85,6 +123,18 @@ func (c *Config) applyDefaults() {
 		c.LogLevel = "INFO"
 	}
 
+	if c.MultipartUploadDir == "" {
+		c.MultipartUploadDir = filepath.Join(os.TempDir(), "mlflow-multipart-uploads")
+	}
+
+	if c.MultipartUploadTTL.Duration == 0 {
+		c.MultipartUploadTTL.Duration = 24 * time.Hour
+	}
+
+	if c.SchemaMismatch == "" {
+		c.SchemaMismatch = SchemaMismatchFail
+	}
+
 	if c.ShutdownTimeout.Duration == 0 {
 		c.ShutdownTimeout.Duration = time.Minute
 	}
@@ -101,6 +151,10 @@ func (c *Config) applyDefaults() {
 		c.ModelRegistryStoreURI = c.TrackingStoreURI
 	}
 
+	if c.ModelRegistryStoreReadReplicaURI == "" && c.ModelRegistryStoreURI == c.TrackingStoreURI {
+		c.ModelRegistryStoreReadReplicaURI = c.TrackingStoreReadReplicaURI
+	}
+
 	if c.Version == "" {
 		c.Version = "dev"
 	}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+	"io"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/protos/artifacts"
+)
+
+// ArtifactsStreamingService holds the mlflow-artifacts endpoints that can't be generated:
+// they read the artifact path from the URL and stream the request or response body.
+type ArtifactsStreamingService interface {
+	DownloadArtifact(ctx context.Context, artifactPath string) (io.ReadCloser, *contract.Error)
+	UploadArtifact(
+		ctx context.Context, artifactPath string, reader io.Reader,
+	) (*artifacts.UploadArtifact_Response, *contract.Error)
+	DeleteArtifact(ctx context.Context, artifactPath string) (*artifacts.DeleteArtifact_Response, *contract.Error)
+	CreateMultipartUpload(
+		ctx context.Context, artifactPath string, input *artifacts.CreateMultipartUpload, partsURL string,
+	) (*artifacts.CreateMultipartUpload_Response, *contract.Error)
+	UploadMultipartPart(
+		ctx context.Context, uploadID string, partNumber int64, reader io.Reader,
+	) (string, *contract.Error)
+	CompleteMultipartUpload(
+		ctx context.Context, artifactPath string, input *artifacts.CompleteMultipartUpload,
+	) (*artifacts.CompleteMultipartUpload_Response, *contract.Error)
+	AbortMultipartUpload(
+		ctx context.Context, artifactPath string, input *artifacts.AbortMultipartUpload,
+	) (*artifacts.AbortMultipartUpload_Response, *contract.Error)
+}
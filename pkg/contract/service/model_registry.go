@@ -0,0 +1,14 @@
+package service
+
+import (
+	"context"
+	"io"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+)
+
+// ModelRegistryStreamingService holds the model registry endpoints that can't be generated:
+// they aren't part of the REST API and stream the response body.
+type ModelRegistryStreamingService interface {
+	DownloadModelVersionArtifact(ctx context.Context, name, version, artifactPath string) (io.ReadCloser, *contract.Error)
+}
@@ -31,10 +31,12 @@ type TrackingService interface {
 	GetRun(ctx context.Context, input *protos.GetRun) (*protos.GetRun_Response, *contract.Error)
 	SearchRuns(ctx context.Context, input *protos.SearchRuns) (*protos.SearchRuns_Response, *contract.Error)
 	GetMetricHistory(ctx context.Context, input *protos.GetMetricHistory) (*protos.GetMetricHistory_Response, *contract.Error)
+	GetMetricHistoryBulkInterval(ctx context.Context, input *protos.GetMetricHistoryBulkInterval) (*protos.GetMetricHistoryBulkInterval_Response, *contract.Error)
 	LogBatch(ctx context.Context, input *protos.LogBatch) (*protos.LogBatch_Response, *contract.Error)
 	LogInputs(ctx context.Context, input *protos.LogInputs) (*protos.LogInputs_Response, *contract.Error)
 	StartTrace(ctx context.Context, input *protos.StartTrace) (*protos.StartTrace_Response, *contract.Error)
 	EndTrace(ctx context.Context, input *protos.EndTrace) (*protos.EndTrace_Response, *contract.Error)
 	GetTraceInfo(ctx context.Context, input *protos.GetTraceInfo) (*protos.GetTraceInfo_Response, *contract.Error)
+	SearchTraces(ctx context.Context, input *protos.SearchTraces) (*protos.SearchTraces_Response, *contract.Error)
 	DeleteTraces(ctx context.Context, input *protos.DeleteTraces) (*protos.DeleteTraces_Response, *contract.Error)
 }
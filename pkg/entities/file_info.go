@@ -0,0 +1,24 @@
+package entities
+
+import (
+	"github.com/mlflow/mlflow-go/pkg/protos/artifacts"
+)
+
+type FileInfo struct {
+	Path     string
+	IsDir    bool
+	FileSize int64
+}
+
+func (f FileInfo) ToProto() *artifacts.FileInfo {
+	fileInfo := artifacts.FileInfo{
+		Path:  &f.Path,
+		IsDir: &f.IsDir,
+	}
+
+	if !f.IsDir {
+		fileInfo.FileSize = &f.FileSize
+	}
+
+	return &fileInfo
+}
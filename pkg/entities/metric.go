@@ -50,3 +50,20 @@ func MetricFromLogMetricProtoInput(input *protos.LogMetric) *Metric {
 		Step:      input.GetStep(),
 	}
 }
+
+type MetricWithRunID struct {
+	*Metric
+	RunID string
+}
+
+func (m MetricWithRunID) ToProto() *protos.MetricWithRunId {
+	metric := m.Metric.ToProto()
+
+	return &protos.MetricWithRunId{
+		Key:       metric.Key,
+		Value:     metric.Value,
+		Timestamp: metric.Timestamp,
+		Step:      metric.Step,
+		RunId:     &m.RunID,
+	}
+}
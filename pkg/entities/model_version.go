@@ -21,11 +21,34 @@ type ModelVersion struct {
 	StatusMessage   string
 	RunLink         string
 	StorageLocation string
+	Tags            []*ModelVersionTag
+	Aliases         []string
 }
 
 func (mv ModelVersion) ToProto() *protos.ModelVersion {
-	return &protos.ModelVersion{
-		Version:      utils.PtrTo(strconv.Itoa(int(mv.Version))),
-		CurrentStage: utils.PtrTo(mv.CurrentStage),
+	modelVersion := protos.ModelVersion{
+		Name:                 utils.PtrTo(mv.Name),
+		Version:              utils.PtrTo(strconv.Itoa(int(mv.Version))),
+		CreationTimestamp:    utils.PtrTo(mv.CreationTime),
+		LastUpdatedTimestamp: utils.PtrTo(mv.LastUpdatedTime),
+		UserId:               utils.PtrTo(mv.UserID),
+		CurrentStage:         utils.PtrTo(mv.CurrentStage),
+		Description:          utils.PtrTo(mv.Description),
+		Source:               utils.PtrTo(mv.Source),
+		RunId:                utils.PtrTo(mv.RunID),
+		StatusMessage:        utils.PtrTo(mv.StatusMessage),
+		RunLink:              utils.PtrTo(mv.RunLink),
+		Tags:                 make([]*protos.ModelVersionTag, 0, len(mv.Tags)),
+		Aliases:              mv.Aliases,
 	}
+
+	if status, ok := protos.ModelVersionStatus_value[mv.Status]; ok {
+		modelVersion.Status = utils.PtrTo(protos.ModelVersionStatus(status))
+	}
+
+	for _, tag := range mv.Tags {
+		modelVersion.Tags = append(modelVersion.Tags, tag.ToProto())
+	}
+
+	return &modelVersion
 }
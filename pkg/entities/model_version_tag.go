@@ -0,0 +1,25 @@
+package entities
+
+import (
+	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/utils"
+)
+
+type ModelVersionTag struct {
+	Key   string
+	Value string
+}
+
+func (t ModelVersionTag) ToProto() *protos.ModelVersionTag {
+	return &protos.ModelVersionTag{
+		Key:   utils.PtrTo(t.Key),
+		Value: utils.PtrTo(t.Value),
+	}
+}
+
+func NewModelVersionTagFromProto(proto *protos.ModelVersionTag) *ModelVersionTag {
+	return &ModelVersionTag{
+		Key:   proto.GetKey(),
+		Value: proto.GetValue(),
+	}
+}
@@ -61,7 +61,7 @@ func (r Run) ToProto() *protos.Run {
 			RunId:          &r.Info.RunID,
 			RunUuid:        &r.Info.RunID,
 			RunName:        &r.Info.RunName,
-			ExperimentId:   utils.ConvertInt32PointerToStringPointer(&r.Info.ExperimentID),
+			ExperimentId:   &r.Info.ExperimentID,
 			UserId:         &r.Info.UserID,
 			Status:         RunStatusToProto(r.Info.Status),
 			StartTime:      &r.Info.StartTime,
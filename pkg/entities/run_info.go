@@ -9,7 +9,7 @@ type RunInfo struct {
 	RunID          string
 	RunUUID        string
 	RunName        string
-	ExperimentID   int32
+	ExperimentID   string
 	UserID         string
 	Status         string
 	StartTime      int64
@@ -23,7 +23,7 @@ func (ri RunInfo) ToProto() *protos.RunInfo {
 		RunId:          &ri.RunID,
 		RunUuid:        &ri.RunID,
 		RunName:        &ri.RunName,
-		ExperimentId:   utils.ConvertInt32PointerToStringPointer(&ri.ExperimentID),
+		ExperimentId:   &ri.ExperimentID,
 		UserId:         &ri.UserID,
 		Status:         RunStatusToProto(ri.Status),
 		StartTime:      &ri.StartTime,
@@ -7,6 +7,14 @@ import (
 	"unsafe"
 	"github.com/mlflow/mlflow-go/pkg/protos"
 )
+//export ModelRegistryServiceCreateRegisteredModel
+func ModelRegistryServiceCreateRegisteredModel(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := modelRegistryServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.CreateRegisteredModel, new(protos.CreateRegisteredModel), requestData, requestSize, responseSize)
+}
 //export ModelRegistryServiceRenameRegisteredModel
 func ModelRegistryServiceRenameRegisteredModel(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
 	service, err := modelRegistryServices.Get(serviceID)
@@ -39,6 +47,14 @@ func ModelRegistryServiceGetRegisteredModel(serviceID int64, requestData unsafe.
 	}
 	return invokeServiceMethod(service.GetRegisteredModel, new(protos.GetRegisteredModel), requestData, requestSize, responseSize)
 }
+//export ModelRegistryServiceSearchRegisteredModels
+func ModelRegistryServiceSearchRegisteredModels(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := modelRegistryServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.SearchRegisteredModels, new(protos.SearchRegisteredModels), requestData, requestSize, responseSize)
+}
 //export ModelRegistryServiceGetLatestVersions
 func ModelRegistryServiceGetLatestVersions(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
 	service, err := modelRegistryServices.Get(serviceID)
@@ -47,6 +63,14 @@ func ModelRegistryServiceGetLatestVersions(serviceID int64, requestData unsafe.P
 	}
 	return invokeServiceMethod(service.GetLatestVersions, new(protos.GetLatestVersions), requestData, requestSize, responseSize)
 }
+//export ModelRegistryServiceCreateModelVersion
+func ModelRegistryServiceCreateModelVersion(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := modelRegistryServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.CreateModelVersion, new(protos.CreateModelVersion), requestData, requestSize, responseSize)
+}
 //export ModelRegistryServiceUpdateModelVersion
 func ModelRegistryServiceUpdateModelVersion(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
 	service, err := modelRegistryServices.Get(serviceID)
@@ -55,6 +79,14 @@ func ModelRegistryServiceUpdateModelVersion(serviceID int64, requestData unsafe.
 	}
 	return invokeServiceMethod(service.UpdateModelVersion, new(protos.UpdateModelVersion), requestData, requestSize, responseSize)
 }
+//export ModelRegistryServiceTransitionModelVersionStage
+func ModelRegistryServiceTransitionModelVersionStage(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := modelRegistryServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.TransitionModelVersionStage, new(protos.TransitionModelVersionStage), requestData, requestSize, responseSize)
+}
 //export ModelRegistryServiceDeleteModelVersion
 func ModelRegistryServiceDeleteModelVersion(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
 	service, err := modelRegistryServices.Get(serviceID)
@@ -63,3 +95,83 @@ func ModelRegistryServiceDeleteModelVersion(serviceID int64, requestData unsafe.
 	}
 	return invokeServiceMethod(service.DeleteModelVersion, new(protos.DeleteModelVersion), requestData, requestSize, responseSize)
 }
+//export ModelRegistryServiceGetModelVersion
+func ModelRegistryServiceGetModelVersion(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := modelRegistryServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.GetModelVersion, new(protos.GetModelVersion), requestData, requestSize, responseSize)
+}
+//export ModelRegistryServiceSearchModelVersions
+func ModelRegistryServiceSearchModelVersions(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := modelRegistryServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.SearchModelVersions, new(protos.SearchModelVersions), requestData, requestSize, responseSize)
+}
+//export ModelRegistryServiceGetModelVersionDownloadUri
+func ModelRegistryServiceGetModelVersionDownloadUri(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := modelRegistryServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.GetModelVersionDownloadUri, new(protos.GetModelVersionDownloadUri), requestData, requestSize, responseSize)
+}
+//export ModelRegistryServiceSetRegisteredModelTag
+func ModelRegistryServiceSetRegisteredModelTag(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := modelRegistryServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.SetRegisteredModelTag, new(protos.SetRegisteredModelTag), requestData, requestSize, responseSize)
+}
+//export ModelRegistryServiceSetModelVersionTag
+func ModelRegistryServiceSetModelVersionTag(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := modelRegistryServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.SetModelVersionTag, new(protos.SetModelVersionTag), requestData, requestSize, responseSize)
+}
+//export ModelRegistryServiceDeleteRegisteredModelTag
+func ModelRegistryServiceDeleteRegisteredModelTag(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := modelRegistryServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.DeleteRegisteredModelTag, new(protos.DeleteRegisteredModelTag), requestData, requestSize, responseSize)
+}
+//export ModelRegistryServiceDeleteModelVersionTag
+func ModelRegistryServiceDeleteModelVersionTag(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := modelRegistryServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.DeleteModelVersionTag, new(protos.DeleteModelVersionTag), requestData, requestSize, responseSize)
+}
+//export ModelRegistryServiceSetRegisteredModelAlias
+func ModelRegistryServiceSetRegisteredModelAlias(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := modelRegistryServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.SetRegisteredModelAlias, new(protos.SetRegisteredModelAlias), requestData, requestSize, responseSize)
+}
+//export ModelRegistryServiceDeleteRegisteredModelAlias
+func ModelRegistryServiceDeleteRegisteredModelAlias(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := modelRegistryServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.DeleteRegisteredModelAlias, new(protos.DeleteRegisteredModelAlias), requestData, requestSize, responseSize)
+}
+//export ModelRegistryServiceGetModelVersionByAlias
+func ModelRegistryServiceGetModelVersionByAlias(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := modelRegistryServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.GetModelVersionByAlias, new(protos.GetModelVersionByAlias), requestData, requestSize, responseSize)
+}
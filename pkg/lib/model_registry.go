@@ -3,17 +3,51 @@ package main
 import "C"
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"unsafe"
 
+	"github.com/mlflow/mlflow-go/pkg/config"
 	"github.com/mlflow/mlflow-go/pkg/model_registry/service"
+	tracking "github.com/mlflow/mlflow-go/pkg/tracking/store"
 )
 
-var modelRegistryServices = newInstanceMap[*service.ModelRegistryService]()
+// modelRegistryService is the service of a Python store, with the tracking store it resolves runs:/ sources with.
+type modelRegistryService struct {
+	*service.ModelRegistryService
+	trackingStore tracking.TrackingStore
+}
+
+func (s *modelRegistryService) Destroy() error {
+	return errors.Join(s.ModelRegistryService.Destroy(), s.trackingStore.Destroy())
+}
+
+var modelRegistryServices = newInstanceMap[*modelRegistryService]()
+
+// newModelRegistryService creates the service of a Python store, which is configured with the URI of the model
+// registry store alone. The runs:/ sources are resolved with a tracking store of the URI the tracking store URI
+// defaults to, the one of the model registry store, like the server does with the store of its tracking service.
+func newModelRegistryService(ctx context.Context, cfg *config.Config) (*modelRegistryService, error) {
+	trackingStore, err := tracking.NewTrackingStore(ctx, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tracking store: %w", err)
+	}
+
+	registryService, err := service.NewModelRegistryService(ctx, cfg, trackingStore)
+	if err != nil {
+		_ = trackingStore.Destroy()
+
+		return nil, err
+	}
+
+	return &modelRegistryService{ModelRegistryService: registryService, trackingStore: trackingStore}, nil
+}
 
 //export CreateModelRegistryService
 func CreateModelRegistryService(configData unsafe.Pointer, configSize C.int) int64 {
 	//nolint:nlreturn
-	return modelRegistryServices.Create(service.NewModelRegistryService, C.GoBytes(configData, configSize))
+	return modelRegistryServices.Create(newModelRegistryService, C.GoBytes(configData, configSize))
 }
 
 //export DestroyModelRegistryService
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go/pkg/config"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/utils"
+)
+
+func TestModelRegistryServiceResolvesRunSources(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// The Python store configures the URI of the model registry store alone.
+	configData, err := json.Marshal(map[string]any{
+		"default_artifact_root":    t.TempDir(),
+		"create_database_schema":   true,
+		"model_registry_store_uri": "sqlite:///" + filepath.ToSlash(filepath.Join(t.TempDir(), "mlflow.db")),
+	})
+	require.NoError(t, err)
+
+	cfg, err := config.NewConfigFromBytes(configData)
+	require.NoError(t, err)
+
+	registryService, err := newModelRegistryService(ctx, cfg)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, registryService.Destroy())
+	})
+
+	experimentID, contractErr := registryService.trackingStore.CreateExperiment(ctx, "experiment", "", nil)
+	require.Nil(t, contractErr)
+
+	run, contractErr := registryService.trackingStore.CreateRun(ctx, experimentID, "user", 0, nil, "run")
+	require.Nil(t, contractErr)
+
+	_, contractErr = registryService.CreateRegisteredModel(ctx, &protos.CreateRegisteredModel{Name: utils.PtrTo("model")})
+	require.Nil(t, contractErr)
+
+	_, contractErr = registryService.CreateModelVersion(ctx, &protos.CreateModelVersion{
+		Name:   utils.PtrTo("model"),
+		Source: utils.PtrTo("runs:/" + run.Info.RunID + "/model"),
+	})
+	require.Nil(t, contractErr)
+
+	response, contractErr := registryService.GetModelVersionDownloadUri(ctx, &protos.GetModelVersionDownloadUri{
+		Name:    utils.PtrTo("model"),
+		Version: utils.PtrTo("1"),
+	})
+	require.Nil(t, contractErr)
+	assert.Equal(t, run.Info.ArtifactURI+"/model", response.GetArtifactUri())
+}
@@ -175,6 +175,14 @@ func TrackingServiceGetMetricHistory(serviceID int64, requestData unsafe.Pointer
 	}
 	return invokeServiceMethod(service.GetMetricHistory, new(protos.GetMetricHistory), requestData, requestSize, responseSize)
 }
+//export TrackingServiceGetMetricHistoryBulkInterval
+func TrackingServiceGetMetricHistoryBulkInterval(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.GetMetricHistoryBulkInterval, new(protos.GetMetricHistoryBulkInterval), requestData, requestSize, responseSize)
+}
 //export TrackingServiceLogBatch
 func TrackingServiceLogBatch(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
 	service, err := trackingServices.Get(serviceID)
@@ -215,6 +223,14 @@ func TrackingServiceGetTraceInfo(serviceID int64, requestData unsafe.Pointer, re
 	}
 	return invokeServiceMethod(service.GetTraceInfo, new(protos.GetTraceInfo), requestData, requestSize, responseSize)
 }
+//export TrackingServiceSearchTraces
+func TrackingServiceSearchTraces(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.SearchTraces, new(protos.SearchTraces), requestData, requestSize, responseSize)
+}
 //export TrackingServiceDeleteTraces
 func TrackingServiceDeleteTraces(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
 	service, err := trackingServices.Get(serviceID)
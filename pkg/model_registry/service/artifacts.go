@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/mlflow/mlflow-go/pkg/artifacts/repository"
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+)
+
+// Port of get_model_version_artifact_handler in mlflow/server/handlers.py.
+// Artifacts proxied through mlflow-artifacts:/ are read from the artifacts destination,
+// other locations are read directly.
+func (m *ModelRegistryService) DownloadModelVersionArtifact(
+	ctx context.Context, name, version, artifactPath string,
+) (io.ReadCloser, *contract.Error) {
+	artifactURI, contractError := m.getDownloadURI(ctx, name, version)
+	if contractError != nil {
+		return nil, contractError
+	}
+
+	location := artifactURI
+
+	uri, err := url.Parse(artifactURI)
+	if err == nil && uri.Scheme == "mlflow-artifacts" {
+		if m.config.ArtifactsDestination == "" {
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf(
+					"Artifacts of model version %s of %q are proxied, but artifacts serving is disabled",
+					version,
+					name,
+				),
+			)
+		}
+
+		location = m.config.ArtifactsDestination
+		artifactPath = path.Join(strings.TrimPrefix(uri.Path, "/"), artifactPath)
+	}
+
+	repo, err := repository.NewRepositoryFromURI(ctx, m.config, location)
+	if err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("Unable to read the artifacts of model version %s of %q", version, name),
+			err,
+		)
+	}
+
+	return repo.Download(ctx, artifactPath)
+}
@@ -2,11 +2,37 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/entities"
 	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/sql"
+	"github.com/mlflow/mlflow-go/pkg/utils"
 )
 
+func (m *ModelRegistryService) CreateRegisteredModel(
+	ctx context.Context, input *protos.CreateRegisteredModel,
+) (*protos.CreateRegisteredModel_Response, *contract.Error) {
+	tags := make([]*entities.RegisteredModelTag, 0, len(input.GetTags()))
+	for _, tag := range input.GetTags() {
+		tags = append(tags, entities.NewRegisteredModelTagFromProto(tag))
+	}
+
+	registeredModel, err := m.store.CreateRegisteredModel(ctx, input.GetName(), input.Description, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protos.CreateRegisteredModel_Response{
+		RegisteredModel: registeredModel.ToProto(),
+	}, nil
+}
+
 func (m *ModelRegistryService) GetLatestVersions(
 	ctx context.Context, input *protos.GetLatestVersions,
 ) (*protos.GetLatestVersions_Response, *contract.Error) {
@@ -77,6 +103,51 @@ func (m *ModelRegistryService) GetRegisteredModel(
 	}, nil
 }
 
+func (m *ModelRegistryService) SearchRegisteredModels(
+	ctx context.Context, input *protos.SearchRegisteredModels,
+) (*protos.SearchRegisteredModels_Response, *contract.Error) {
+	registeredModels, nextPageToken, err := m.store.SearchRegisteredModels(
+		ctx, input.GetFilter(), input.GetMaxResults(), input.GetOrderBy(), input.GetPageToken(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response := protos.SearchRegisteredModels_Response{
+		RegisteredModels: make([]*protos.RegisteredModel, 0, len(registeredModels)),
+	}
+
+	for _, registeredModel := range registeredModels {
+		response.RegisteredModels = append(response.RegisteredModels, registeredModel.ToProto())
+	}
+
+	if nextPageToken != "" {
+		response.NextPageToken = &nextPageToken
+	}
+
+	return &response, nil
+}
+
+func (m *ModelRegistryService) SetRegisteredModelTag(
+	ctx context.Context, input *protos.SetRegisteredModelTag,
+) (*protos.SetRegisteredModelTag_Response, *contract.Error) {
+	if err := m.store.SetRegisteredModelTag(ctx, input.GetName(), input.GetKey(), input.GetValue()); err != nil {
+		return nil, err
+	}
+
+	return &protos.SetRegisteredModelTag_Response{}, nil
+}
+
+func (m *ModelRegistryService) DeleteRegisteredModelTag(
+	ctx context.Context, input *protos.DeleteRegisteredModelTag,
+) (*protos.DeleteRegisteredModelTag_Response, *contract.Error) {
+	if err := m.store.DeleteRegisteredModelTag(ctx, input.GetName(), input.GetKey()); err != nil {
+		return nil, err
+	}
+
+	return &protos.DeleteRegisteredModelTag_Response{}, nil
+}
+
 func (m *ModelRegistryService) DeleteModelVersion(
 	ctx context.Context, input *protos.DeleteModelVersion,
 ) (*protos.DeleteModelVersion_Response, *contract.Error) {
@@ -99,3 +170,385 @@ func (m *ModelRegistryService) UpdateModelVersion(
 		ModelVersion: modelVersion.ToProto(),
 	}, nil
 }
+
+// Sources of the form runs:/<run_id>/<path> are resolved against the artifact URI of the run.
+//
+// Without a tracking store, they are stored as is.
+func (m *ModelRegistryService) getRunArtifactLocation(
+	ctx context.Context, source string, uri *url.URL,
+) (string, *contract.Error) {
+	if m.trackingStore == nil {
+		return source, nil
+	}
+
+	runID, artifactPath, _ := strings.Cut(strings.TrimPrefix(uri.Path, "/"), "/")
+	if uri.Host != "" || runID == "" {
+		return "", contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf(
+				"Not a proper runs:/ URI: %s. "+
+					"Runs URIs must be of the form 'runs:/<run_id>/run-relative/path/to/artifact'",
+				source,
+			),
+		)
+	}
+
+	run, contractError := m.trackingStore.GetRun(ctx, runID)
+	if contractError != nil {
+		return "", contractError
+	}
+
+	artifactURI := run.Info.ArtifactURI
+	if artifactURI == "" {
+		// Same layout as the artifact URI given to new runs by the tracking store.
+		defaultArtifactURI, err := utils.AppendToURIPath(
+			m.config.DefaultArtifactRoot, run.Info.ExperimentID, runID, "artifacts",
+		)
+		if err != nil {
+			return "", contract.NewErrorWith(
+				protos.ErrorCode_INTERNAL_ERROR,
+				fmt.Sprintf("failed to build the artifact URI of run %q", runID),
+				err,
+			)
+		}
+
+		artifactURI = defaultArtifactURI
+	}
+
+	if artifactPath == "" {
+		return artifactURI, nil
+	}
+
+	location, err := utils.AppendToURIPath(artifactURI, artifactPath)
+	if err != nil {
+		return "", contract.NewErrorWith(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("Unable to resolve runs URI %s", source),
+			err,
+		)
+	}
+
+	return location, nil
+}
+
+// Sources of the form models:/<name>/<version|stage|latest> or models:/<name>@<alias> point to another model version,
+// whose storage location is reused. Sources of the form runs:/<run_id>/<path> point to the artifacts of a run.
+//
+//nolint:cyclop
+func (m *ModelRegistryService) getStorageLocation(ctx context.Context, source string) (string, *contract.Error) {
+	uri, err := url.Parse(source)
+	if err != nil {
+		return source, nil
+	}
+
+	switch uri.Scheme {
+	case "runs":
+		return m.getRunArtifactLocation(ctx, source, uri)
+	case "models":
+	default:
+		return source, nil
+	}
+
+	newError := func(err error) *contract.Error {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("Unable to fetch model from model URI source artifact location '%s'.", source),
+			err,
+		)
+	}
+
+	parts := strings.Split(strings.Trim(uri.Path, "/"), "/")
+
+	// models:/<name>@<alias>
+	if name, alias, ok := strings.Cut(parts[0], "@"); ok && uri.Host == "" && len(parts) == 1 {
+		modelVersion, contractError := m.store.GetModelVersionByAlias(ctx, name, alias)
+		if contractError != nil {
+			return "", newError(contractError)
+		}
+
+		return modelVersion.StorageLocation, nil
+	}
+
+	if uri.Host != "" || len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", newError(contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("Not a proper models:/ URI: %s", source),
+		))
+	}
+
+	name, version := parts[0], parts[1]
+
+	if _, err := strconv.Atoi(version); err != nil {
+		var stages []string
+		if !strings.EqualFold(version, "latest") {
+			stages = []string{version}
+		}
+
+		latestVersions, contractError := m.store.GetLatestVersions(ctx, name, stages)
+		if contractError != nil {
+			return "", newError(contractError)
+		}
+
+		if len(latestVersions) == 0 {
+			return "", newError(contract.NewError(
+				protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+				fmt.Sprintf("No versions of model with name '%s' and stage '%s' found", name, version),
+			))
+		}
+
+		latest := 0
+		for _, latestVersion := range latestVersions {
+			if current, _ := strconv.Atoi(latestVersion.GetVersion()); current > latest {
+				latest = current
+			}
+		}
+
+		version = strconv.Itoa(latest)
+	}
+
+	modelVersion, contractError := m.store.GetModelVersion(ctx, name, version)
+	if contractError != nil {
+		return "", newError(contractError)
+	}
+
+	return modelVersion.StorageLocation, nil
+}
+
+func (m *ModelRegistryService) CreateModelVersion(
+	ctx context.Context, input *protos.CreateModelVersion,
+) (*protos.CreateModelVersion_Response, *contract.Error) {
+	// The source is resolved from the primary database, where the run or model version it refers to may
+	// have just been created.
+	storageLocation, err := m.getStorageLocation(sql.NewContextWithPrimary(ctx), input.GetSource())
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]*entities.ModelVersionTag, 0, len(input.GetTags()))
+	for _, tag := range input.GetTags() {
+		tags = append(tags, entities.NewModelVersionTagFromProto(tag))
+	}
+
+	modelVersion, err := m.store.CreateModelVersion(
+		ctx,
+		input.GetName(),
+		input.GetSource(),
+		input.GetRunId(),
+		tags,
+		input.GetRunLink(),
+		input.GetDescription(),
+		storageLocation,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protos.CreateModelVersion_Response{
+		ModelVersion: modelVersion.ToProto(),
+	}, nil
+}
+
+func (m *ModelRegistryService) GetModelVersion(
+	ctx context.Context, input *protos.GetModelVersion,
+) (*protos.GetModelVersion_Response, *contract.Error) {
+	modelVersion, err := m.store.GetModelVersion(ctx, input.GetName(), input.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	return &protos.GetModelVersion_Response{
+		ModelVersion: modelVersion.ToProto(),
+	}, nil
+}
+
+func (m *ModelRegistryService) SearchModelVersions(
+	ctx context.Context, input *protos.SearchModelVersions,
+) (*protos.SearchModelVersions_Response, *contract.Error) {
+	modelVersions, nextPageToken, err := m.store.SearchModelVersions(
+		ctx, input.GetFilter(), input.GetMaxResults(), input.GetOrderBy(), input.GetPageToken(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response := protos.SearchModelVersions_Response{
+		ModelVersions: make([]*protos.ModelVersion, 0, len(modelVersions)),
+	}
+
+	for _, modelVersion := range modelVersions {
+		response.ModelVersions = append(response.ModelVersions, modelVersion.ToProto())
+	}
+
+	if nextPageToken != "" {
+		response.NextPageToken = &nextPageToken
+	}
+
+	return &response, nil
+}
+
+// getDownloadURI returns where the artifacts of a model version are stored.
+// Model versions created before their source was resolved are resolved on read.
+func (m *ModelRegistryService) getDownloadURI(ctx context.Context, name, version string) (string, *contract.Error) {
+	modelVersion, err := m.store.GetModelVersion(ctx, name, version)
+	if err != nil {
+		return "", err
+	}
+
+	location := modelVersion.StorageLocation
+	if location == "" {
+		location = modelVersion.Source
+	}
+
+	return m.getStorageLocation(ctx, location)
+}
+
+func (m *ModelRegistryService) GetModelVersionDownloadUri( //nolint:revive,stylecheck
+	ctx context.Context, input *protos.GetModelVersionDownloadUri,
+) (*protos.GetModelVersionDownloadUri_Response, *contract.Error) {
+	artifactURI, err := m.getDownloadURI(ctx, input.GetName(), input.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	return &protos.GetModelVersionDownloadUri_Response{
+		ArtifactUri: &artifactURI,
+	}, nil
+}
+
+func (m *ModelRegistryService) TransitionModelVersionStage(
+	ctx context.Context, input *protos.TransitionModelVersionStage,
+) (*protos.TransitionModelVersionStage_Response, *contract.Error) {
+	modelVersion, err := m.store.TransitionModelVersionStage(
+		ctx, input.GetName(), input.GetVersion(), input.GetStage(), input.GetArchiveExistingVersions(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protos.TransitionModelVersionStage_Response{
+		ModelVersion: modelVersion.ToProto(),
+	}, nil
+}
+
+func (m *ModelRegistryService) SetModelVersionTag(
+	ctx context.Context, input *protos.SetModelVersionTag,
+) (*protos.SetModelVersionTag_Response, *contract.Error) {
+	if err := m.store.SetModelVersionTag(
+		ctx, input.GetName(), input.GetVersion(), input.GetKey(), input.GetValue(),
+	); err != nil {
+		return nil, err
+	}
+
+	return &protos.SetModelVersionTag_Response{}, nil
+}
+
+func (m *ModelRegistryService) DeleteModelVersionTag(
+	ctx context.Context, input *protos.DeleteModelVersionTag,
+) (*protos.DeleteModelVersionTag_Response, *contract.Error) {
+	if err := m.store.DeleteModelVersionTag(ctx, input.GetName(), input.GetVersion(), input.GetKey()); err != nil {
+		return nil, err
+	}
+
+	return &protos.DeleteModelVersionTag_Response{}, nil
+}
+
+const (
+	maxAliasLength      = 255
+	reservedAliasLatest = "latest"
+)
+
+var (
+	aliasRegex        = regexp.MustCompile(`^[\w\-]*$`)
+	versionAliasRegex = regexp.MustCompile(`(?i)^v\d+$`)
+)
+
+func validateAlias(alias string) *contract.Error {
+	switch {
+	case alias == "":
+		return contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			"Registered model alias name cannot be empty.",
+		)
+	case !aliasRegex.MatchString(alias):
+		return contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf(
+				"Invalid alias name: '%s'. Names may only contain alphanumerics, underscores, and dashes.",
+				alias,
+			),
+		)
+	case len(alias) > maxAliasLength:
+		return contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf(
+				"Registered model alias name '%s' had length %d, which exceeded length limit of %d",
+				alias,
+				len(alias),
+				maxAliasLength,
+			),
+		)
+	case versionAliasRegex.MatchString(alias):
+		return contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf(
+				"Invalid alias name: '%s'. Aliases of the format 'v<version>' (e.g. v1, v2) are reserved for internal "+
+					"use. Please use a different alias name.",
+				alias,
+			),
+		)
+	case strings.EqualFold(alias, reservedAliasLatest):
+		return contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("'%s' alias name (case insensitive) is reserved.", alias),
+		)
+	}
+
+	return nil
+}
+
+func (m *ModelRegistryService) SetRegisteredModelAlias(
+	ctx context.Context, input *protos.SetRegisteredModelAlias,
+) (*protos.SetRegisteredModelAlias_Response, *contract.Error) {
+	if err := validateAlias(input.GetAlias()); err != nil {
+		return nil, err
+	}
+
+	if err := m.store.SetRegisteredModelAlias(
+		ctx, input.GetName(), input.GetAlias(), input.GetVersion(),
+	); err != nil {
+		return nil, err
+	}
+
+	return &protos.SetRegisteredModelAlias_Response{}, nil
+}
+
+func (m *ModelRegistryService) DeleteRegisteredModelAlias(
+	ctx context.Context, input *protos.DeleteRegisteredModelAlias,
+) (*protos.DeleteRegisteredModelAlias_Response, *contract.Error) {
+	if err := validateAlias(input.GetAlias()); err != nil {
+		return nil, err
+	}
+
+	if err := m.store.DeleteRegisteredModelAlias(ctx, input.GetName(), input.GetAlias()); err != nil {
+		return nil, err
+	}
+
+	return &protos.DeleteRegisteredModelAlias_Response{}, nil
+}
+
+func (m *ModelRegistryService) GetModelVersionByAlias(
+	ctx context.Context, input *protos.GetModelVersionByAlias,
+) (*protos.GetModelVersionByAlias_Response, *contract.Error) {
+	if err := validateAlias(input.GetAlias()); err != nil {
+		return nil, err
+	}
+
+	modelVersion, err := m.store.GetModelVersionByAlias(ctx, input.GetName(), input.GetAlias())
+	if err != nil {
+		return nil, err
+	}
+
+	return &protos.GetModelVersionByAlias_Response{
+		ModelVersion: modelVersion.ToProto(),
+	}, nil
+}
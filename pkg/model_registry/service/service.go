@@ -4,28 +4,46 @@ import (
 	"context"
 	"fmt"
 
+	tracking "github.com/mlflow/mlflow-go/pkg/tracking/store"
+
 	"github.com/mlflow/mlflow-go/pkg/config"
 	"github.com/mlflow/mlflow-go/pkg/model_registry/store"
-	"github.com/mlflow/mlflow-go/pkg/model_registry/store/sql"
+
+	// Registers the memory and SQL model registry stores.
+	_ "github.com/mlflow/mlflow-go/pkg/model_registry/store/memory"
+	_ "github.com/mlflow/mlflow-go/pkg/model_registry/store/sql"
 )
 
 type ModelRegistryService struct {
-	store  store.ModelRegistryStore
-	config *config.Config
+	store store.ModelRegistryStore
+	// trackingStore resolves runs:/ sources, unless it's nil.
+	// It belongs to the caller, such as the tracking service, which destroys it.
+	trackingStore tracking.TrackingStore
+	config        *config.Config
 }
 
-func NewModelRegistryService(ctx context.Context, config *config.Config) (*ModelRegistryService, error) {
-	store, err := sql.NewModelRegistrySQLStore(ctx, config)
+// NewModelRegistryService creates the service and its store. The runs:/ sources are resolved with trackingStore,
+// such as the store of the tracking service, unless it's nil.
+func NewModelRegistryService(
+	ctx context.Context, config *config.Config, trackingStore tracking.TrackingStore,
+) (*ModelRegistryService, error) {
+	store, err := store.NewModelRegistryStore(ctx, config)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create new sql store: %w", err)
+		return nil, fmt.Errorf("failed to create new store: %w", err)
 	}
 
 	return &ModelRegistryService{
-		store:  store,
-		config: config,
+		store:         store,
+		trackingStore: trackingStore,
+		config:        config,
 	}, nil
 }
 
+// Store returns the store of the service, for the server to report on it.
+func (m *ModelRegistryService) Store() store.ModelRegistryStore {
+	return m.store
+}
+
 func (m *ModelRegistryService) Destroy() error {
 	if err := m.store.Destroy(); err != nil {
 		return fmt.Errorf("failed to close store: %w", err)
@@ -0,0 +1,324 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/entities"
+	"github.com/mlflow/mlflow-go/pkg/model_registry/store/sql/models"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+)
+
+type modelVersion struct {
+	version         int32
+	creationTime    int64
+	lastUpdatedTime int64
+	description     string
+	userID          string
+	currentStage    string
+	source          string
+	runID           string
+	status          string
+	statusMessage   string
+	runLink         string
+	storageLocation string
+	tags            map[string]string
+}
+
+// toEntity returns the version of registeredModel, which holds its name and aliases.
+func (v *modelVersion) toEntity(registeredModel *registeredModel) *entities.ModelVersion {
+	modelVersion := &entities.ModelVersion{
+		Name:            registeredModel.name,
+		Version:         v.version,
+		CreationTime:    v.creationTime,
+		LastUpdatedTime: v.lastUpdatedTime,
+		Description:     v.description,
+		UserID:          v.userID,
+		CurrentStage:    v.currentStage,
+		Source:          v.source,
+		RunID:           v.runID,
+		Status:          v.status,
+		StatusMessage:   v.statusMessage,
+		RunLink:         v.runLink,
+		StorageLocation: v.storageLocation,
+		Tags:            make([]*entities.ModelVersionTag, 0, len(v.tags)),
+		Aliases:         make([]string, 0),
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(v.tags)) {
+		modelVersion.Tags = append(modelVersion.Tags, &entities.ModelVersionTag{Key: key, Value: v.tags[key]})
+	}
+
+	for _, alias := range slices.Sorted(maps.Keys(registeredModel.aliases)) {
+		if registeredModel.aliases[alias] == v.version {
+			modelVersion.Aliases = append(modelVersion.Aliases, alias)
+		}
+	}
+
+	return modelVersion
+}
+
+// getModelVersion returns a version which isn't deleted, along with its model.
+func (m *ModelRegistryMemoryStore) getModelVersion(
+	name, version string,
+) (*registeredModel, *modelVersion, *contract.Error) {
+	notFoundError := contract.NewError(
+		protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+		fmt.Sprintf("Model Version (name=%s, version=%s) not found", name, version),
+	)
+
+	registeredModel, ok := m.registeredModels[name]
+	if !ok {
+		return nil, nil, notFoundError
+	}
+
+	number, err := strconv.ParseInt(version, 10, 32)
+	if err != nil || number < 1 || int(number) > len(registeredModel.versions) {
+		return nil, nil, notFoundError
+	}
+
+	modelVersion := registeredModel.versions[number-1]
+	if modelVersion.currentStage == models.StageDeletedInternal {
+		return nil, nil, notFoundError
+	}
+
+	return registeredModel, modelVersion, nil
+}
+
+func (m *ModelRegistryMemoryStore) GetLatestVersions(
+	_ context.Context, name string, stages []string,
+) ([]*protos.ModelVersion, *contract.Error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	registeredModel, err := m.assertModelExists(name)
+	if err != nil {
+		return nil, err
+	}
+
+	canonicalStages := make([]string, 0, len(stages))
+
+	for _, stage := range stages {
+		canonicalStage, ok := models.CanonicalMapping[strings.ToLower(stage)]
+		if !ok {
+			return nil, contract.NewError(
+				protos.ErrorCode_BAD_REQUEST,
+				fmt.Sprintf(
+					"Invalid Model Version stage: %s. Value must be one of %s.",
+					stage,
+					models.AllModelVersionStages(),
+				),
+			)
+		}
+
+		canonicalStages = append(canonicalStages, canonicalStage)
+	}
+
+	latestVersions := registeredModel.latestVersions(canonicalStages)
+
+	results := make([]*protos.ModelVersion, 0, len(latestVersions))
+	for _, modelVersion := range latestVersions {
+		results = append(results, modelVersion.toEntity(registeredModel).ToProto())
+	}
+
+	return results, nil
+}
+
+func (m *ModelRegistryMemoryStore) CreateModelVersion(
+	_ context.Context,
+	name, source, runID string,
+	tags []*entities.ModelVersionTag,
+	runLink, description, storageLocation string,
+) (*entities.ModelVersion, *contract.Error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	registeredModel, err := m.getRegisteredModel(name)
+	if err != nil {
+		return nil, err
+	}
+
+	creationTime := now()
+	modelVersion := &modelVersion{
+		// Deleted versions are kept with a special stage, so numbers are never reused.
+		version:         int32(len(registeredModel.versions) + 1), //nolint:gosec
+		creationTime:    creationTime,
+		lastUpdatedTime: creationTime,
+		description:     description,
+		currentStage:    models.ModelVersionStageNone,
+		source:          source,
+		runID:           runID,
+		status:          protos.ModelVersionStatus_READY.String(),
+		runLink:         runLink,
+		storageLocation: storageLocation,
+		tags:            make(map[string]string, len(tags)),
+	}
+
+	// Like mlflow, the last value wins when the same key is given twice.
+	for _, tag := range tags {
+		modelVersion.tags[tag.Key] = tag.Value
+	}
+
+	registeredModel.versions = append(registeredModel.versions, modelVersion)
+	registeredModel.lastUpdatedTime = creationTime
+
+	return modelVersion.toEntity(registeredModel), nil
+}
+
+func (m *ModelRegistryMemoryStore) GetModelVersion(
+	_ context.Context, name, version string,
+) (*entities.ModelVersion, *contract.Error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	registeredModel, modelVersion, err := m.getModelVersion(name, version)
+	if err != nil {
+		return nil, err
+	}
+
+	return modelVersion.toEntity(registeredModel), nil
+}
+
+// DeleteModelVersion keeps the version with its details redacted, like the SQL store.
+func (m *ModelRegistryMemoryStore) DeleteModelVersion(_ context.Context, name, version string) *contract.Error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, err := m.getRegisteredModel(name); err != nil {
+		return err
+	}
+
+	registeredModel, modelVersion, err := m.getModelVersion(name, version)
+	if err != nil {
+		return err
+	}
+
+	updateTime := now()
+	registeredModel.lastUpdatedTime = updateTime
+
+	maps.DeleteFunc(registeredModel.aliases, func(_ string, aliasVersion int32) bool {
+		return aliasVersion == modelVersion.version
+	})
+
+	modelVersion.runID = "REDACTED-RUN-ID"
+	modelVersion.userID = ""
+	modelVersion.source = "REDACTED-SOURCE-PATH"
+	modelVersion.runLink = "REDACTED-RUN-LINK"
+	modelVersion.currentStage = models.StageDeletedInternal
+	modelVersion.description = ""
+	modelVersion.statusMessage = ""
+	modelVersion.lastUpdatedTime = updateTime
+
+	return nil
+}
+
+func (m *ModelRegistryMemoryStore) UpdateModelVersion(
+	_ context.Context, name, version, description string,
+) (*entities.ModelVersion, *contract.Error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	registeredModel, modelVersion, err := m.getModelVersion(name, version)
+	if err != nil {
+		return nil, err
+	}
+
+	modelVersion.description = description
+	modelVersion.lastUpdatedTime = now()
+
+	return modelVersion.toEntity(registeredModel), nil
+}
+
+func (m *ModelRegistryMemoryStore) TransitionModelVersionStage(
+	_ context.Context, name, version, stage string, archiveExistingVersions bool,
+) (*entities.ModelVersion, *contract.Error) {
+	canonicalStage, ok := models.CanonicalMapping[strings.ToLower(stage)]
+	if !ok {
+		return nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf(
+				"Invalid Model Version stage: %s. Value must be one of %s.",
+				stage,
+				models.AllModelVersionStages(),
+			),
+		)
+	}
+
+	if archiveExistingVersions &&
+		canonicalStage != models.ModelVersionStageStaging &&
+		canonicalStage != models.ModelVersionStageProduction {
+		return nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf(
+				"Model version transition cannot archive existing model versions because '%s' is not an Active stage. "+
+					"Valid stages are ['%s', '%s']",
+				stage,
+				models.ModelVersionStageStaging,
+				models.ModelVersionStageProduction,
+			),
+		)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	registeredModel, modelVersion, err := m.getModelVersion(name, version)
+	if err != nil {
+		return nil, err
+	}
+
+	lastUpdatedTime := now()
+
+	if archiveExistingVersions {
+		for _, otherVersion := range registeredModel.versions {
+			if otherVersion != modelVersion && otherVersion.currentStage == canonicalStage {
+				otherVersion.currentStage = models.ModelVersionStageArchived
+				otherVersion.lastUpdatedTime = lastUpdatedTime
+			}
+		}
+	}
+
+	modelVersion.currentStage = canonicalStage
+	modelVersion.lastUpdatedTime = lastUpdatedTime
+	registeredModel.lastUpdatedTime = lastUpdatedTime
+
+	return modelVersion.toEntity(registeredModel), nil
+}
+
+func (m *ModelRegistryMemoryStore) SetModelVersionTag(
+	_ context.Context, name, version, key, value string,
+) *contract.Error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	_, modelVersion, err := m.getModelVersion(name, version)
+	if err != nil {
+		return err
+	}
+
+	modelVersion.tags[key] = value
+
+	return nil
+}
+
+// DeleteModelVersionTag doesn't fail when the tag doesn't exist, like mlflow.
+func (m *ModelRegistryMemoryStore) DeleteModelVersionTag(
+	_ context.Context, name, version, key string,
+) *contract.Error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	_, modelVersion, err := m.getModelVersion(name, version)
+	if err != nil {
+		return err
+	}
+
+	delete(modelVersion.tags, key)
+
+	return nil
+}
@@ -0,0 +1,309 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/entities"
+	"github.com/mlflow/mlflow-go/pkg/model_registry/store/sql/models"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/utils"
+)
+
+type registeredModel struct {
+	name            string
+	description     *string
+	creationTime    int64
+	lastUpdatedTime int64
+	tags            map[string]string
+	// aliases are the versions of the aliases of the model.
+	aliases map[string]int32
+	// versions are the versions of the model by number minus one, the deleted ones included
+	// so that their numbers are never reused.
+	versions []*modelVersion
+}
+
+// latestVersions returns the latest version of every stage of the model, ordered by version.
+func (m *registeredModel) latestVersions(stages []string) []*modelVersion {
+	latestVersions := make(map[string]*modelVersion)
+
+	for _, version := range m.versions {
+		if version.currentStage == models.StageDeletedInternal ||
+			(len(stages) > 0 && !slices.Contains(stages, version.currentStage)) {
+			continue
+		}
+
+		latestVersions[version.currentStage] = version
+	}
+
+	return slices.SortedFunc(maps.Values(latestVersions), func(a, b *modelVersion) int {
+		return cmp.Compare(a.version, b.version)
+	})
+}
+
+func (m *registeredModel) toEntity() *entities.RegisteredModel {
+	registeredModel := &entities.RegisteredModel{
+		Name:            m.name,
+		Tags:            make([]*entities.RegisteredModelTag, 0, len(m.tags)),
+		Aliases:         make([]*entities.RegisteredModelAlias, 0, len(m.aliases)),
+		Versions:        make([]*entities.ModelVersion, 0),
+		Description:     clonePtr(m.description),
+		CreationTime:    m.creationTime,
+		LastUpdatedTime: m.lastUpdatedTime,
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(m.tags)) {
+		registeredModel.Tags = append(registeredModel.Tags, &entities.RegisteredModelTag{Key: key, Value: m.tags[key]})
+	}
+
+	for _, alias := range slices.Sorted(maps.Keys(m.aliases)) {
+		registeredModel.Aliases = append(registeredModel.Aliases, &entities.RegisteredModelAlias{
+			Alias:   alias,
+			Version: strconv.Itoa(int(m.aliases[alias])),
+		})
+	}
+
+	for _, version := range m.latestVersions(nil) {
+		registeredModel.Versions = append(registeredModel.Versions, version.toEntity(m))
+	}
+
+	return registeredModel
+}
+
+func clonePtr[T any](value *T) *T {
+	if value == nil {
+		return nil
+	}
+
+	return utils.PtrTo(*value)
+}
+
+func (m *ModelRegistryMemoryStore) getRegisteredModel(name string) (*registeredModel, *contract.Error) {
+	registeredModel, ok := m.registeredModels[name]
+	if !ok {
+		return nil, contract.NewError(
+			protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+			fmt.Sprintf("Registered Model with name=%s not found", name),
+		)
+	}
+
+	return registeredModel, nil
+}
+
+// assertModelExists returns the model, with the error of the SQL store's function of the same name.
+func (m *ModelRegistryMemoryStore) assertModelExists(name string) (*registeredModel, *contract.Error) {
+	registeredModel, ok := m.registeredModels[name]
+	if !ok {
+		return nil, contract.NewError(
+			protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+			fmt.Sprintf("registered model with name=%q not found", name),
+		)
+	}
+
+	return registeredModel, nil
+}
+
+func (m *ModelRegistryMemoryStore) CreateRegisteredModel(
+	_ context.Context, name string, description *string, tags []*entities.RegisteredModelTag,
+) (*entities.RegisteredModel, *contract.Error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, ok := m.registeredModels[name]; ok {
+		return nil, contract.NewError(
+			protos.ErrorCode_RESOURCE_ALREADY_EXISTS,
+			fmt.Sprintf("Registered Model (name=%s) already exists.", name),
+		)
+	}
+
+	creationTime := now()
+	registeredModel := &registeredModel{
+		name:            name,
+		description:     clonePtr(description),
+		creationTime:    creationTime,
+		lastUpdatedTime: creationTime,
+		tags:            make(map[string]string, len(tags)),
+		aliases:         make(map[string]int32),
+		versions:        make([]*modelVersion, 0),
+	}
+
+	// Like mlflow, the last value wins when the same key is given twice.
+	for _, tag := range tags {
+		registeredModel.tags[tag.Key] = tag.Value
+	}
+
+	m.registeredModels[name] = registeredModel
+
+	return registeredModel.toEntity(), nil
+}
+
+func (m *ModelRegistryMemoryStore) GetRegisteredModel(
+	_ context.Context, name string,
+) (*entities.RegisteredModel, *contract.Error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	registeredModel, err := m.getRegisteredModel(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return registeredModel.toEntity(), nil
+}
+
+func (m *ModelRegistryMemoryStore) UpdateRegisteredModel(
+	_ context.Context, name, description string,
+) (*entities.RegisteredModel, *contract.Error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	registeredModel, err := m.getRegisteredModel(name)
+	if err != nil {
+		return nil, err
+	}
+
+	registeredModel.description = &description
+	registeredModel.lastUpdatedTime = now()
+
+	return registeredModel.toEntity(), nil
+}
+
+func (m *ModelRegistryMemoryStore) RenameRegisteredModel(
+	_ context.Context, name, newName string,
+) (*entities.RegisteredModel, *contract.Error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	registeredModel, err := m.getRegisteredModel(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := m.registeredModels[newName]; ok && newName != name {
+		return nil, contract.NewError(
+			protos.ErrorCode_RESOURCE_ALREADY_EXISTS,
+			fmt.Sprintf("Registered Model (name=%s) already exists", newName),
+		)
+	}
+
+	updateTime := now()
+
+	for _, version := range registeredModel.versions {
+		version.lastUpdatedTime = updateTime
+	}
+
+	delete(m.registeredModels, name)
+
+	registeredModel.name = newName
+	registeredModel.lastUpdatedTime = updateTime
+	m.registeredModels[newName] = registeredModel
+
+	return registeredModel.toEntity(), nil
+}
+
+func (m *ModelRegistryMemoryStore) DeleteRegisteredModel(_ context.Context, name string) *contract.Error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, err := m.getRegisteredModel(name); err != nil {
+		return err
+	}
+
+	delete(m.registeredModels, name)
+
+	return nil
+}
+
+func (m *ModelRegistryMemoryStore) SetRegisteredModelTag(_ context.Context, name, key, value string) *contract.Error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	registeredModel, err := m.assertModelExists(name)
+	if err != nil {
+		return err
+	}
+
+	registeredModel.tags[key] = value
+
+	return nil
+}
+
+// DeleteRegisteredModelTag doesn't fail when the tag doesn't exist, like mlflow.
+func (m *ModelRegistryMemoryStore) DeleteRegisteredModelTag(_ context.Context, name, key string) *contract.Error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	registeredModel, err := m.assertModelExists(name)
+	if err != nil {
+		return err
+	}
+
+	delete(registeredModel.tags, key)
+
+	return nil
+}
+
+func (m *ModelRegistryMemoryStore) SetRegisteredModelAlias(
+	_ context.Context, name, alias, version string,
+) *contract.Error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	registeredModel, modelVersion, err := m.getModelVersion(name, version)
+	if err != nil {
+		return err
+	}
+
+	registeredModel.aliases[alias] = modelVersion.version
+	registeredModel.lastUpdatedTime = now()
+
+	return nil
+}
+
+// DeleteRegisteredModelAlias doesn't fail when the alias doesn't exist, like mlflow.
+func (m *ModelRegistryMemoryStore) DeleteRegisteredModelAlias(_ context.Context, name, alias string) *contract.Error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	registeredModel, err := m.assertModelExists(name)
+	if err != nil {
+		return err
+	}
+
+	delete(registeredModel.aliases, alias)
+	registeredModel.lastUpdatedTime = now()
+
+	return nil
+}
+
+func (m *ModelRegistryMemoryStore) GetModelVersionByAlias(
+	_ context.Context, name, alias string,
+) (*entities.ModelVersion, *contract.Error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	registeredModel, err := m.assertModelExists(name)
+	if err != nil {
+		return nil, err
+	}
+
+	version, ok := registeredModel.aliases[alias]
+	if !ok {
+		return nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("Registered model alias %s not found.", alias),
+		)
+	}
+
+	_, modelVersion, err := m.getModelVersion(name, strconv.Itoa(int(version)))
+	if err != nil {
+		return nil, err
+	}
+
+	return modelVersion.toEntity(registeredModel), nil
+}
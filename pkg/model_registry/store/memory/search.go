@@ -0,0 +1,265 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/entities"
+	"github.com/mlflow/mlflow-go/pkg/model_registry/store/sql/models"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/tracking/service/query"
+	"github.com/mlflow/mlflow-go/pkg/tracking/service/query/parser"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store/search"
+	"github.com/mlflow/mlflow-go/pkg/utils"
+)
+
+// The bounds of max_results, same as the SQL store.
+const (
+	searchRegisteredModelsMaxResultsThreshold = 1000
+	searchModelVersionsMaxResultsThreshold    = 200000
+)
+
+// The attributes of the order_by clauses which aren't filter attributes.
+const (
+	creationTimestamp    = "creation_timestamp"
+	lastUpdatedTimestamp = "last_updated_timestamp"
+)
+
+func validateMaxResults(maxResults int64, threshold int) *contract.Error {
+	if maxResults < 1 || maxResults > int64(threshold) {
+		return contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf(
+				"Invalid value for request parameter max_results. "+
+					"It must be at least 1 and at most %d, but got value %d",
+				threshold,
+				maxResults,
+			),
+		)
+	}
+
+	return nil
+}
+
+func parseSearchFilter(
+	ctx context.Context, filter string, parse func(string) ([]*parser.ValidCompareExpr, error),
+) ([]*parser.ValidCompareExpr, *contract.Error) {
+	filterConditions, err := parse(filter)
+	if err != nil {
+		return nil, query.NewFilterError(err)
+	}
+
+	utils.GetLoggerFromContext(ctx).Debugf("Filter conditions: %v", filterConditions)
+
+	return filterConditions, nil
+}
+
+var orderByRegExp = regexp.MustCompile(`^(?:attr(?:ibutes?)?\.)?(\w+)(?i:\s+(ASC|DESC))?$`)
+
+// parseOrderBy returns the order keys of orderBy, followed by the tiebreakers which aren't already part of it.
+func parseOrderBy(
+	orderBy []string, orderAttributes map[string]string, tiebreakers []search.OrderKey,
+) ([]search.OrderKey, *contract.Error) {
+	orderKeys := make([]search.OrderKey, 0, len(orderBy)+len(tiebreakers))
+	ordered := map[string]bool{}
+
+	for _, order := range orderBy {
+		match := orderByRegExp.FindStringSubmatch(strings.TrimSpace(order))
+
+		var attribute string
+		if match != nil {
+			attribute = orderAttributes[match[1]]
+		}
+
+		if attribute == "" {
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf(
+					"Invalid order by key %q specified. Valid keys are %v",
+					order,
+					slices.Sorted(maps.Keys(orderAttributes)),
+				),
+			)
+		}
+
+		if ordered[attribute] {
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("`order_by` contains duplicate fields: %v", orderBy),
+			)
+		}
+
+		ordered[attribute] = true
+		orderKeys = append(orderKeys, search.OrderKey{
+			Identifier: parser.Attribute,
+			Key:        attribute,
+			Desc:       strings.EqualFold(match[2], "DESC"),
+		})
+	}
+
+	for _, tiebreaker := range tiebreakers {
+		if !ordered[tiebreaker.Key] {
+			orderKeys = append(orderKeys, tiebreaker)
+		}
+	}
+
+	return orderKeys, nil
+}
+
+func registeredModelValues(registeredModel *entities.RegisteredModel) search.Lookup {
+	return func(identifier parser.ValidIdentifier, key string) []any {
+		if identifier == parser.Tag {
+			for _, tag := range registeredModel.Tags {
+				if tag.Key == key {
+					return []any{tag.Value}
+				}
+			}
+
+			return nil
+		}
+
+		switch key {
+		case parser.ModelName:
+			return []any{registeredModel.Name}
+		case lastUpdatedTimestamp:
+			return []any{registeredModel.LastUpdatedTime}
+		default:
+			return nil
+		}
+	}
+}
+
+func modelVersionValues(modelVersion *entities.ModelVersion) search.Lookup {
+	return func(identifier parser.ValidIdentifier, key string) []any {
+		if identifier == parser.Tag {
+			for _, tag := range modelVersion.Tags {
+				if tag.Key == key {
+					return []any{tag.Value}
+				}
+			}
+
+			return nil
+		}
+
+		switch key {
+		case parser.ModelName:
+			return []any{modelVersion.Name}
+		case parser.ModelVersionRunID:
+			return []any{modelVersion.RunID}
+		case parser.ModelVersionSourcePath:
+			return []any{modelVersion.Source}
+		case parser.ModelVersionNumber:
+			return []any{int64(modelVersion.Version)}
+		case creationTimestamp:
+			return []any{modelVersion.CreationTime}
+		case lastUpdatedTimestamp:
+			return []any{modelVersion.LastUpdatedTime}
+		default:
+			return nil
+		}
+	}
+}
+
+func (m *ModelRegistryMemoryStore) SearchRegisteredModels(
+	ctx context.Context, filter string, maxResults int64, orderBy []string, pageToken string,
+) ([]*entities.RegisteredModel, string, *contract.Error) {
+	if err := validateMaxResults(maxResults, searchRegisteredModelsMaxResultsThreshold); err != nil {
+		return nil, "", err
+	}
+
+	filterConditions, contractError := parseSearchFilter(ctx, filter, query.ParseRegisteredModelFilter)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	offset, contractError := search.ParsePageToken(pageToken)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	orderKeys, contractError := parseOrderBy(orderBy, map[string]string{
+		"name":               parser.ModelName,
+		"timestamp":          lastUpdatedTimestamp,
+		lastUpdatedTimestamp: lastUpdatedTimestamp,
+	}, []search.OrderKey{
+		{Identifier: parser.Attribute, Key: parser.ModelName},
+	})
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	registeredModels := make([]*entities.RegisteredModel, 0)
+
+	for _, registeredModel := range m.registeredModels {
+		entity := registeredModel.toEntity()
+		if search.MatchesAll(filterConditions, registeredModelValues(entity)) {
+			registeredModels = append(registeredModels, entity)
+		}
+	}
+
+	search.Sort(registeredModels, orderKeys, registeredModelValues)
+
+	return search.Paginate(registeredModels, offset, int(maxResults))
+}
+
+func (m *ModelRegistryMemoryStore) SearchModelVersions(
+	ctx context.Context, filter string, maxResults int64, orderBy []string, pageToken string,
+) ([]*entities.ModelVersion, string, *contract.Error) {
+	if err := validateMaxResults(maxResults, searchModelVersionsMaxResultsThreshold); err != nil {
+		return nil, "", err
+	}
+
+	filterConditions, contractError := parseSearchFilter(ctx, filter, query.ParseModelVersionFilter)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	offset, contractError := search.ParsePageToken(pageToken)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	orderKeys, contractError := parseOrderBy(orderBy, map[string]string{
+		"name":               parser.ModelName,
+		"version_number":     parser.ModelVersionNumber,
+		creationTimestamp:    creationTimestamp,
+		"timestamp":          lastUpdatedTimestamp,
+		lastUpdatedTimestamp: lastUpdatedTimestamp,
+	}, []search.OrderKey{
+		{Identifier: parser.Attribute, Key: parser.ModelName},
+		{Identifier: parser.Attribute, Key: parser.ModelVersionNumber, Desc: true},
+	})
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	modelVersions := make([]*entities.ModelVersion, 0)
+
+	for _, registeredModel := range m.registeredModels {
+		for _, modelVersion := range registeredModel.versions {
+			if modelVersion.currentStage == models.StageDeletedInternal {
+				continue
+			}
+
+			entity := modelVersion.toEntity(registeredModel)
+			if search.MatchesAll(filterConditions, modelVersionValues(entity)) {
+				modelVersions = append(modelVersions, entity)
+			}
+		}
+	}
+
+	search.Sort(modelVersions, orderKeys, modelVersionValues)
+
+	return search.Paginate(modelVersions, offset, int(maxResults))
+}
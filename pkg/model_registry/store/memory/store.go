@@ -0,0 +1,55 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/mlflow/mlflow-go/pkg/config"
+	"github.com/mlflow/mlflow-go/pkg/model_registry/store"
+)
+
+// Scheme is the scheme of the URIs of the memory stores, such as memory://.
+const Scheme = "memory"
+
+// Like the SQL stores, the memory store is registered when the package is imported.
+//
+//nolint:gochecknoinits
+func init() {
+	store.RegisterModelRegistryStore(
+		Scheme,
+		func(ctx context.Context, config *config.Config) (store.ModelRegistryStore, error) {
+			return NewModelRegistryMemoryStore(ctx, config)
+		},
+	)
+}
+
+// ModelRegistryMemoryStore keeps the registered models and their versions in memory, like a fresh SQL database would,
+// for the tests and the servers which don't need to persist them. Its data is lost when it's destroyed.
+type ModelRegistryMemoryStore struct {
+	config *config.Config
+	mutex  sync.RWMutex
+
+	registeredModels map[string]*registeredModel
+}
+
+func NewModelRegistryMemoryStore(_ context.Context, config *config.Config) (*ModelRegistryMemoryStore, error) {
+	return &ModelRegistryMemoryStore{
+		config:           config,
+		mutex:            sync.RWMutex{},
+		registeredModels: make(map[string]*registeredModel),
+	}, nil
+}
+
+func (m *ModelRegistryMemoryStore) Destroy() error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.registeredModels = make(map[string]*registeredModel)
+
+	return nil
+}
+
+func now() int64 {
+	return time.Now().UnixMilli()
+}
@@ -0,0 +1,32 @@
+package memory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go/pkg/config"
+	"github.com/mlflow/mlflow-go/pkg/model_registry/store"
+	"github.com/mlflow/mlflow-go/pkg/model_registry/store/memory"
+	"github.com/mlflow/mlflow-go/pkg/model_registry/store/storetest"
+)
+
+func TestModelRegistryMemoryStore(t *testing.T) {
+	t.Parallel()
+
+	storetest.Run(t, func(t *testing.T) store.ModelRegistryStore {
+		t.Helper()
+
+		memoryStore, err := memory.NewModelRegistryMemoryStore(context.Background(), &config.Config{
+			ModelRegistryStoreURI: memory.Scheme + "://",
+		})
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			require.NoError(t, memoryStore.Destroy())
+		})
+
+		return memoryStore
+	})
+}
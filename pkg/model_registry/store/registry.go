@@ -0,0 +1,48 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mlflow/mlflow-go/pkg/config"
+	"github.com/mlflow/mlflow-go/pkg/utils"
+)
+
+// ModelRegistryStoreFactory creates the model registry store of config.ModelRegistryStoreURI.
+type ModelRegistryStoreFactory func(ctx context.Context, config *config.Config) (ModelRegistryStore, error)
+
+var modelRegistryStores = utils.NewSchemeRegistry[ModelRegistryStoreFactory]("model registry store")
+
+// RegisterModelRegistryStore makes factory create the model registry stores of the URIs with scheme.
+// The SQL stores register themselves when package sql is imported, other stores can be plugged in
+// by registering them before the server is launched.
+func RegisterModelRegistryStore(scheme string, factory ModelRegistryStoreFactory) {
+	modelRegistryStores.Register(scheme, factory)
+}
+
+// ModelRegistryStoreSchemes returns the schemes of the registered model registry stores.
+func ModelRegistryStoreSchemes() []string {
+	return modelRegistryStores.Schemes()
+}
+
+// SupportsModelRegistryStoreURI returns whether a model registry store is registered for the scheme of uri.
+func SupportsModelRegistryStoreURI(uri string) bool {
+	return modelRegistryStores.Supports(uri)
+}
+
+// NewModelRegistryStore creates the store of config.ModelRegistryStoreURI with the factory of its scheme.
+//
+//nolint:ireturn
+func NewModelRegistryStore(ctx context.Context, config *config.Config) (ModelRegistryStore, error) {
+	factory, err := modelRegistryStores.Lookup(config.ModelRegistryStoreURI)
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := factory(ctx, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create model registry store: %w", err)
+	}
+
+	return store, nil
+}
@@ -0,0 +1,44 @@
+package sql_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go/pkg/config"
+	"github.com/mlflow/mlflow-go/pkg/model_registry/store"
+	"github.com/mlflow/mlflow-go/pkg/model_registry/store/sql"
+	"github.com/mlflow/mlflow-go/pkg/model_registry/store/storetest"
+)
+
+// The conformance suite runs against the database of this variable, with the schema of the MLflow migrations,
+// or against a new SQLite database created by the migrations of the Go server.
+const testDatabaseURIEnv = "MLFLOW_GO_TEST_DATABASE_URI"
+
+func TestModelRegistrySQLStoreConformance(t *testing.T) {
+	t.Parallel()
+
+	databaseURI := os.Getenv(testDatabaseURIEnv)
+	createSchema := databaseURI == ""
+
+	if createSchema {
+		databaseURI = "sqlite:///" + filepath.ToSlash(filepath.Join(t.TempDir(), "mlflow.db"))
+	}
+
+	sqlStore, err := sql.NewModelRegistrySQLStore(context.Background(), &config.Config{
+		ModelRegistryStoreURI: databaseURI,
+		CreateDatabaseSchema:  createSchema,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, sqlStore.Destroy())
+	})
+
+	storetest.Run(t, func(*testing.T) store.ModelRegistryStore {
+		return sqlStore
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/mlflow/mlflow-go/pkg/contract"
 	"github.com/mlflow/mlflow-go/pkg/entities"
@@ -39,14 +40,15 @@ func assertModelExists(db *gorm.DB, name string) *contract.Error {
 func (m *ModelRegistrySQLStore) GetLatestVersions(
 	ctx context.Context, name string, stages []string,
 ) ([]*protos.ModelVersion, *contract.Error) {
-	if err := assertModelExists(m.db.WithContext(ctx), name); err != nil {
+	database := m.reader(ctx)
+
+	if err := assertModelExists(database, name); err != nil {
 		return nil, err
 	}
 
 	var modelVersions []*models.ModelVersion
 
-	subQuery := m.db.
-		WithContext(ctx).
+	subQuery := database.
 		Model(&models.ModelVersion{}).
 		Select("name, MAX(version) AS max_version").
 		Where("name = ?", name).
@@ -75,8 +77,7 @@ func (m *ModelRegistrySQLStore) GetLatestVersions(
 		subQuery = subQuery.Where("current_stage IN (?)", stages)
 	}
 
-	err := m.db.
-		WithContext(ctx).
+	err := database.
 		Model(&models.ModelVersion{}).
 		Joins("JOIN (?) AS sub ON model_versions.name = sub.name AND model_versions.version = sub.max_version", subQuery).
 		Find(&modelVersions).Error
@@ -100,9 +101,7 @@ func (m *ModelRegistrySQLStore) GetRegisteredModel(
 	ctx context.Context, name string,
 ) (*entities.RegisteredModel, *contract.Error) {
 	var registeredModel models.RegisteredModel
-	if err := m.db.WithContext(
-		ctx,
-	).Where(
+	if err := m.reader(ctx).Where(
 		"name = ?", name,
 	).Preload(
 		"Tags",
@@ -134,7 +133,7 @@ func (m *ModelRegistrySQLStore) GetRegisteredModel(
 func (m *ModelRegistrySQLStore) UpdateRegisteredModel(
 	ctx context.Context, name, description string,
 ) (*entities.RegisteredModel, *contract.Error) {
-	registeredModel, err := m.GetRegisteredModel(ctx, name)
+	registeredModel, err := m.GetRegisteredModel(m.writeContext(ctx), name)
 	if err != nil {
 		return nil, err
 	}
@@ -157,12 +156,12 @@ func (m *ModelRegistrySQLStore) UpdateRegisteredModel(
 func (m *ModelRegistrySQLStore) RenameRegisteredModel(
 	ctx context.Context, name, newName string,
 ) (*entities.RegisteredModel, *contract.Error) {
-	registeredModel, err := m.GetRegisteredModel(ctx, name)
+	registeredModel, err := m.GetRegisteredModel(m.writeContext(ctx), name)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := m.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+	if err := m.transaction(ctx, func(transaction *gorm.DB) error {
 		if err := transaction.Model(
 			&models.ModelVersion{},
 		).Where(
@@ -198,7 +197,7 @@ func (m *ModelRegistrySQLStore) RenameRegisteredModel(
 		return nil, contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to rename registered model", err)
 	}
 
-	registeredModel, err = m.GetRegisteredModel(ctx, newName)
+	registeredModel, err = m.GetRegisteredModel(m.writeContext(ctx), newName)
 	if err != nil {
 		return nil, err
 	}
@@ -207,12 +206,12 @@ func (m *ModelRegistrySQLStore) RenameRegisteredModel(
 }
 
 func (m *ModelRegistrySQLStore) DeleteRegisteredModel(ctx context.Context, name string) *contract.Error {
-	registeredModel, err := m.GetRegisteredModel(ctx, name)
+	registeredModel, err := m.GetRegisteredModel(m.writeContext(ctx), name)
 	if err != nil {
 		return err
 	}
 
-	if err := m.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+	if err := m.transaction(ctx, func(transaction *gorm.DB) error {
 		if err := transaction.Where(
 			"name = ?", registeredModel.Name,
 		).Delete(
@@ -266,15 +265,21 @@ func (m *ModelRegistrySQLStore) DeleteRegisteredModel(ctx context.Context, name
 func (m *ModelRegistrySQLStore) GetModelVersion(
 	ctx context.Context, name, version string,
 ) (*entities.ModelVersion, *contract.Error) {
+	return getModelVersion(m.reader(ctx), name, version)
+}
+
+func getModelVersion(database *gorm.DB, name, version string) (*entities.ModelVersion, *contract.Error) {
 	var modelVersion models.ModelVersion
-	if err := m.db.WithContext(
-		ctx,
-	).Where(
+	if err := database.Where(
 		"name = ?", name,
 	).Where(
 		"version = ?", version,
 	).Where(
 		"current_stage != ?", models.StageDeletedInternal,
+	).Preload(
+		"Tags",
+	).Preload(
+		"Aliases",
 	).First(
 		&modelVersion,
 	).Error; err != nil {
@@ -296,17 +301,17 @@ func (m *ModelRegistrySQLStore) GetModelVersion(
 }
 
 func (m *ModelRegistrySQLStore) DeleteModelVersion(ctx context.Context, name, version string) *contract.Error {
-	registeredModel, err := m.GetRegisteredModel(ctx, name)
+	registeredModel, err := m.GetRegisteredModel(m.writeContext(ctx), name)
 	if err != nil {
 		return err
 	}
 
-	modelVersion, err := m.GetModelVersion(ctx, name, version)
+	modelVersion, err := m.GetModelVersion(m.writeContext(ctx), name, version)
 	if err != nil {
 		return err
 	}
 
-	if err := m.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+	if err := m.transaction(ctx, func(transaction *gorm.DB) error {
 		if err := transaction.Model(
 			&models.RegisteredModel{},
 		).Where(
@@ -357,7 +362,7 @@ func (m *ModelRegistrySQLStore) DeleteModelVersion(ctx context.Context, name, ve
 func (m *ModelRegistrySQLStore) UpdateModelVersion(
 	ctx context.Context, name, version, description string,
 ) (*entities.ModelVersion, *contract.Error) {
-	modelVersion, err := m.GetModelVersion(ctx, name, version)
+	modelVersion, err := m.GetModelVersion(m.writeContext(ctx), name, version)
 	if err != nil {
 		return nil, err
 	}
@@ -377,3 +382,263 @@ func (m *ModelRegistrySQLStore) UpdateModelVersion(
 
 	return modelVersion, nil
 }
+
+// Concurrent creates of the same model may race for the next version number.
+const createModelVersionRetries = 3
+
+//nolint:funlen
+func (m *ModelRegistrySQLStore) CreateModelVersion(
+	ctx context.Context,
+	name, source, runID string,
+	tags []*entities.ModelVersionTag,
+	runLink, description, storageLocation string,
+) (*entities.ModelVersion, *contract.Error) {
+	creationTime := time.Now().UnixMilli()
+	modelVersion := models.ModelVersion{
+		Name:            name,
+		CreationTime:    creationTime,
+		LastUpdatedTime: creationTime,
+		Description:     sql.NullString{String: description, Valid: description != ""},
+		CurrentStage:    models.ModelVersionStageNone,
+		Source:          source,
+		RunID:           runID,
+		Status:          protos.ModelVersionStatus_READY.String(),
+		RunLink:         runLink,
+		StorageLocation: storageLocation,
+		Tags:            make([]models.ModelVersionTag, 0, len(tags)),
+	}
+
+	// Like mlflow, the last value wins when the same key is given twice.
+	tagIndexes := make(map[string]int, len(tags))
+	for _, tag := range tags {
+		if index, ok := tagIndexes[tag.Key]; ok {
+			modelVersion.Tags[index].Value = tag.Value
+
+			continue
+		}
+
+		tagIndexes[tag.Key] = len(modelVersion.Tags)
+		modelVersion.Tags = append(modelVersion.Tags, models.ModelVersionTag{Key: tag.Key, Value: tag.Value})
+	}
+
+	for attempt := 1; attempt <= createModelVersionRetries; attempt++ {
+		err := m.transaction(ctx, func(transaction *gorm.DB) error {
+			// Updating the registered model first locks its row,
+			// which serializes concurrent creates on databases with row level locking.
+			result := transaction.Model(
+				&models.RegisteredModel{},
+			).Where(
+				"name = ?", name,
+			).Update(
+				"last_updated_time", creationTime,
+			)
+			if result.Error != nil {
+				return result.Error
+			}
+
+			if result.RowsAffected == 0 {
+				return contract.NewError(
+					protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+					fmt.Sprintf("Registered Model with name=%s not found", name),
+				)
+			}
+
+			// Deleted versions are kept with a special stage, so numbers are never reused.
+			var maxVersion int32
+			if err := transaction.Model(
+				&models.ModelVersion{},
+			).Where(
+				"name = ?", name,
+			).Select(
+				"COALESCE(MAX(version), 0)",
+			).Scan(&maxVersion).Error; err != nil {
+				return err
+			}
+
+			modelVersion.Version = maxVersion + 1
+			for i := range modelVersion.Tags {
+				modelVersion.Tags[i].Version = modelVersion.Version
+			}
+
+			return transaction.Create(&modelVersion).Error
+		})
+		if err == nil {
+			return modelVersion.ToEntity(), nil
+		}
+
+		var contractError *contract.Error
+		if errors.As(err, &contractError) {
+			return nil, contractError
+		}
+
+		if !errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, contract.NewErrorWith(
+				protos.ErrorCode_INTERNAL_ERROR,
+				fmt.Sprintf("failed to create model version for %q", name),
+				err,
+			)
+		}
+	}
+
+	return nil, contract.NewError(
+		protos.ErrorCode_INTERNAL_ERROR,
+		fmt.Sprintf(
+			"Model Version creation error (name=%s). Giving up after %d attempts.",
+			name,
+			createModelVersionRetries,
+		),
+	)
+}
+
+func getCanonicalStage(stage string) (string, *contract.Error) {
+	canonicalStage, ok := models.CanonicalMapping[strings.ToLower(stage)]
+	if !ok {
+		return "", contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf(
+				"Invalid Model Version stage: %s. Value must be one of %s.",
+				stage,
+				models.AllModelVersionStages(),
+			),
+		)
+	}
+
+	return canonicalStage, nil
+}
+
+//nolint:funlen
+func (m *ModelRegistrySQLStore) TransitionModelVersionStage(
+	ctx context.Context, name, version, stage string, archiveExistingVersions bool,
+) (*entities.ModelVersion, *contract.Error) {
+	canonicalStage, contractError := getCanonicalStage(stage)
+	if contractError != nil {
+		return nil, contractError
+	}
+
+	if archiveExistingVersions &&
+		canonicalStage != models.ModelVersionStageStaging &&
+		canonicalStage != models.ModelVersionStageProduction {
+		return nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf(
+				"Model version transition cannot archive existing model versions because '%s' is not an Active stage. "+
+					"Valid stages are ['%s', '%s']",
+				stage,
+				models.ModelVersionStageStaging,
+				models.ModelVersionStageProduction,
+			),
+		)
+	}
+
+	modelVersion, contractError := m.GetModelVersion(m.writeContext(ctx), name, version)
+	if contractError != nil {
+		return nil, contractError
+	}
+
+	lastUpdatedTime := time.Now().UnixMilli()
+
+	if err := m.transaction(ctx, func(transaction *gorm.DB) error {
+		if archiveExistingVersions {
+			if err := transaction.Model(
+				&models.ModelVersion{},
+			).Where(
+				"name = ?", name,
+			).Where(
+				"version != ?", modelVersion.Version,
+			).Where(
+				"current_stage = ?", canonicalStage,
+			).Updates(&models.ModelVersion{
+				CurrentStage:    models.ModelVersionStageArchived,
+				LastUpdatedTime: lastUpdatedTime,
+			}).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := transaction.Model(
+			&models.ModelVersion{},
+		).Where(
+			"name = ?", name,
+		).Where(
+			"version = ?", modelVersion.Version,
+		).Updates(&models.ModelVersion{
+			CurrentStage:    models.ModelVersionStage(canonicalStage),
+			LastUpdatedTime: lastUpdatedTime,
+		}).Error; err != nil {
+			return err
+		}
+
+		return transaction.Model(
+			&models.RegisteredModel{},
+		).Where(
+			"name = ?", name,
+		).Update(
+			"last_updated_time", lastUpdatedTime,
+		).Error
+	}); err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR, "failed to transition model version stage", err,
+		)
+	}
+
+	modelVersion.CurrentStage = canonicalStage
+	modelVersion.LastUpdatedTime = lastUpdatedTime
+
+	return modelVersion, nil
+}
+
+func (m *ModelRegistrySQLStore) SetModelVersionTag(
+	ctx context.Context, name, version, key, value string,
+) *contract.Error {
+	modelVersion, err := m.GetModelVersion(m.writeContext(ctx), name, version)
+	if err != nil {
+		return err
+	}
+
+	tag := models.ModelVersionTag{
+		Name:    modelVersion.Name,
+		Version: modelVersion.Version,
+		Key:     key,
+		Value:   value,
+	}
+
+	if err := m.db.WithContext(ctx).Clauses(clause.OnConflict{
+		UpdateAll: true,
+	}).Create(&tag).Error; err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to set tag %q on model version (name=%s, version=%s)", key, name, version),
+			err,
+		)
+	}
+
+	return nil
+}
+
+// DeleteModelVersionTag doesn't fail when the tag doesn't exist, like mlflow.
+func (m *ModelRegistrySQLStore) DeleteModelVersionTag(
+	ctx context.Context, name, version, key string,
+) *contract.Error {
+	modelVersion, err := m.GetModelVersion(m.writeContext(ctx), name, version)
+	if err != nil {
+		return err
+	}
+
+	if err := m.db.WithContext(ctx).Where(
+		"name = ?", modelVersion.Name,
+	).Where(
+		"version = ?", modelVersion.Version,
+	).Where(
+		"key = ?", key,
+	).Delete(
+		&models.ModelVersionTag{},
+	).Error; err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to delete tag %q of model version (name=%s, version=%s)", key, name, version),
+			err,
+		)
+	}
+
+	return nil
+}
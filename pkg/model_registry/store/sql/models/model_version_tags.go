@@ -1,5 +1,7 @@
 package models
 
+import "github.com/mlflow/mlflow-go/pkg/entities"
+
 // ModelVersionTag mapped from table <model_version_tags>.
 //
 //revive:disable:exported
@@ -9,3 +11,10 @@ type ModelVersionTag struct {
 	Name    string `db:"name"    gorm:"column:name;primaryKey"`
 	Version int32  `db:"version" gorm:"column:version;primaryKey"`
 }
+
+func (t ModelVersionTag) ToEntity() *entities.ModelVersionTag {
+	return &entities.ModelVersionTag{
+		Key:   t.Key,
+		Value: t.Value,
+	}
+}
@@ -12,19 +12,21 @@ import (
 //
 //revive:disable:exported
 type ModelVersion struct {
-	Name            string            `db:"name"              gorm:"column:name;primaryKey"`
-	Version         int32             `db:"version"           gorm:"column:version;primaryKey"`
-	CreationTime    int64             `db:"creation_time"     gorm:"column:creation_time"`
-	LastUpdatedTime int64             `db:"last_updated_time" gorm:"column:last_updated_time"`
-	Description     sql.NullString    `db:"description"       gorm:"column:description"`
-	UserID          sql.NullString    `db:"user_id"           gorm:"column:user_id"`
-	CurrentStage    ModelVersionStage `db:"current_stage"     gorm:"column:current_stage"`
-	Source          string            `db:"source"            gorm:"column:source"`
-	RunID           string            `db:"run_id"            gorm:"column:run_id"`
-	Status          string            `db:"status"            gorm:"column:status"`
-	StatusMessage   sql.NullString    `db:"status_message"    gorm:"column:status_message"`
-	RunLink         string            `db:"run_link"          gorm:"column:run_link"`
-	StorageLocation string            `db:"storage_location"  gorm:"column:storage_location"`
+	Name            string                 `db:"name"              gorm:"column:name;primaryKey"`
+	Version         int32                  `db:"version"           gorm:"column:version;primaryKey"`
+	CreationTime    int64                  `db:"creation_time"     gorm:"column:creation_time"`
+	LastUpdatedTime int64                  `db:"last_updated_time" gorm:"column:last_updated_time"`
+	Description     sql.NullString         `db:"description"       gorm:"column:description"`
+	UserID          sql.NullString         `db:"user_id"           gorm:"column:user_id"`
+	CurrentStage    ModelVersionStage      `db:"current_stage"     gorm:"column:current_stage"`
+	Source          string                 `db:"source"            gorm:"column:source"`
+	RunID           string                 `db:"run_id"            gorm:"column:run_id"`
+	Status          string                 `db:"status"            gorm:"column:status"`
+	StatusMessage   sql.NullString         `db:"status_message"    gorm:"column:status_message"`
+	RunLink         string                 `db:"run_link"          gorm:"column:run_link"`
+	StorageLocation string                 `db:"storage_location"  gorm:"column:storage_location"`
+	Tags            []ModelVersionTag      `gorm:"foreignKey:Name,Version;references:Name,Version"`
+	Aliases         []RegisteredModelAlias `gorm:"foreignKey:Name,Version;references:Name,Version"`
 }
 
 const StageDeletedInternal = "Deleted_Internal"
@@ -52,7 +54,7 @@ func (mv ModelVersion) ToProto() *protos.ModelVersion {
 }
 
 func (mv ModelVersion) ToEntity() *entities.ModelVersion {
-	return &entities.ModelVersion{
+	modelVersion := entities.ModelVersion{
 		Name:            mv.Name,
 		Version:         mv.Version,
 		CreationTime:    mv.CreationTime,
@@ -66,5 +68,17 @@ func (mv ModelVersion) ToEntity() *entities.ModelVersion {
 		StatusMessage:   mv.StatusMessage.String,
 		RunLink:         mv.RunLink,
 		StorageLocation: mv.StorageLocation,
+		Tags:            make([]*entities.ModelVersionTag, 0, len(mv.Tags)),
+		Aliases:         make([]string, 0, len(mv.Aliases)),
 	}
+
+	for _, tag := range mv.Tags {
+		modelVersion.Tags = append(modelVersion.Tags, tag.ToEntity())
+	}
+
+	for _, alias := range mv.Aliases {
+		modelVersion.Aliases = append(modelVersion.Aliases, alias.Alias)
+	}
+
+	return &modelVersion
 }
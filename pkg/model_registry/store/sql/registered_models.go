@@ -0,0 +1,216 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/entities"
+	"github.com/mlflow/mlflow-go/pkg/model_registry/store/sql/models"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+)
+
+func (m *ModelRegistrySQLStore) CreateRegisteredModel(
+	ctx context.Context, name string, description *string, tags []*entities.RegisteredModelTag,
+) (*entities.RegisteredModel, *contract.Error) {
+	creationTime := time.Now().UnixMilli()
+	registeredModel := models.RegisteredModel{
+		Name:            name,
+		CreationTime:    creationTime,
+		LastUpdatedTime: creationTime,
+		Tags:            make([]models.RegisteredModelTag, 0, len(tags)),
+	}
+
+	if description != nil {
+		registeredModel.Description = sql.NullString{String: *description, Valid: true}
+	}
+
+	// Like mlflow, the last value wins when the same key is given twice.
+	tagIndexes := make(map[string]int, len(tags))
+	for _, tag := range tags {
+		if index, ok := tagIndexes[tag.Key]; ok {
+			registeredModel.Tags[index].Value = tag.Value
+
+			continue
+		}
+
+		tagIndexes[tag.Key] = len(registeredModel.Tags)
+		registeredModel.Tags = append(registeredModel.Tags, models.RegisteredModelTagFromEntity(name, tag))
+	}
+
+	if err := m.db.WithContext(ctx).Create(&registeredModel).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, contract.NewErrorWith(
+				protos.ErrorCode_RESOURCE_ALREADY_EXISTS,
+				fmt.Sprintf("Registered Model (name=%s) already exists.", name),
+				err,
+			)
+		}
+
+		return nil, contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to create registered model", err)
+	}
+
+	return registeredModel.ToEntity(), nil
+}
+
+func (m *ModelRegistrySQLStore) SetRegisteredModelTag(
+	ctx context.Context, name, key, value string,
+) *contract.Error {
+	if err := assertModelExists(m.db.WithContext(ctx), name); err != nil {
+		return err
+	}
+
+	tag := models.RegisteredModelTag{
+		Name:  name,
+		Key:   key,
+		Value: value,
+	}
+
+	if err := m.db.WithContext(ctx).Clauses(clause.OnConflict{
+		UpdateAll: true,
+	}).Create(&tag).Error; err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to set tag %q on registered model %q", key, name),
+			err,
+		)
+	}
+
+	return nil
+}
+
+// DeleteRegisteredModelTag doesn't fail when the tag doesn't exist, like mlflow.
+func (m *ModelRegistrySQLStore) DeleteRegisteredModelTag(ctx context.Context, name, key string) *contract.Error {
+	if err := assertModelExists(m.db.WithContext(ctx), name); err != nil {
+		return err
+	}
+
+	if err := m.db.WithContext(ctx).Where(
+		"name = ?", name,
+	).Where(
+		"key = ?", key,
+	).Delete(
+		&models.RegisteredModelTag{},
+	).Error; err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to delete tag %q of registered model %q", key, name),
+			err,
+		)
+	}
+
+	return nil
+}
+
+func (m *ModelRegistrySQLStore) SetRegisteredModelAlias(
+	ctx context.Context, name, alias, version string,
+) *contract.Error {
+	modelVersion, err := m.GetModelVersion(m.writeContext(ctx), name, version)
+	if err != nil {
+		return err
+	}
+
+	if err := m.transaction(ctx, func(transaction *gorm.DB) error {
+		if err := transaction.Clauses(clause.OnConflict{
+			UpdateAll: true,
+		}).Create(&models.RegisteredModelAlias{
+			Name:    modelVersion.Name,
+			Alias:   alias,
+			Version: modelVersion.Version,
+		}).Error; err != nil {
+			return err
+		}
+
+		return transaction.Model(
+			&models.RegisteredModel{},
+		).Where(
+			"name = ?", name,
+		).Update(
+			"last_updated_time", time.Now().UnixMilli(),
+		).Error
+	}); err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to set alias %q on registered model %q", alias, name),
+			err,
+		)
+	}
+
+	return nil
+}
+
+// DeleteRegisteredModelAlias doesn't fail when the alias doesn't exist, like mlflow.
+func (m *ModelRegistrySQLStore) DeleteRegisteredModelAlias(ctx context.Context, name, alias string) *contract.Error {
+	if err := assertModelExists(m.db.WithContext(ctx), name); err != nil {
+		return err
+	}
+
+	if err := m.transaction(ctx, func(transaction *gorm.DB) error {
+		if err := transaction.Where(
+			"name = ?", name,
+		).Where(
+			"alias = ?", alias,
+		).Delete(
+			&models.RegisteredModelAlias{},
+		).Error; err != nil {
+			return err
+		}
+
+		return transaction.Model(
+			&models.RegisteredModel{},
+		).Where(
+			"name = ?", name,
+		).Update(
+			"last_updated_time", time.Now().UnixMilli(),
+		).Error
+	}); err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to delete alias %q of registered model %q", alias, name),
+			err,
+		)
+	}
+
+	return nil
+}
+
+func (m *ModelRegistrySQLStore) GetModelVersionByAlias(
+	ctx context.Context, name, alias string,
+) (*entities.ModelVersion, *contract.Error) {
+	database := m.reader(ctx)
+
+	if err := assertModelExists(database, name); err != nil {
+		return nil, err
+	}
+
+	var registeredModelAlias models.RegisteredModelAlias
+	if err := database.Where(
+		"name = ?", name,
+	).Where(
+		"alias = ?", alias,
+	).First(
+		&registeredModelAlias,
+	).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("Registered model alias %s not found.", alias),
+			)
+		}
+
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to get alias %q of registered model %q", alias, name),
+			err,
+		)
+	}
+
+	return getModelVersion(database, name, strconv.Itoa(int(registeredModelAlias.Version)))
+}
@@ -0,0 +1,76 @@
+package sql_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go/pkg/config"
+	registry "github.com/mlflow/mlflow-go/pkg/model_registry/store/sql"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/sql"
+)
+
+// The registered models and model versions being updated are read from the primary database,
+// not from a read replica missing them.
+func TestUpdatesWithReadReplica(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	replicaURI := "sqlite:///" + filepath.ToSlash(filepath.Join(t.TempDir(), "replica.db"))
+
+	replicaStore, err := registry.NewModelRegistrySQLStore(ctx, &config.Config{
+		ModelRegistryStoreURI: replicaURI,
+		CreateDatabaseSchema:  true,
+	})
+	require.NoError(t, err)
+	require.NoError(t, replicaStore.Destroy())
+
+	sqlStore, err := registry.NewModelRegistrySQLStore(ctx, &config.Config{
+		ModelRegistryStoreURI:            "sqlite:///" + filepath.ToSlash(filepath.Join(t.TempDir(), "mlflow.db")),
+		ModelRegistryStoreReadReplicaURI: replicaURI,
+		CreateDatabaseSchema:             true,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, sqlStore.Destroy())
+	})
+
+	_, contractError := sqlStore.CreateRegisteredModel(sql.NewContextWithWrites(ctx), "model", nil, nil)
+	require.Nil(t, contractError)
+
+	_, contractError = sqlStore.CreateModelVersion(
+		sql.NewContextWithWrites(ctx), "model", "source", "", nil, "", "", "source",
+	)
+	require.Nil(t, contractError)
+
+	// The reads of the next requests go to the replica.
+	_, contractError = sqlStore.GetModelVersion(sql.NewContextWithWrites(ctx), "model", "1")
+	require.NotNil(t, contractError)
+	require.Equal(t, protos.ErrorCode_RESOURCE_DOES_NOT_EXIST, protos.ErrorCode(contractError.Code))
+
+	_, contractError = sqlStore.UpdateRegisteredModel(sql.NewContextWithWrites(ctx), "model", "description")
+	require.Nil(t, contractError)
+
+	_, contractError = sqlStore.UpdateModelVersion(sql.NewContextWithWrites(ctx), "model", "1", "description")
+	require.Nil(t, contractError)
+
+	require.Nil(t, sqlStore.SetModelVersionTag(sql.NewContextWithWrites(ctx), "model", "1", "key", "value"))
+	require.Nil(t, sqlStore.DeleteModelVersionTag(sql.NewContextWithWrites(ctx), "model", "1", "key"))
+	require.Nil(t, sqlStore.SetRegisteredModelAlias(sql.NewContextWithWrites(ctx), "model", "champion", "1"))
+
+	_, contractError = sqlStore.TransitionModelVersionStage(
+		sql.NewContextWithWrites(ctx), "model", "1", "Production", false,
+	)
+	require.Nil(t, contractError)
+
+	renamed, contractError := sqlStore.RenameRegisteredModel(sql.NewContextWithWrites(ctx), "model", "renamed")
+	require.Nil(t, contractError)
+	require.Equal(t, "renamed", renamed.Name)
+
+	require.Nil(t, sqlStore.DeleteModelVersion(sql.NewContextWithWrites(ctx), "renamed", "1"))
+	require.Nil(t, sqlStore.DeleteRegisteredModel(sql.NewContextWithWrites(ctx), "renamed"))
+}
@@ -0,0 +1,331 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/entities"
+	"github.com/mlflow/mlflow-go/pkg/model_registry/store/sql/models"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/tracking/service/query"
+	"github.com/mlflow/mlflow-go/pkg/tracking/service/query/parser"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store/search"
+	"github.com/mlflow/mlflow-go/pkg/utils"
+)
+
+const (
+	SearchRegisteredModelsMaxResultsThreshold = 1000
+	SearchModelVersionsMaxResultsThreshold    = 200000
+)
+
+func validateMaxResults(maxResults int64, threshold int) *contract.Error {
+	if maxResults < 1 || maxResults > int64(threshold) {
+		return contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf(
+				"Invalid value for request parameter max_results. "+
+					"It must be at least 1 and at most %d, but got value %d",
+				threshold,
+				maxResults,
+			),
+		)
+	}
+
+	return nil
+}
+
+// searchFilter describes how the identifiers of a filter map to the model registry tables.
+type searchFilter struct {
+	table     string
+	tagsTable string
+	// joinColumns are the columns shared by table and tagsTable.
+	joinColumns []string
+	// attributeColumns maps the attribute keys of the filter to the columns of table.
+	attributeColumns map[string]string
+}
+
+var (
+	registeredModelsFilter = searchFilter{
+		table:       "registered_models",
+		tagsTable:   "registered_model_tags",
+		joinColumns: []string{"name"},
+		attributeColumns: map[string]string{
+			parser.ModelName: "name",
+		},
+	}
+	modelVersionsFilter = searchFilter{
+		table:       "model_versions",
+		tagsTable:   "model_version_tags",
+		joinColumns: []string{"name", "version"},
+		attributeColumns: map[string]string{
+			parser.ModelName:              "name",
+			parser.ModelVersionRunID:      "run_id",
+			parser.ModelVersionSourcePath: "source",
+			parser.ModelVersionNumber:     "version",
+		},
+	}
+)
+
+func (f searchFilter) apply(
+	database, transaction *gorm.DB, filterConditions []*parser.ValidCompareExpr,
+) {
+	isSqlite := database.Dialector.Name() == "sqlite"
+
+	for index, clause := range filterConditions {
+		comparison := strings.ToUpper(clause.Operator.String())
+		value := clause.Value
+
+		column := "value"
+		if clause.Identifier == parser.Attribute {
+			column = fmt.Sprintf("%s.%s", f.table, f.attributeColumns[clause.Key])
+		}
+
+		where := fmt.Sprintf("%s %s ?", column, comparison)
+		if isSqlite && clause.Operator == parser.ILike {
+			where = fmt.Sprintf("LOWER(%s) LIKE ?", column)
+
+			if str, ok := value.(string); ok {
+				value = strings.ToLower(str)
+			}
+		}
+
+		if clause.Identifier == parser.Attribute {
+			transaction.Where(where, value)
+
+			continue
+		}
+
+		// JOIN (
+		//   SELECT name, version
+		//   FROM model_version_tags
+		//   WHERE key = ? AND value <comparison> ?
+		// ) AS filter_0
+		// ON model_versions.name = filter_0.name AND model_versions.version = filter_0.version
+		table := fmt.Sprintf("filter_%d", index)
+		conditions := make([]string, 0, len(f.joinColumns))
+
+		for _, joinColumn := range f.joinColumns {
+			conditions = append(
+				conditions,
+				fmt.Sprintf("%s.%s = %s.%s", f.table, joinColumn, table, joinColumn),
+			)
+		}
+
+		transaction.Joins(
+			fmt.Sprintf("JOIN (?) AS %s ON %s", table, strings.Join(conditions, " AND ")),
+			database.Table(f.tagsTable).Select(f.joinColumns).Where("key = ?", clause.Key).Where(where, value),
+		)
+	}
+}
+
+var orderByRegExp = regexp.MustCompile(`^(?:attr(?:ibutes?)?\.)?(\w+)(?i:\s+(ASC|DESC))?$`)
+
+// applyOrderBy orders by the requested columns, then by the tiebreakers which aren't already part of orderBy.
+func (f searchFilter) applyOrderBy(
+	transaction *gorm.DB, orderBy []string, orderColumns map[string]string, tiebreakers []string,
+) *contract.Error {
+	ordered := map[string]bool{}
+
+	for _, order := range orderBy {
+		match := orderByRegExp.FindStringSubmatch(strings.TrimSpace(order))
+
+		var column string
+		if match != nil {
+			column = orderColumns[match[1]]
+		}
+
+		if column == "" {
+			keys := make([]string, 0, len(orderColumns))
+			for key := range orderColumns {
+				keys = append(keys, key)
+			}
+
+			sort.Strings(keys)
+
+			return contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("Invalid order by key %q specified. Valid keys are %v", order, keys),
+			)
+		}
+
+		if ordered[column] {
+			return contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("`order_by` contains duplicate fields: %v", orderBy),
+			)
+		}
+
+		ordered[column] = true
+
+		direction := "ASC"
+		if strings.EqualFold(match[2], "DESC") {
+			direction = "DESC"
+		}
+
+		transaction.Order(fmt.Sprintf("%s.%s %s", f.table, column, direction))
+	}
+
+	for _, tiebreaker := range tiebreakers {
+		column, direction, _ := strings.Cut(tiebreaker, " ")
+		if !ordered[column] {
+			transaction.Order(fmt.Sprintf("%s.%s %s", f.table, column, direction))
+		}
+	}
+
+	return nil
+}
+
+func parseSearchFilter(
+	ctx context.Context, filter string, parse func(string) ([]*parser.ValidCompareExpr, error),
+) ([]*parser.ValidCompareExpr, *contract.Error) {
+	filterConditions, err := parse(filter)
+	if err != nil {
+		return nil, query.NewFilterError(err)
+	}
+
+	utils.GetLoggerFromContext(ctx).Debugf("Filter conditions: %v", filterConditions)
+
+	return filterConditions, nil
+}
+
+func (m *ModelRegistrySQLStore) SearchRegisteredModels(
+	ctx context.Context, filter string, maxResults int64, orderBy []string, pageToken string,
+) ([]*entities.RegisteredModel, string, *contract.Error) {
+	if err := validateMaxResults(maxResults, SearchRegisteredModelsMaxResultsThreshold); err != nil {
+		return nil, "", err
+	}
+
+	filterConditions, contractError := parseSearchFilter(ctx, filter, query.ParseRegisteredModelFilter)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	offset, contractError := search.ParsePageToken(pageToken)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	database := m.reader(ctx)
+	transaction := database.Model(&models.RegisteredModel{})
+
+	registeredModelsFilter.apply(database, transaction, filterConditions)
+
+	if contractError := registeredModelsFilter.applyOrderBy(transaction, orderBy, map[string]string{
+		"name":                   "name",
+		"timestamp":              "last_updated_time",
+		"last_updated_timestamp": "last_updated_time",
+	}, []string{"name ASC"}); contractError != nil {
+		return nil, "", contractError
+	}
+
+	var registeredModels []models.RegisteredModel
+	if err := transaction.Preload(
+		"Tags",
+	).Preload(
+		"Aliases",
+	).Preload(
+		"Versions",
+	).Offset(
+		offset,
+	).Limit(
+		int(maxResults) + 1,
+	).Find(
+		&registeredModels,
+	).Error; err != nil {
+		return nil, "", contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			"failed to search registered models",
+			err,
+		)
+	}
+
+	nextPageToken, contractError := search.NextPageToken(len(registeredModels), int(maxResults), offset)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	registeredModels = registeredModels[:min(len(registeredModels), int(maxResults))]
+
+	results := make([]*entities.RegisteredModel, 0, len(registeredModels))
+	for _, registeredModel := range registeredModels {
+		results = append(results, registeredModel.ToEntity())
+	}
+
+	return results, nextPageToken, nil
+}
+
+func (m *ModelRegistrySQLStore) SearchModelVersions(
+	ctx context.Context, filter string, maxResults int64, orderBy []string, pageToken string,
+) ([]*entities.ModelVersion, string, *contract.Error) {
+	if err := validateMaxResults(maxResults, SearchModelVersionsMaxResultsThreshold); err != nil {
+		return nil, "", err
+	}
+
+	filterConditions, contractError := parseSearchFilter(ctx, filter, query.ParseModelVersionFilter)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	offset, contractError := search.ParsePageToken(pageToken)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	database := m.reader(ctx)
+	transaction := database.Model(
+		&models.ModelVersion{},
+	).Where(
+		"model_versions.current_stage <> ?", models.StageDeletedInternal,
+	)
+
+	modelVersionsFilter.apply(database, transaction, filterConditions)
+
+	if contractError := modelVersionsFilter.applyOrderBy(transaction, orderBy, map[string]string{
+		"name":                   "name",
+		"version_number":         "version",
+		"creation_timestamp":     "creation_time",
+		"timestamp":              "last_updated_time",
+		"last_updated_timestamp": "last_updated_time",
+	}, []string{"name ASC", "version DESC"}); contractError != nil {
+		return nil, "", contractError
+	}
+
+	var modelVersions []models.ModelVersion
+	if err := transaction.Preload(
+		"Tags",
+	).Preload(
+		"Aliases",
+	).Offset(
+		offset,
+	).Limit(
+		int(maxResults) + 1,
+	).Find(
+		&modelVersions,
+	).Error; err != nil {
+		return nil, "", contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			"failed to search model versions",
+			err,
+		)
+	}
+
+	nextPageToken, contractError := search.NextPageToken(len(modelVersions), int(maxResults), offset)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	modelVersions = modelVersions[:min(len(modelVersions), int(maxResults))]
+
+	results := make([]*entities.ModelVersion, 0, len(modelVersions))
+	for _, modelVersion := range modelVersions {
+		results = append(results, modelVersion.ToEntity())
+	}
+
+	return results, nextPageToken, nil
+}
@@ -7,30 +7,117 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/mlflow/mlflow-go/pkg/config"
+	"github.com/mlflow/mlflow-go/pkg/model_registry/store"
 	"github.com/mlflow/mlflow-go/pkg/sql"
 )
 
+// Like database/sql drivers, the SQL stores are registered when the package is imported.
+//
+//nolint:gochecknoinits
+func init() {
+	for _, scheme := range sql.Schemes() {
+		store.RegisterModelRegistryStore(scheme, func(ctx context.Context, config *config.Config) (store.ModelRegistryStore, error) {
+			return NewModelRegistrySQLStore(ctx, config)
+		})
+	}
+}
+
 type ModelRegistrySQLStore struct {
 	config *config.Config
 	db     *gorm.DB
+	// replica is the read replica of the database with the model_registry_store_read_replica_uri option,
+	// or the read pool of a SQLite database.
+	replica     *gorm.DB
+	schemaCheck *sql.SchemaCheck
 }
 
 func NewModelRegistrySQLStore(ctx context.Context, config *config.Config) (*ModelRegistrySQLStore, error) {
-	database, err := sql.NewDatabase(ctx, config.ModelRegistryStoreURI)
+	database, err := sql.NewDatabase(ctx, config.ModelRegistryStoreURI, sql.NewOptions(config))
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database %q: %w", config.ModelRegistryStoreURI, err)
 	}
 
+	schemaCheck, err := initDatabase(ctx, database, config)
+	if err != nil {
+		_ = sql.CloseDatabase(database)
+
+		return nil, err
+	}
+
+	var replica *gorm.DB
+
+	switch {
+	case config.ModelRegistryStoreReadReplicaURI != "":
+		replica, err = sql.NewReplica(ctx, database, config.ModelRegistryStoreReadReplicaURI, sql.NewOptions(config))
+		if err != nil {
+			_ = sql.CloseDatabase(database)
+
+			return nil, fmt.Errorf(
+				"failed to connect to read replica %q: %w", config.ModelRegistryStoreReadReplicaURI, err,
+			)
+		}
+	case database.Dialector.Name() == "sqlite":
+		if replica, err = sql.NewReadPool(ctx, config.ModelRegistryStoreURI, sql.NewOptions(config)); err != nil {
+			_ = sql.CloseDatabase(database)
+
+			return nil, err
+		}
+	}
+
 	return &ModelRegistrySQLStore{
-		config: config,
-		db:     database,
+		config:      config,
+		db:          database,
+		replica:     replica,
+		schemaCheck: schemaCheck,
 	}, nil
 }
 
+// initDatabase creates the schema of an empty database with the create_database_schema option, then checks it.
+func initDatabase(ctx context.Context, database *gorm.DB, config *config.Config) (*sql.SchemaCheck, error) {
+	if config.CreateDatabaseSchema {
+		if err := sql.CreateSchema(ctx, database); err != nil {
+			return nil, err
+		}
+	}
+
+	check, err := sql.EnforceSchema(ctx, database, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check schema of database %q: %w", config.ModelRegistryStoreURI, err)
+	}
+
+	return check, nil
+}
+
+// transaction runs fn in a transaction, which is retried after a conflict with a concurrent transaction.
+func (m *ModelRegistrySQLStore) transaction(ctx context.Context, fn func(transaction *gorm.DB) error) error {
+	return sql.Transaction(m.db.WithContext(ctx), fn) //nolint:wrapcheck
+}
+
+// reader returns the database of the read-only operations, which may be the read replica or read pool.
+func (m *ModelRegistrySQLStore) reader(ctx context.Context) *gorm.DB {
+	return sql.Reader(ctx, m.db, m.replica)
+}
+
+// writeContext returns the context of the reads of the operations which write, which go to the primary database.
+func (m *ModelRegistrySQLStore) writeContext(ctx context.Context) context.Context {
+	return sql.NewContextWithPrimary(ctx)
+}
+
+// SchemaCheck returns the outcome of the check of the schema of the database when the store was created.
+func (m *ModelRegistrySQLStore) SchemaCheck() *sql.SchemaCheck {
+	return m.schemaCheck
+}
+
 func (m *ModelRegistrySQLStore) Destroy() error {
 	if err := sql.CloseDatabase(m.db); err != nil {
 		return fmt.Errorf("failed to close database: %w", err)
 	}
 
+	if m.replica != nil {
+		if err := sql.CloseDatabase(m.replica); err != nil {
+			return fmt.Errorf("failed to close read replica: %w", err)
+		}
+	}
+
 	return nil
 }
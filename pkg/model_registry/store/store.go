@@ -10,11 +10,37 @@ import (
 
 type ModelRegistryStore interface {
 	contract.Destroyer
+	CreateRegisteredModel(
+		ctx context.Context, name string, description *string, tags []*entities.RegisteredModelTag,
+	) (*entities.RegisteredModel, *contract.Error)
 	GetLatestVersions(ctx context.Context, name string, stages []string) ([]*protos.ModelVersion, *contract.Error)
 	GetRegisteredModel(ctx context.Context, name string) (*entities.RegisteredModel, *contract.Error)
+	SearchRegisteredModels(
+		ctx context.Context, filter string, maxResults int64, orderBy []string, pageToken string,
+	) ([]*entities.RegisteredModel, string, *contract.Error)
 	UpdateRegisteredModel(ctx context.Context, name, description string) (*entities.RegisteredModel, *contract.Error)
 	RenameRegisteredModel(ctx context.Context, name, newName string) (*entities.RegisteredModel, *contract.Error)
 	DeleteRegisteredModel(ctx context.Context, name string) *contract.Error
+	SetRegisteredModelTag(ctx context.Context, name, key, value string) *contract.Error
+	DeleteRegisteredModelTag(ctx context.Context, name, key string) *contract.Error
+	SetRegisteredModelAlias(ctx context.Context, name, alias, version string) *contract.Error
+	DeleteRegisteredModelAlias(ctx context.Context, name, alias string) *contract.Error
+	GetModelVersionByAlias(ctx context.Context, name, alias string) (*entities.ModelVersion, *contract.Error)
+	CreateModelVersion(
+		ctx context.Context,
+		name, source, runID string,
+		tags []*entities.ModelVersionTag,
+		runLink, description, storageLocation string,
+	) (*entities.ModelVersion, *contract.Error)
+	GetModelVersion(ctx context.Context, name, version string) (*entities.ModelVersion, *contract.Error)
+	SearchModelVersions(
+		ctx context.Context, filter string, maxResults int64, orderBy []string, pageToken string,
+	) ([]*entities.ModelVersion, string, *contract.Error)
 	DeleteModelVersion(ctx context.Context, name, version string) *contract.Error
 	UpdateModelVersion(ctx context.Context, name, version, description string) (*entities.ModelVersion, *contract.Error)
+	TransitionModelVersionStage(
+		ctx context.Context, name, version, stage string, archiveExistingVersions bool,
+	) (*entities.ModelVersion, *contract.Error)
+	SetModelVersionTag(ctx context.Context, name, version, key, value string) *contract.Error
+	DeleteModelVersionTag(ctx context.Context, name, version, key string) *contract.Error
 }
@@ -0,0 +1,391 @@
+// Package storetest is the conformance suite of the model registry stores, which checks that a store
+// behaves like the SQL store the Python server relies on.
+//
+// The tests only create uniquely named models, so the suite can run against a database
+// which is shared with other tests.
+package storetest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/entities"
+	"github.com/mlflow/mlflow-go/pkg/model_registry/store"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/utils"
+)
+
+// NewStore returns the store under test, which may be shared by the tests of the suite.
+type NewStore func(t *testing.T) store.ModelRegistryStore
+
+// Run runs the conformance suite against the stores created by newStore.
+func Run(t *testing.T, newStore NewStore) {
+	t.Helper()
+
+	tests := map[string]func(t *testing.T, store store.ModelRegistryStore){
+		"RegisteredModels":        testRegisteredModels,
+		"RenameRegisteredModel":   testRenameRegisteredModel,
+		"DeleteRegisteredModel":   testDeleteRegisteredModel,
+		"ModelVersions":           testModelVersions,
+		"DeleteModelVersion":      testDeleteModelVersion,
+		"Aliases":                 testAliases,
+		"Stages":                  testStages,
+		"SearchRegisteredModels":  testSearchRegisteredModels,
+		"SearchModelVersions":     testSearchModelVersions,
+		"SearchModelVersionPages": testSearchModelVersionPages,
+		"InvalidMaxResults":       testInvalidMaxResults,
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			test(t, newStore(t))
+		})
+	}
+}
+
+func requireErrorCode(t *testing.T, err *contract.Error, code protos.ErrorCode) {
+	t.Helper()
+
+	require.NotNil(t, err)
+	assert.Equal(t, code, protos.ErrorCode(err.Code), err.Message)
+}
+
+func createRegisteredModel(t *testing.T, store store.ModelRegistryStore, versions int) string {
+	t.Helper()
+
+	ctx := context.Background()
+	name := "storetest-" + utils.NewUUID()
+
+	_, err := store.CreateRegisteredModel(ctx, name, nil, nil)
+	require.Nil(t, err)
+
+	for range versions {
+		_, err := store.CreateModelVersion(ctx, name, "source", "run", nil, "", "", "")
+		require.Nil(t, err)
+	}
+
+	return name
+}
+
+func testRegisteredModels(t *testing.T, store store.ModelRegistryStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	name := "storetest-" + utils.NewUUID()
+
+	registeredModel, err := store.CreateRegisteredModel(
+		ctx,
+		name,
+		utils.PtrTo("description"),
+		[]*entities.RegisteredModelTag{{Key: "team", Value: "a"}, {Key: "team", Value: "b"}},
+	)
+	require.Nil(t, err)
+	assert.Equal(t, name, registeredModel.Name)
+	assert.Equal(t, utils.PtrTo("description"), registeredModel.Description)
+
+	_, err = store.CreateRegisteredModel(ctx, name, nil, nil)
+	requireErrorCode(t, err, protos.ErrorCode_RESOURCE_ALREADY_EXISTS)
+
+	_, err = store.UpdateRegisteredModel(ctx, name, "updated")
+	require.Nil(t, err)
+	require.Nil(t, store.SetRegisteredModelTag(ctx, name, "stage", "dev"))
+
+	registeredModel, err = store.GetRegisteredModel(ctx, name)
+	require.Nil(t, err)
+	assert.Equal(t, utils.PtrTo("updated"), registeredModel.Description)
+	// Like in mlflow, the last value of a tag given twice wins.
+	assert.ElementsMatch(t, []*entities.RegisteredModelTag{
+		{Key: "team", Value: "b"},
+		{Key: "stage", Value: "dev"},
+	}, registeredModel.Tags)
+
+	require.Nil(t, store.DeleteRegisteredModelTag(ctx, name, "team"))
+	require.Nil(t, store.DeleteRegisteredModelTag(ctx, name, "missing"))
+
+	registeredModel, err = store.GetRegisteredModel(ctx, name)
+	require.Nil(t, err)
+	assert.Equal(t, []*entities.RegisteredModelTag{{Key: "stage", Value: "dev"}}, registeredModel.Tags)
+
+	requireErrorCode(
+		t, store.SetRegisteredModelTag(ctx, name+"-missing", "key", "value"), protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+	)
+}
+
+func testRenameRegisteredModel(t *testing.T, store store.ModelRegistryStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	name := createRegisteredModel(t, store, 1)
+	other := createRegisteredModel(t, store, 0)
+
+	_, err := store.RenameRegisteredModel(ctx, name, other)
+	requireErrorCode(t, err, protos.ErrorCode_RESOURCE_ALREADY_EXISTS)
+
+	registeredModel, err := store.RenameRegisteredModel(ctx, name, name+"-renamed")
+	require.Nil(t, err)
+	assert.Equal(t, name+"-renamed", registeredModel.Name)
+
+	_, err = store.GetRegisteredModel(ctx, name)
+	requireErrorCode(t, err, protos.ErrorCode_RESOURCE_DOES_NOT_EXIST)
+
+	modelVersion, err := store.GetModelVersion(ctx, name+"-renamed", "1")
+	require.Nil(t, err)
+	assert.Equal(t, name+"-renamed", modelVersion.Name)
+}
+
+func testDeleteRegisteredModel(t *testing.T, store store.ModelRegistryStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	name := createRegisteredModel(t, store, 2)
+
+	require.Nil(t, store.DeleteRegisteredModel(ctx, name))
+
+	_, err := store.GetRegisteredModel(ctx, name)
+	requireErrorCode(t, err, protos.ErrorCode_RESOURCE_DOES_NOT_EXIST)
+
+	_, err = store.GetModelVersion(ctx, name, "1")
+	requireErrorCode(t, err, protos.ErrorCode_RESOURCE_DOES_NOT_EXIST)
+
+	requireErrorCode(t, store.DeleteRegisteredModel(ctx, name), protos.ErrorCode_RESOURCE_DOES_NOT_EXIST)
+}
+
+func testModelVersions(t *testing.T, store store.ModelRegistryStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	name := createRegisteredModel(t, store, 0)
+
+	modelVersion, err := store.CreateModelVersion(
+		ctx,
+		name,
+		"s3://bucket/model",
+		"run-id",
+		[]*entities.ModelVersionTag{{Key: "team", Value: "a"}},
+		"run-link",
+		"description",
+		"s3://bucket/storage",
+	)
+	require.Nil(t, err)
+	assert.Equal(t, int32(1), modelVersion.Version)
+	assert.Equal(t, "None", modelVersion.CurrentStage)
+	assert.Equal(t, protos.ModelVersionStatus_READY.String(), modelVersion.Status)
+
+	_, err = store.UpdateModelVersion(ctx, name, "1", "updated")
+	require.Nil(t, err)
+	require.Nil(t, store.SetModelVersionTag(ctx, name, "1", "stage", "dev"))
+	require.Nil(t, store.DeleteModelVersionTag(ctx, name, "1", "team"))
+
+	modelVersion, err = store.GetModelVersion(ctx, name, "1")
+	require.Nil(t, err)
+	assert.Equal(t, "s3://bucket/model", modelVersion.Source)
+	assert.Equal(t, "run-id", modelVersion.RunID)
+	assert.Equal(t, "run-link", modelVersion.RunLink)
+	assert.Equal(t, "updated", modelVersion.Description)
+	assert.Equal(t, []*entities.ModelVersionTag{{Key: "stage", Value: "dev"}}, modelVersion.Tags)
+
+	_, err = store.CreateModelVersion(ctx, name+"-missing", "source", "run", nil, "", "", "")
+	requireErrorCode(t, err, protos.ErrorCode_RESOURCE_DOES_NOT_EXIST)
+
+	_, err = store.GetModelVersion(ctx, name, "2")
+	requireErrorCode(t, err, protos.ErrorCode_RESOURCE_DOES_NOT_EXIST)
+}
+
+func testDeleteModelVersion(t *testing.T, store store.ModelRegistryStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	name := createRegisteredModel(t, store, 2)
+
+	require.Nil(t, store.SetRegisteredModelAlias(ctx, name, "champion", "2"))
+	require.Nil(t, store.DeleteModelVersion(ctx, name, "2"))
+
+	_, err := store.GetModelVersion(ctx, name, "2")
+	requireErrorCode(t, err, protos.ErrorCode_RESOURCE_DOES_NOT_EXIST)
+
+	// The aliases of the version are deleted along with it.
+	_, err = store.GetModelVersionByAlias(ctx, name, "champion")
+	requireErrorCode(t, err, protos.ErrorCode_INVALID_PARAMETER_VALUE)
+
+	// The numbers of the deleted versions aren't reused.
+	modelVersion, err := store.CreateModelVersion(ctx, name, "source", "run", nil, "", "", "")
+	require.Nil(t, err)
+	assert.Equal(t, int32(3), modelVersion.Version)
+}
+
+func testAliases(t *testing.T, store store.ModelRegistryStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	name := createRegisteredModel(t, store, 2)
+
+	require.Nil(t, store.SetRegisteredModelAlias(ctx, name, "champion", "1"))
+	require.Nil(t, store.SetRegisteredModelAlias(ctx, name, "champion", "2"))
+	require.Nil(t, store.SetRegisteredModelAlias(ctx, name, "challenger", "1"))
+
+	modelVersion, err := store.GetModelVersionByAlias(ctx, name, "champion")
+	require.Nil(t, err)
+	assert.Equal(t, int32(2), modelVersion.Version)
+	assert.Equal(t, []string{"champion"}, modelVersion.Aliases)
+
+	registeredModel, err := store.GetRegisteredModel(ctx, name)
+	require.Nil(t, err)
+	assert.ElementsMatch(t, []*entities.RegisteredModelAlias{
+		{Alias: "champion", Version: "2"},
+		{Alias: "challenger", Version: "1"},
+	}, registeredModel.Aliases)
+
+	require.Nil(t, store.DeleteRegisteredModelAlias(ctx, name, "champion"))
+	require.Nil(t, store.DeleteRegisteredModelAlias(ctx, name, "missing"))
+
+	_, err = store.GetModelVersionByAlias(ctx, name, "champion")
+	requireErrorCode(t, err, protos.ErrorCode_INVALID_PARAMETER_VALUE)
+
+	requireErrorCode(t, store.SetRegisteredModelAlias(ctx, name, "alias", "3"), protos.ErrorCode_RESOURCE_DOES_NOT_EXIST)
+}
+
+func testStages(t *testing.T, store store.ModelRegistryStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	name := createRegisteredModel(t, store, 3)
+
+	_, err := store.TransitionModelVersionStage(ctx, name, "1", "production", false)
+	require.Nil(t, err)
+
+	_, err = store.TransitionModelVersionStage(ctx, name, "2", "Archived", true)
+	requireErrorCode(t, err, protos.ErrorCode_INVALID_PARAMETER_VALUE)
+
+	_, err = store.TransitionModelVersionStage(ctx, name, "2", "invalid", false)
+	requireErrorCode(t, err, protos.ErrorCode_INVALID_PARAMETER_VALUE)
+
+	modelVersion, err := store.TransitionModelVersionStage(ctx, name, "2", "Production", true)
+	require.Nil(t, err)
+	assert.Equal(t, "Production", modelVersion.CurrentStage)
+
+	modelVersion, err = store.GetModelVersion(ctx, name, "1")
+	require.Nil(t, err)
+	assert.Equal(t, "Archived", modelVersion.CurrentStage)
+
+	latestVersions, err := store.GetLatestVersions(ctx, name, []string{"production"})
+	require.Nil(t, err)
+	require.Len(t, latestVersions, 1)
+	assert.Equal(t, "2", latestVersions[0].GetVersion())
+
+	latestVersions, err = store.GetLatestVersions(ctx, name, nil)
+	require.Nil(t, err)
+
+	versions := make([]string, 0, len(latestVersions))
+	for _, latestVersion := range latestVersions {
+		versions = append(versions, latestVersion.GetVersion())
+	}
+
+	assert.ElementsMatch(t, []string{"1", "2", "3"}, versions)
+
+	_, err = store.GetLatestVersions(ctx, name, []string{"invalid"})
+	requireErrorCode(t, err, protos.ErrorCode_BAD_REQUEST)
+}
+
+func testSearchRegisteredModels(t *testing.T, store store.ModelRegistryStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	prefix := "storetest-" + utils.NewUUID()
+
+	for _, suffix := range []string{"-b", "-a", "-c"} {
+		_, err := store.CreateRegisteredModel(
+			ctx, prefix+suffix, nil, []*entities.RegisteredModelTag{{Key: "suffix", Value: suffix}},
+		)
+		require.Nil(t, err)
+	}
+
+	filter := "name LIKE '" + prefix + "%'"
+
+	registeredModels, token, err := store.SearchRegisteredModels(ctx, filter, 2, []string{"name DESC"}, "")
+	require.Nil(t, err)
+	require.Len(t, registeredModels, 2)
+	assert.Equal(t, prefix+"-c", registeredModels[0].Name)
+	assert.Equal(t, prefix+"-b", registeredModels[1].Name)
+	require.NotEmpty(t, token)
+
+	registeredModels, token, err = store.SearchRegisteredModels(ctx, filter, 2, []string{"name DESC"}, token)
+	require.Nil(t, err)
+	require.Len(t, registeredModels, 1)
+	assert.Equal(t, prefix+"-a", registeredModels[0].Name)
+	assert.Empty(t, token)
+
+	registeredModels, _, err = store.SearchRegisteredModels(
+		ctx, filter+" AND tags.suffix = '-b'", 10, nil, "",
+	)
+	require.Nil(t, err)
+	require.Len(t, registeredModels, 1)
+	assert.Equal(t, prefix+"-b", registeredModels[0].Name)
+
+	_, _, err = store.SearchRegisteredModels(ctx, filter, 10, []string{"invalid"}, "")
+	requireErrorCode(t, err, protos.ErrorCode_INVALID_PARAMETER_VALUE)
+}
+
+func testSearchModelVersions(t *testing.T, store store.ModelRegistryStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	name := createRegisteredModel(t, store, 3)
+
+	require.Nil(t, store.DeleteModelVersion(ctx, name, "2"))
+
+	// The versions are ordered by name, then by descending version.
+	modelVersions, _, err := store.SearchModelVersions(ctx, "name = '"+name+"'", 10, nil, "")
+	require.Nil(t, err)
+	require.Len(t, modelVersions, 2)
+	assert.Equal(t, int32(3), modelVersions[0].Version)
+	assert.Equal(t, int32(1), modelVersions[1].Version)
+
+	modelVersions, _, err = store.SearchModelVersions(
+		ctx, "name = '"+name+"' AND version_number >= 2", 10, []string{"version_number ASC"}, "",
+	)
+	require.Nil(t, err)
+	require.Len(t, modelVersions, 1)
+	assert.Equal(t, int32(3), modelVersions[0].Version)
+}
+
+func testSearchModelVersionPages(t *testing.T, store store.ModelRegistryStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	name := createRegisteredModel(t, store, 12)
+	filter := "name = '" + name + "'"
+	token := ""
+
+	// The second page starts at offset 10, whose token has to decode as such.
+	for _, versions := range [][]int32{{12, 11, 10, 9, 8}, {7, 6, 5, 4, 3}, {2, 1}} {
+		modelVersions, nextPageToken, err := store.SearchModelVersions(ctx, filter, 5, nil, token)
+		require.Nil(t, err)
+		require.Len(t, modelVersions, len(versions))
+
+		for index, version := range versions {
+			assert.Equal(t, version, modelVersions[index].Version)
+		}
+
+		token = nextPageToken
+	}
+
+	assert.Empty(t, token)
+}
+
+func testInvalidMaxResults(t *testing.T, store store.ModelRegistryStore) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	_, _, err := store.SearchRegisteredModels(ctx, "", 0, nil, "")
+	requireErrorCode(t, err, protos.ErrorCode_INVALID_PARAMETER_VALUE)
+
+	_, _, err = store.SearchModelVersions(ctx, "", 200001, nil, "")
+	requireErrorCode(t, err, protos.ErrorCode_INVALID_PARAMETER_VALUE)
+}
@@ -365,9 +365,9 @@ type CreateRegisteredModel struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Register models under this name
-	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" query:"name" params:"name"`
+	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" query:"name" params:"name" validate:"required"`
 	// Additional metadata for registered model.
-	Tags []*RegisteredModelTag `protobuf:"bytes,2,rep,name=tags" json:"tags,omitempty" query:"tags" params:"tags"`
+	Tags []*RegisteredModelTag `protobuf:"bytes,2,rep,name=tags" json:"tags,omitempty" query:"tags" params:"tags" validate:"omitempty,dive"`
 	// Optional description for registered model.
 	Description *string `protobuf:"bytes,3,opt,name=description" json:"description,omitempty" query:"description" params:"description"`
 }
@@ -768,14 +768,14 @@ type CreateModelVersion struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Register model under this name
-	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" query:"name" params:"name"`
+	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" query:"name" params:"name" validate:"required"`
 	// URI indicating the location of the model artifacts.
-	Source *string `protobuf:"bytes,2,opt,name=source" json:"source,omitempty" query:"source" params:"source"`
+	Source *string `protobuf:"bytes,2,opt,name=source" json:"source,omitempty" query:"source" params:"source" validate:"required"`
 	// MLflow run ID for correlation, if “source“ was generated by an experiment run in
 	// MLflow tracking server
 	RunId *string `protobuf:"bytes,3,opt,name=run_id,json=runId" json:"run_id,omitempty" query:"run_id" params:"run_id"`
 	// Additional metadata for model version.
-	Tags []*ModelVersionTag `protobuf:"bytes,4,rep,name=tags" json:"tags,omitempty" query:"tags" params:"tags"`
+	Tags []*ModelVersionTag `protobuf:"bytes,4,rep,name=tags" json:"tags,omitempty" query:"tags" params:"tags" validate:"omitempty,dive"`
 	// MLflow run link - this is the exact link of the run that generated this model version,
 	// potentially hosted at another instance of MLflow.
 	RunLink *string `protobuf:"bytes,5,opt,name=run_link,json=runLink" json:"run_link,omitempty" query:"run_link" params:"run_link"`
@@ -925,11 +925,11 @@ type TransitionModelVersionStage struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Name of the registered model
-	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" query:"name" params:"name"`
+	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" query:"name" params:"name" validate:"required"`
 	// Model version number
-	Version *string `protobuf:"bytes,2,opt,name=version" json:"version,omitempty" query:"version" params:"version"`
+	Version *string `protobuf:"bytes,2,opt,name=version" json:"version,omitempty" query:"version" params:"version" validate:"required,stringAsPositiveInteger"`
 	// Transition `model_version` to new stage.
-	Stage *string `protobuf:"bytes,3,opt,name=stage" json:"stage,omitempty" query:"stage" params:"stage"`
+	Stage *string `protobuf:"bytes,3,opt,name=stage" json:"stage,omitempty" query:"stage" params:"stage" validate:"required"`
 	// When transitioning a model version to a particular stage, this flag dictates whether all
 	// existing model versions in that stage should be atomically moved to the "archived" stage.
 	// This ensures that at-most-one model version exists in the target stage.
@@ -1056,9 +1056,9 @@ type GetModelVersion struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Name of the registered model
-	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" query:"name" params:"name"`
+	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" query:"name" params:"name" validate:"required"`
 	// Model version number
-	Version *string `protobuf:"bytes,2,opt,name=version" json:"version,omitempty" query:"version" params:"version"`
+	Version *string `protobuf:"bytes,2,opt,name=version" json:"version,omitempty" query:"version" params:"version" validate:"required,stringAsPositiveInteger"`
 }
 
 func (x *GetModelVersion) Reset() {
@@ -1194,9 +1194,9 @@ type GetModelVersionDownloadUri struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Name of the registered model
-	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" query:"name" params:"name"`
+	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" query:"name" params:"name" validate:"required"`
 	// Model version number
-	Version *string `protobuf:"bytes,2,opt,name=version" json:"version,omitempty" query:"version" params:"version"`
+	Version *string `protobuf:"bytes,2,opt,name=version" json:"version,omitempty" query:"version" params:"version" validate:"required,stringAsPositiveInteger"`
 }
 
 func (x *GetModelVersionDownloadUri) Reset() {
@@ -1250,9 +1250,9 @@ type ModelVersionTag struct {
 	unknownFields protoimpl.UnknownFields
 
 	// The tag key.
-	Key *string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty" query:"key" params:"key"`
+	Key *string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty" query:"key" params:"key" validate:"required,max=250,validMetricParamOrTagName,pathIsUnique"`
 	// The tag value.
-	Value *string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty" query:"value" params:"value"`
+	Value *string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty" query:"value" params:"value" validate:"omitempty,max=5000"`
 }
 
 func (x *ModelVersionTag) Reset() {
@@ -1306,9 +1306,9 @@ type RegisteredModelTag struct {
 	unknownFields protoimpl.UnknownFields
 
 	// The tag key.
-	Key *string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty" query:"key" params:"key"`
+	Key *string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty" query:"key" params:"key" validate:"required,max=250,validMetricParamOrTagName,pathIsUnique"`
 	// The tag value.
-	Value *string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty" query:"value" params:"value"`
+	Value *string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty" query:"value" params:"value" validate:"omitempty,max=5000"`
 }
 
 func (x *RegisteredModelTag) Reset() {
@@ -1361,13 +1361,13 @@ type SetRegisteredModelTag struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Unique name of the model.
-	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" query:"name" params:"name"`
+	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" query:"name" params:"name" validate:"required"`
 	// Name of the tag. Maximum size depends on storage backend.
 	// If a tag with this name already exists, its preexisting value will be replaced by the specified `value`.
 	// All storage backends are guaranteed to support key values up to 250 bytes in size.
-	Key *string `protobuf:"bytes,2,opt,name=key" json:"key,omitempty" query:"key" params:"key"`
+	Key *string `protobuf:"bytes,2,opt,name=key" json:"key,omitempty" query:"key" params:"key" validate:"required,max=250,validMetricParamOrTagName,pathIsUnique"`
 	// String value of the tag being logged. Maximum size depends on storage backend.
-	Value *string `protobuf:"bytes,3,opt,name=value" json:"value,omitempty" query:"value" params:"value"`
+	Value *string `protobuf:"bytes,3,opt,name=value" json:"value,omitempty" query:"value" params:"value" validate:"omitempty,max=5000"`
 }
 
 func (x *SetRegisteredModelTag) Reset() {
@@ -1427,15 +1427,15 @@ type SetModelVersionTag struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Unique name of the model.
-	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" query:"name" params:"name"`
+	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" query:"name" params:"name" validate:"required"`
 	// Model version number.
-	Version *string `protobuf:"bytes,2,opt,name=version" json:"version,omitempty" query:"version" params:"version"`
+	Version *string `protobuf:"bytes,2,opt,name=version" json:"version,omitempty" query:"version" params:"version" validate:"required,stringAsPositiveInteger"`
 	// Name of the tag. Maximum size depends on storage backend.
 	// If a tag with this name already exists, its preexisting value will be replaced by the specified `value`.
 	// All storage backends are guaranteed to support key values up to 250 bytes in size.
-	Key *string `protobuf:"bytes,3,opt,name=key" json:"key,omitempty" query:"key" params:"key"`
+	Key *string `protobuf:"bytes,3,opt,name=key" json:"key,omitempty" query:"key" params:"key" validate:"required,max=250,validMetricParamOrTagName,pathIsUnique"`
 	// String value of the tag being logged. Maximum size depends on storage backend.
-	Value *string `protobuf:"bytes,4,opt,name=value" json:"value,omitempty" query:"value" params:"value"`
+	Value *string `protobuf:"bytes,4,opt,name=value" json:"value,omitempty" query:"value" params:"value" validate:"omitempty,max=5000"`
 }
 
 func (x *SetModelVersionTag) Reset() {
@@ -1502,9 +1502,9 @@ type DeleteRegisteredModelTag struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Name of the registered model that the tag was logged under.
-	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" query:"name" params:"name"`
+	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" query:"name" params:"name" validate:"required"`
 	// Name of the tag. The name must be an exact match; wild-card deletion is not supported. Maximum size is 250 bytes.
-	Key *string `protobuf:"bytes,2,opt,name=key" json:"key,omitempty" query:"key" params:"key"`
+	Key *string `protobuf:"bytes,2,opt,name=key" json:"key,omitempty" query:"key" params:"key" validate:"required"`
 }
 
 func (x *DeleteRegisteredModelTag) Reset() {
@@ -1557,11 +1557,11 @@ type DeleteModelVersionTag struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Name of the registered model that the tag was logged under.
-	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" query:"name" params:"name"`
+	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" query:"name" params:"name" validate:"required"`
 	// Model version number that the tag was logged under.
-	Version *string `protobuf:"bytes,2,opt,name=version" json:"version,omitempty" query:"version" params:"version"`
+	Version *string `protobuf:"bytes,2,opt,name=version" json:"version,omitempty" query:"version" params:"version" validate:"required,stringAsPositiveInteger"`
 	// Name of the tag. The name must be an exact match; wild-card deletion is not supported. Maximum size is 250 bytes.
-	Key *string `protobuf:"bytes,3,opt,name=key" json:"key,omitempty" query:"key" params:"key"`
+	Key *string `protobuf:"bytes,3,opt,name=key" json:"key,omitempty" query:"key" params:"key" validate:"required"`
 }
 
 func (x *DeleteModelVersionTag) Reset() {
@@ -1677,13 +1677,13 @@ type SetRegisteredModelAlias struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Name of the registered model.
-	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" query:"name" params:"name"`
+	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" query:"name" params:"name" validate:"required"`
 	// Name of the alias. Maximum size depends on storage backend.
 	// If an alias with this name already exists, its preexisting value will be replaced by the specified `version`.
 	// All storage backends are guaranteed to support alias name values up to 256 bytes in size.
 	Alias *string `protobuf:"bytes,2,opt,name=alias" json:"alias,omitempty" query:"alias" params:"alias"`
 	// Model version number.
-	Version *string `protobuf:"bytes,3,opt,name=version" json:"version,omitempty" query:"version" params:"version"`
+	Version *string `protobuf:"bytes,3,opt,name=version" json:"version,omitempty" query:"version" params:"version" validate:"required,stringAsPositiveInteger"`
 }
 
 func (x *SetRegisteredModelAlias) Reset() {
@@ -1743,7 +1743,7 @@ type DeleteRegisteredModelAlias struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Name of the registered model.
-	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" query:"name" params:"name"`
+	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" query:"name" params:"name" validate:"required"`
 	// Name of the alias. The name must be an exact match; wild-card deletion is not supported. Maximum size is 256 bytes.
 	Alias *string `protobuf:"bytes,2,opt,name=alias" json:"alias,omitempty" query:"alias" params:"alias"`
 }
@@ -1798,7 +1798,7 @@ type GetModelVersionByAlias struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Name of the registered model.
-	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" query:"name" params:"name"`
+	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" query:"name" params:"name" validate:"required"`
 	// Name of the alias. Maximum size is 256 bytes.
 	Alias *string `protobuf:"bytes,2,opt,name=alias" json:"alias,omitempty" query:"alias" params:"alias"`
 }
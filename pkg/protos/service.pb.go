@@ -2329,7 +2329,7 @@ type ListArtifacts struct {
 	// be removed in a future MLflow version.
 	RunUuid *string `protobuf:"bytes,1,opt,name=run_uuid,json=runUuid" json:"run_uuid,omitempty" query:"run_uuid" params:"run_uuid"`
 	// Filter artifacts matching this path (a relative path from the root artifact directory).
-	Path *string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty" query:"path" params:"path"`
+	Path *string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty" query:"path" params:"path" validate:"omitempty,pathIsUnique"`
 	// Token indicating the page of artifact results to fetch
 	PageToken *string `protobuf:"bytes,4,opt,name=page_token,json=pageToken" json:"page_token,omitempty" query:"page_token" params:"page_token"`
 }
@@ -2475,7 +2475,7 @@ type GetMetricHistory struct {
 	// Backend servers may restrict the value of `max_results` depending on performance requirements.
 	// Requests that do not specify this value will behave as non-paginated queries where all
 	// metric history values for a given metric within a run are returned in a single response.
-	MaxResults *int32 `protobuf:"varint,5,opt,name=max_results,json=maxResults" json:"max_results,omitempty" query:"max_results" params:"max_results"`
+	MaxResults *int32 `protobuf:"varint,5,opt,name=max_results,json=maxResults" json:"max_results,omitempty" query:"max_results" params:"max_results" validate:"omitempty,gt=0,max=25000"`
 }
 
 func (x *GetMetricHistory) Reset() {
@@ -2636,9 +2636,9 @@ type GetMetricHistoryBulkInterval struct {
 	unknownFields protoimpl.UnknownFields
 
 	// ID(s) of the run(s) from which to fetch metric values. Must be provided.
-	RunIds []string `protobuf:"bytes,1,rep,name=run_ids,json=runIds" json:"run_ids,omitempty" query:"run_ids" params:"run_ids"`
+	RunIds []string `protobuf:"bytes,1,rep,name=run_ids,json=runIds" json:"run_ids,omitempty" query:"run_ids" params:"run_ids" validate:"required,max=100"`
 	// Name of the metric.
-	MetricKey *string `protobuf:"bytes,2,opt,name=metric_key,json=metricKey" json:"metric_key,omitempty" query:"metric_key" params:"metric_key"`
+	MetricKey *string `protobuf:"bytes,2,opt,name=metric_key,json=metricKey" json:"metric_key,omitempty" query:"metric_key" params:"metric_key" validate:"required"`
 	// Optional start step to only fetch metrics after the specified step. Must be defined if
 	// end_step is defined.
 	StartStep *int32 `protobuf:"varint,3,opt,name=start_step,json=startStep" json:"start_step,omitempty" query:"start_step" params:"start_step"`
@@ -2648,7 +2648,7 @@ type GetMetricHistoryBulkInterval struct {
 	// Maximum number of results to fetch per run specified. Must be set to a positive number.
 	// Note, in reality, the API returns at most (max_results + # of run IDs) x (# run IDs) metric
 	// data points.
-	MaxResults *int32 `protobuf:"varint,5,opt,name=max_results,json=maxResults" json:"max_results,omitempty" query:"max_results" params:"max_results"`
+	MaxResults *int32 `protobuf:"varint,5,opt,name=max_results,json=maxResults" json:"max_results,omitempty" query:"max_results" params:"max_results" validate:"omitempty,gt=0,max=2500"`
 }
 
 func (x *GetMetricHistoryBulkInterval) Reset() {
@@ -3366,13 +3366,13 @@ type SearchTraces struct {
 	unknownFields protoimpl.UnknownFields
 
 	// List of experiment IDs to search over.
-	ExperimentIds []string `protobuf:"bytes,1,rep,name=experiment_ids,json=experimentIds" json:"experiment_ids,omitempty" query:"experiment_ids" params:"experiment_ids"`
+	ExperimentIds []string `protobuf:"bytes,1,rep,name=experiment_ids,json=experimentIds" json:"experiment_ids,omitempty" query:"experiment_ids" params:"experiment_ids" validate:"required"`
 	// A filter expression over trace attributes and tags that allows returning a subset of
 	// traces. The syntax is a subset of SQL that supports ANDing together binary operations
 	// Example: “trace.status = 'OK' and trace.timestamp_ms > 1711089570679“.
 	Filter *string `protobuf:"bytes,2,opt,name=filter" json:"filter,omitempty" query:"filter" params:"filter"`
 	// Maximum number of traces desired. Max threshold is 500.
-	MaxResults *int32 `protobuf:"varint,3,opt,name=max_results,json=maxResults,def=100" json:"max_results,omitempty" query:"max_results" params:"max_results"`
+	MaxResults *int32 `protobuf:"varint,3,opt,name=max_results,json=maxResults,def=100" json:"max_results,omitempty" query:"max_results" params:"max_results" validate:"omitempty,gt=0,max=500"`
 	// List of columns for ordering the results, e.g. “["timestamp_ms DESC"]“.
 	OrderBy []string `protobuf:"bytes,4,rep,name=order_by,json=orderBy" json:"order_by,omitempty" query:"order_by" params:"order_by"`
 	// Token indicating the page of traces to fetch.
@@ -0,0 +1,44 @@
+package server
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/mlflow/mlflow-go/pkg/sql"
+)
+
+const (
+	healthStatusOK       = "OK"
+	healthStatusReadOnly = "READ_ONLY"
+)
+
+// schemaChecks are the checks of the schemas of the databases of the stores, by service.
+type schemaChecks map[string]*sql.SchemaCheck
+
+func (checks schemaChecks) add(service string, store any) {
+	if checker, ok := store.(sql.SchemaChecker); ok {
+		checks[service] = checker.SchemaCheck()
+	}
+}
+
+type healthResponse struct {
+	Status  string       `json:"status"`
+	Schemas schemaChecks `json:"schemas,omitempty"`
+}
+
+// health answers OK like the Python server, or READ_ONLY when a database with an incompatible schema
+// was opened read-only. The checks of the schemas are returned to the clients accepting JSON.
+func (checks schemaChecks) health(c *fiber.Ctx) error {
+	status := healthStatusOK
+
+	for _, check := range checks {
+		if check.ReadOnly {
+			status = healthStatusReadOnly
+		}
+	}
+
+	if c.Accepts(fiber.MIMETextPlain, fiber.MIMEApplicationJSON) == fiber.MIMEApplicationJSON {
+		return c.JSON(healthResponse{Status: status, Schemas: checks})
+	}
+
+	return c.SendString(status)
+}
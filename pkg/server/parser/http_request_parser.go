@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
@@ -75,3 +76,21 @@ func (p *HTTPRequestParser) ParseQuery(ctx *fiber.Ctx, input interface{}) *contr
 
 	return nil
 }
+
+// ParseArtifactPath returns the artifact path captured by the wildcard of the route,
+// rejecting paths which are not clean relative paths.
+func (p *HTTPRequestParser) ParseArtifactPath(ctx *fiber.Ctx) (string, *contract.Error) {
+	artifactPath, err := url.PathUnescape(ctx.Params("*"))
+	if err != nil {
+		return "", contract.NewError(protos.ErrorCode_BAD_REQUEST, err.Error())
+	}
+
+	if err := p.validator.Var(artifactPath, "required,pathIsUnique"); err != nil {
+		return "", contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("Invalid path: %q", artifactPath),
+		)
+	}
+
+	return artifactPath, nil
+}
@@ -0,0 +1,92 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/valyala/fasthttp"
+)
+
+// The request bodies are streamed for the artifact uploads to be written to the repository instead of
+// being buffered, so the body limit of the server isn't enforced by fasthttp, which streams the bodies
+// over it. It is enforced by limitRequestBody on the other routes.
+
+// apiPrefixes are the paths where the REST API app is mounted.
+var apiPrefixes = []string{"/api/2.0", "/ajax-api/2.0"}
+
+// artifactUploadPaths are the paths of the artifact uploads, under the prefixes of the REST API.
+var artifactUploadPaths = []string{"/mlflow-artifacts/artifacts/", "/mlflow-artifacts/mpu/parts/"}
+
+// isArtifactUpload tells whether a request is an artifact upload, whose body is streamed.
+func isArtifactUpload(method, uri []byte) bool {
+	if string(method) != fiber.MethodPut {
+		return false
+	}
+
+	path, _, _ := bytes.Cut(uri, []byte("?"))
+
+	for _, prefix := range apiPrefixes {
+		for _, uploadPath := range artifactUploadPaths {
+			if bytes.HasPrefix(path, []byte(prefix+uploadPath)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// artifactUploadRequestConfig gives the artifact uploads their own read timeout, the read timeout of the server
+// bounding the read of the whole request.
+func artifactUploadRequestConfig(
+	readTimeout time.Duration,
+) func(header *fasthttp.RequestHeader) fasthttp.RequestConfig {
+	return func(header *fasthttp.RequestHeader) fasthttp.RequestConfig {
+		if isArtifactUpload(header.Method(), header.RequestURI()) {
+			return fasthttp.RequestConfig{ReadTimeout: readTimeout}
+		}
+
+		return fasthttp.RequestConfig{}
+	}
+}
+
+// limitRequestBody rejects the bodies over limit of the requests other than the artifact uploads, like fasthttp
+// does without streaming, and reads the others from their stream, for the handlers not to read streams of any length.
+func limitRequestBody(limit int) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if isArtifactUpload(c.Request().Header.Method(), c.Request().RequestURI()) {
+			return c.Next()
+		}
+
+		stream := c.Context().RequestBodyStream()
+		if stream == nil {
+			return c.Next()
+		}
+
+		// The body left unread, the connection can't be reused.
+		if c.Request().Header.ContentLength() > limit {
+			c.Context().SetConnectionClose()
+
+			return c.SendStatus(fiber.StatusRequestEntityTooLarge)
+		}
+
+		// Chunked bodies have no length, they're read up to the limit.
+		body, err := io.ReadAll(io.LimitReader(stream, int64(limit)+1))
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("failed to read request body: %v", err))
+		}
+
+		if len(body) > limit {
+			c.Context().SetConnectionClose()
+
+			return c.SendStatus(fiber.StatusRequestEntityTooLarge)
+		}
+
+		c.Request().SetBody(body)
+
+		return c.Next()
+	}
+}
@@ -0,0 +1,115 @@
+package server
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+
+	"github.com/mlflow/mlflow-go/pkg/config"
+)
+
+func newTestRootApp(t *testing.T) *fiber.App {
+	t.Helper()
+
+	cfg, err := config.NewConfigFromString("{}")
+	require.NoError(t, err)
+
+	app := newRootApp(cfg)
+
+	readLength := func(c *fiber.Ctx) error {
+		var body io.Reader = bytes.NewReader(c.Body())
+		if stream := c.Context().RequestBodyStream(); stream != nil {
+			body = stream
+		}
+
+		length, err := io.Copy(io.Discard, body)
+		if err != nil {
+			return err
+		}
+
+		return c.SendString(strconv.FormatInt(length, 10))
+	}
+
+	app.Post("/api/2.0/mlflow/runs/create", readLength)
+	app.Put("/api/2.0/mlflow-artifacts/artifacts/*", readLength)
+	app.Put("/ajax-api/2.0/mlflow-artifacts/mpu/parts/:upload_id/:part_number", readLength)
+
+	return app
+}
+
+func TestRequestBodyLimit(t *testing.T) {
+	t.Parallel()
+
+	app := newTestRootApp(t)
+	overLimit := bytes.Repeat([]byte("a"), bodyLimit+1)
+	tooLarge := fiber.StatusRequestEntityTooLarge
+
+	testCases := []struct {
+		name           string
+		method         string
+		path           string
+		body           []byte
+		chunked        bool
+		expectedStatus int
+	}{
+		{"UnderLimit", http.MethodPost, "/api/2.0/mlflow/runs/create", []byte("{}"), false, fiber.StatusOK},
+		{"OverLimit", http.MethodPost, "/api/2.0/mlflow/runs/create", overLimit, false, tooLarge},
+		{"ChunkedOverLimit", http.MethodPost, "/api/2.0/mlflow/runs/create", overLimit, true, tooLarge},
+		{"ArtifactUpload", http.MethodPut, "/api/2.0/mlflow-artifacts/artifacts/a/b.bin", overLimit, false, fiber.StatusOK},
+		{"MultipartPart", http.MethodPut, "/ajax-api/2.0/mlflow-artifacts/mpu/parts/id/1", overLimit, true, fiber.StatusOK},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(testCase.method, testCase.path, bytes.NewReader(testCase.body))
+			if testCase.chunked {
+				req.ContentLength = -1
+				req.TransferEncoding = []string{"chunked"}
+			}
+
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			assert.Equal(t, testCase.expectedStatus, resp.StatusCode)
+
+			if testCase.expectedStatus == fiber.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+				assert.Equal(t, strconv.Itoa(len(testCase.body)), string(body))
+			}
+		})
+	}
+}
+
+func TestArtifactUploadRequestConfig(t *testing.T) {
+	t.Parallel()
+
+	requestConfig := artifactUploadRequestConfig(time.Hour)
+
+	for uri, expected := range map[string]time.Duration{
+		"/api/2.0/mlflow-artifacts/artifacts/a/b.bin":                       time.Hour,
+		"/ajax-api/2.0/mlflow-artifacts/mpu/parts/id/1":                     time.Hour,
+		"/api/2.0/mlflow/runs/create":                                       0,
+		"/api/2.0/mlflow/runs/create?/api/2.0/mlflow-artifacts/artifacts/a": 0,
+	} {
+		var header fasthttp.RequestHeader
+
+		header.SetMethod(fiber.MethodPut)
+		header.SetRequestURI(uri)
+
+		assert.Equal(t, expected, requestConfig(&header).ReadTimeout, uri)
+	}
+}
@@ -6,7 +6,20 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/mlflow/mlflow-go/pkg/server/parser"
 	"github.com/mlflow/mlflow-go/pkg/contract/service"
+	"github.com/mlflow/mlflow-go/pkg/utils"
+	"github.com/mlflow/mlflow-go/pkg/protos/artifacts"
 )
 
 func RegisterArtifactsServiceRoutes(service service.ArtifactsService, parser *parser.HTTPRequestParser, app *fiber.App) {
+	app.Get("/mlflow-artifacts/artifacts", func(ctx *fiber.Ctx) error {
+		input := &artifacts.ListArtifacts{}
+		if err := parser.ParseQuery(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.ListArtifacts(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
 }
@@ -0,0 +1,179 @@
+package routes
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/contract/service"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/protos/artifacts"
+	"github.com/mlflow/mlflow-go/pkg/server/parser"
+	"github.com/mlflow/mlflow-go/pkg/utils"
+)
+
+// Port of _guess_mime_type in mlflow/server/handlers.py.
+func guessMimeType(artifactPath string) string {
+	if mimeType := mime.TypeByExtension(path.Ext(artifactPath)); mimeType != "" {
+		return mimeType
+	}
+
+	return fiber.MIMEOctetStream
+}
+
+// Port of _send_artifact in mlflow/server/handlers.py.
+// Artifacts are always sent as attachments, so browsers don't render them on the domain of the server.
+func sendArtifact(ctx *fiber.Ctx, artifactPath string, reader io.Reader) error {
+	ctx.Attachment(path.Base(artifactPath))
+	ctx.Set(fiber.HeaderContentType, guessMimeType(artifactPath))
+	ctx.Set(fiber.HeaderXContentTypeOptions, "nosniff")
+
+	// The reader is closed by fasthttp once the response has been written.
+	return ctx.SendStream(reader)
+}
+
+func requestBodyStream(ctx *fiber.Ctx) io.Reader {
+	if reader := ctx.Context().RequestBodyStream(); reader != nil {
+		return reader
+	}
+
+	return bytes.NewReader(ctx.Body())
+}
+
+//nolint:funlen
+func RegisterArtifactsServiceStreamingRoutes(
+	service service.ArtifactsStreamingService, parser *parser.HTTPRequestParser, app *fiber.App,
+) {
+	app.Get("/mlflow-artifacts/artifacts/*", func(ctx *fiber.Ctx) error {
+		artifactPath, err := parser.ParseArtifactPath(ctx)
+		if err != nil {
+			return err
+		}
+
+		reader, err := service.DownloadArtifact(utils.NewContextWithLoggerFromFiberContext(ctx), artifactPath)
+		if err != nil {
+			return err
+		}
+
+		return sendArtifact(ctx, artifactPath, reader)
+	})
+	app.Put("/mlflow-artifacts/artifacts/*", func(ctx *fiber.Ctx) error {
+		artifactPath, err := parser.ParseArtifactPath(ctx)
+		if err != nil {
+			return err
+		}
+
+		output, err := service.UploadArtifact(
+			utils.NewContextWithLoggerFromFiberContext(ctx), artifactPath, requestBodyStream(ctx),
+		)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(output)
+	})
+	app.Delete("/mlflow-artifacts/artifacts/*", func(ctx *fiber.Ctx) error {
+		artifactPath, err := parser.ParseArtifactPath(ctx)
+		if err != nil {
+			return err
+		}
+
+		output, err := service.DeleteArtifact(utils.NewContextWithLoggerFromFiberContext(ctx), artifactPath)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(output)
+	})
+	app.Post("/mlflow-artifacts/mpu/create/*", func(ctx *fiber.Ctx) error {
+		artifactPath, err := parser.ParseArtifactPath(ctx)
+		if err != nil {
+			return err
+		}
+
+		input := &artifacts.CreateMultipartUpload{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+
+		// Parts are sent back to this server, next to the create endpoint.
+		prefix, _, _ := strings.Cut(ctx.Path(), "/mpu/create/")
+		partsURL := ctx.BaseURL() + prefix + "/mpu/parts"
+
+		output, err := service.CreateMultipartUpload(
+			utils.NewContextWithLoggerFromFiberContext(ctx), artifactPath, input, partsURL,
+		)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(output)
+	})
+	app.Put("/mlflow-artifacts/mpu/parts/:upload_id/:part_number", func(ctx *fiber.Ctx) error {
+		partNumber, parseErr := strconv.ParseInt(ctx.Params("part_number"), 10, 64)
+		if parseErr != nil {
+			return contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("Invalid part number %q", ctx.Params("part_number")),
+			)
+		}
+
+		etag, err := service.UploadMultipartPart(
+			utils.NewContextWithLoggerFromFiberContext(ctx), ctx.Params("upload_id"), partNumber, requestBodyStream(ctx),
+		)
+		if err != nil {
+			return err
+		}
+
+		ctx.Set(fiber.HeaderETag, etag)
+
+		return ctx.SendStatus(fiber.StatusOK)
+	})
+	app.Post("/mlflow-artifacts/mpu/complete/*", func(ctx *fiber.Ctx) error {
+		artifactPath, err := parser.ParseArtifactPath(ctx)
+		if err != nil {
+			return err
+		}
+
+		input := &artifacts.CompleteMultipartUpload{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+
+		output, err := service.CompleteMultipartUpload(
+			utils.NewContextWithLoggerFromFiberContext(ctx), artifactPath, input,
+		)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(output)
+	})
+	app.Post("/mlflow-artifacts/mpu/abort/*", func(ctx *fiber.Ctx) error {
+		artifactPath, err := parser.ParseArtifactPath(ctx)
+		if err != nil {
+			return err
+		}
+
+		input := &artifacts.AbortMultipartUpload{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+
+		output, err := service.AbortMultipartUpload(
+			utils.NewContextWithLoggerFromFiberContext(ctx), artifactPath, input,
+		)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(output)
+	})
+}
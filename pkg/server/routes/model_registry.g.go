@@ -11,6 +11,17 @@ import (
 )
 
 func RegisterModelRegistryServiceRoutes(service service.ModelRegistryService, parser *parser.HTTPRequestParser, app *fiber.App) {
+	app.Post("/mlflow/registered-models/create", func(ctx *fiber.Ctx) error {
+		input := &protos.CreateRegisteredModel{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.CreateRegisteredModel(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
 	app.Post("/mlflow/registered-models/rename", func(ctx *fiber.Ctx) error {
 		input := &protos.RenameRegisteredModel{}
 		if err := parser.ParseBody(ctx, input); err != nil {
@@ -55,6 +66,17 @@ func RegisterModelRegistryServiceRoutes(service service.ModelRegistryService, pa
 		}
 		return ctx.JSON(output)
 	})
+	app.Get("/mlflow/registered-models/search", func(ctx *fiber.Ctx) error {
+		input := &protos.SearchRegisteredModels{}
+		if err := parser.ParseQuery(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.SearchRegisteredModels(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
 	app.Post("/mlflow/registered-models/get-latest-versions", func(ctx *fiber.Ctx) error {
 		input := &protos.GetLatestVersions{}
 		if err := parser.ParseBody(ctx, input); err != nil {
@@ -77,6 +99,17 @@ func RegisterModelRegistryServiceRoutes(service service.ModelRegistryService, pa
 		}
 		return ctx.JSON(output)
 	})
+	app.Post("/mlflow/model-versions/create", func(ctx *fiber.Ctx) error {
+		input := &protos.CreateModelVersion{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.CreateModelVersion(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
 	app.Patch("/mlflow/model-versions/update", func(ctx *fiber.Ctx) error {
 		input := &protos.UpdateModelVersion{}
 		if err := parser.ParseBody(ctx, input); err != nil {
@@ -88,6 +121,17 @@ func RegisterModelRegistryServiceRoutes(service service.ModelRegistryService, pa
 		}
 		return ctx.JSON(output)
 	})
+	app.Post("/mlflow/model-versions/transition-stage", func(ctx *fiber.Ctx) error {
+		input := &protos.TransitionModelVersionStage{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.TransitionModelVersionStage(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
 	app.Delete("/mlflow/model-versions/delete", func(ctx *fiber.Ctx) error {
 		input := &protos.DeleteModelVersion{}
 		if err := parser.ParseBody(ctx, input); err != nil {
@@ -99,4 +143,114 @@ func RegisterModelRegistryServiceRoutes(service service.ModelRegistryService, pa
 		}
 		return ctx.JSON(output)
 	})
+	app.Get("/mlflow/model-versions/get", func(ctx *fiber.Ctx) error {
+		input := &protos.GetModelVersion{}
+		if err := parser.ParseQuery(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.GetModelVersion(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Get("/mlflow/model-versions/search", func(ctx *fiber.Ctx) error {
+		input := &protos.SearchModelVersions{}
+		if err := parser.ParseQuery(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.SearchModelVersions(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Get("/mlflow/model-versions/get-download-uri", func(ctx *fiber.Ctx) error {
+		input := &protos.GetModelVersionDownloadUri{}
+		if err := parser.ParseQuery(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.GetModelVersionDownloadUri(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Post("/mlflow/registered-models/set-tag", func(ctx *fiber.Ctx) error {
+		input := &protos.SetRegisteredModelTag{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.SetRegisteredModelTag(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Post("/mlflow/model-versions/set-tag", func(ctx *fiber.Ctx) error {
+		input := &protos.SetModelVersionTag{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.SetModelVersionTag(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Delete("/mlflow/registered-models/delete-tag", func(ctx *fiber.Ctx) error {
+		input := &protos.DeleteRegisteredModelTag{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.DeleteRegisteredModelTag(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Delete("/mlflow/model-versions/delete-tag", func(ctx *fiber.Ctx) error {
+		input := &protos.DeleteModelVersionTag{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.DeleteModelVersionTag(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Post("/mlflow/registered-models/alias", func(ctx *fiber.Ctx) error {
+		input := &protos.SetRegisteredModelAlias{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.SetRegisteredModelAlias(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Delete("/mlflow/registered-models/alias", func(ctx *fiber.Ctx) error {
+		input := &protos.DeleteRegisteredModelAlias{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.DeleteRegisteredModelAlias(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Get("/mlflow/registered-models/alias", func(ctx *fiber.Ctx) error {
+		input := &protos.GetModelVersionByAlias{}
+		if err := parser.ParseQuery(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.GetModelVersionByAlias(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
 }
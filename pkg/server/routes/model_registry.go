@@ -0,0 +1,37 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/mlflow/mlflow-go/pkg/contract/service"
+	"github.com/mlflow/mlflow-go/pkg/server/parser"
+	"github.com/mlflow/mlflow-go/pkg/utils"
+)
+
+type modelVersionArtifactRequest struct {
+	Name    string `query:"name"    validate:"required"`
+	Version string `query:"version" validate:"required,stringAsPositiveInteger"`
+	Path    string `query:"path"    validate:"required,pathIsUnique"`
+}
+
+// RegisterModelRegistryServiceStreamingRoutes registers the routes used by the UI to read model version artifacts.
+// Like in mlflow, they live outside of the REST API, so app is the root application.
+func RegisterModelRegistryServiceStreamingRoutes(
+	service service.ModelRegistryStreamingService, parser *parser.HTTPRequestParser, app *fiber.App,
+) {
+	app.Get("/model-versions/get-artifact", func(ctx *fiber.Ctx) error {
+		var input modelVersionArtifactRequest
+		if err := parser.ParseQuery(ctx, &input); err != nil {
+			return err
+		}
+
+		reader, err := service.DownloadModelVersionArtifact(
+			utils.NewContextWithLoggerFromFiberContext(ctx), input.Name, input.Version, input.Path,
+		)
+		if err != nil {
+			return err
+		}
+
+		return sendArtifact(ctx, input.Path, reader)
+	})
+}
@@ -253,6 +253,17 @@ func RegisterTrackingServiceRoutes(service service.TrackingService, parser *pars
 		}
 		return ctx.JSON(output)
 	})
+	app.Get("/mlflow/metrics/get-history-bulk-interval", func(ctx *fiber.Ctx) error {
+		input := &protos.GetMetricHistoryBulkInterval{}
+		if err := parser.ParseQuery(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.GetMetricHistoryBulkInterval(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
 	app.Post("/mlflow/runs/log-batch", func(ctx *fiber.Ctx) error {
 		input := &protos.LogBatch{}
 		if err := parser.ParseBody(ctx, input); err != nil {
@@ -308,6 +319,17 @@ func RegisterTrackingServiceRoutes(service service.TrackingService, parser *pars
 		}
 		return ctx.JSON(output)
 	})
+	app.Get("/mlflow/traces", func(ctx *fiber.Ctx) error {
+		input := &protos.SearchTraces{}
+		if err := parser.ParseQuery(ctx, input); err != nil {
+			return err
+		}
+		output, err := service.SearchTraces(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
 	app.Post("/mlflow/traces/delete-traces", func(ctx *fiber.Ctx) error {
 		input := &protos.DeleteTraces{}
 		if err := parser.ParseBody(ctx, input); err != nil {
@@ -19,26 +19,35 @@ import (
 
 	as "github.com/mlflow/mlflow-go/pkg/artifacts/service"
 	mr "github.com/mlflow/mlflow-go/pkg/model_registry/service"
+	mrs "github.com/mlflow/mlflow-go/pkg/model_registry/store"
 	ts "github.com/mlflow/mlflow-go/pkg/tracking/service"
+	trackingStore "github.com/mlflow/mlflow-go/pkg/tracking/store"
 
 	"github.com/mlflow/mlflow-go/pkg/config"
 	"github.com/mlflow/mlflow-go/pkg/contract"
 	"github.com/mlflow/mlflow-go/pkg/protos"
 	"github.com/mlflow/mlflow-go/pkg/server/parser"
 	"github.com/mlflow/mlflow-go/pkg/server/routes"
+	"github.com/mlflow/mlflow-go/pkg/sql"
 	"github.com/mlflow/mlflow-go/pkg/utils"
 )
 
-//nolint:funlen
-func configureApp(ctx context.Context, cfg *config.Config) (*fiber.App, error) {
+// bodyLimit is the limit of the request bodies, except for the artifact uploads which are streamed.
+const bodyLimit = 16 * 1024 * 1024
+
+// newRootApp returns the app serving the REST API and UI apps, which streams the bodies of the artifact uploads.
+func newRootApp(cfg *config.Config) *fiber.App {
 	//nolint:mnd
 	app := fiber.New(fiber.Config{
-		BodyLimit:      16 * 1024 * 1024,
-		ReadBufferSize: 16384,
-		ReadTimeout:    5 * time.Second,
-		WriteTimeout:   600 * time.Second,
-		IdleTimeout:    120 * time.Second,
-		ServerHeader:   "mlflow/" + cfg.Version,
+		BodyLimit: bodyLimit,
+		// Artifact uploads are streamed to the repository instead of being buffered,
+		// the bodies of the other requests being limited by limitRequestBody.
+		StreamRequestBody: true,
+		ReadBufferSize:    16384,
+		ReadTimeout:       5 * time.Second,
+		WriteTimeout:      600 * time.Second,
+		IdleTimeout:       120 * time.Second,
+		ServerHeader:      "mlflow/" + cfg.Version,
 		JSONEncoder: func(value interface{}) ([]byte, error) {
 			if protoMessage, ok := value.(proto.Message); ok {
 				return protojson.MarshalOptions{
@@ -58,6 +67,17 @@ func configureApp(ctx context.Context, cfg *config.Config) (*fiber.App, error) {
 		DisableStartupMessage: true,
 	})
 
+	// The artifact uploads take longer than the read timeout of the other requests.
+	app.Server().HeaderReceived = artifactUploadRequestConfig(cfg.ArtifactsUploadReadTimeout.Duration)
+
+	app.Use(limitRequestBody(bodyLimit))
+
+	return app
+}
+
+func configureApp(ctx context.Context, cfg *config.Config) (*fiber.App, error) {
+	app := newRootApp(cfg)
+
 	app.Use(compress.New())
 	app.Use(recover.New(recover.Config{EnableStackTrace: true}))
 	app.Use(logger.New(logger.Config{
@@ -66,17 +86,20 @@ func configureApp(ctx context.Context, cfg *config.Config) (*fiber.App, error) {
 	}))
 	app.Use(func(c *fiber.Ctx) error {
 		c.SetUserContext(ctx)
+		// The reads after a write of the request go to the primary database instead of a read replica.
+		c.Locals(sql.WritesContextKey{}, &sql.Writes{})
 
 		return c.Next()
 	})
 
-	apiApp, err := newAPIApp(ctx, cfg)
+	apiApp, uiApp, schemaChecks, err := newApps(ctx, cfg)
 	if err != nil {
 		return nil, err
 	}
 
 	app.Mount("/api/2.0", apiApp)
 	app.Mount("/ajax-api/2.0", apiApp)
+	app.Mount("/", uiApp)
 
 	if cfg.StaticFolder != "" {
 		app.Static("/static-files", cfg.StaticFolder)
@@ -85,9 +108,7 @@ func configureApp(ctx context.Context, cfg *config.Config) (*fiber.App, error) {
 		})
 	}
 
-	app.Get("/health", func(c *fiber.Ctx) error {
-		return c.SendString("OK")
-	})
+	app.Get("/health", schemaChecks.health)
 	app.Get("/version", func(c *fiber.Ctx) error {
 		return c.SendString(cfg.Version)
 	})
@@ -193,34 +214,79 @@ func newFiberConfig() fiber.Config {
 	}
 }
 
-func newAPIApp(ctx context.Context, cfg *config.Config) (*fiber.App, error) {
+// SearchRunsIncludeHeader lists the sections of the runs returned by SearchRuns, such as "params,tags".
+// The sections left out aren't loaded from the store, all of them are returned without the header.
+const SearchRunsIncludeHeader = "X-MLflow-Search-Runs-Include"
+
+func parseSearchRunsInclude(c *fiber.Ctx) error {
+	include := c.Get(SearchRunsIncludeHeader)
+	if include == "" {
+		return c.Next()
+	}
+
+	sections, err := trackingStore.ParseRunSections(include)
+	if err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("invalid %s header: %v", SearchRunsIncludeHeader, err),
+			err,
+		)
+	}
+
+	// The Locals of Fiber are the values of the context passed to the service.
+	c.Locals(trackingStore.RunSectionsContextKey{}, sections)
+
+	return c.Next()
+}
+
+// newApps returns the REST API app and the app of the routes used by the UI outside of the REST API,
+// along with the checks of the schemas of the databases of their stores.
+//
+//nolint:funlen
+func newApps(ctx context.Context, cfg *config.Config) (*fiber.App, *fiber.App, schemaChecks, error) {
 	app := fiber.New(newFiberConfig())
+	uiApp := fiber.New(newFiberConfig())
 
 	parser, err := parser.NewHTTPRequestParser()
 	if err != nil {
-		return nil, fmt.Errorf("failed to create new HTTP request parser: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to create new HTTP request parser: %w", err)
 	}
 
 	trackingService, err := ts.NewTrackingService(ctx, cfg)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create new tracking service: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to create new tracking service: %w", err)
 	}
 
+	checks := make(schemaChecks)
+	checks.add("tracking", trackingService.Store)
+
+	app.Use("/mlflow/runs/search", parseSearchRunsInclude)
 	routes.RegisterTrackingServiceRoutes(trackingService, parser, app)
 
-	modelRegistryService, err := mr.NewModelRegistryService(ctx, cfg)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create new model registry service: %w", err)
-	}
+	// Without a Go store for its URI, such as the file store of MLflow,
+	// model registry requests are left to the Python server.
+	if mrs.SupportsModelRegistryStoreURI(cfg.ModelRegistryStoreURI) {
+		modelRegistryService, err := mr.NewModelRegistryService(ctx, cfg, trackingService.Store)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to create new model registry service: %w", err)
+		}
 
-	routes.RegisterModelRegistryServiceRoutes(modelRegistryService, parser, app)
+		checks.add("model_registry", modelRegistryService.Store())
+
+		routes.RegisterModelRegistryServiceRoutes(modelRegistryService, parser, app)
+		routes.RegisterModelRegistryServiceStreamingRoutes(modelRegistryService, parser, uiApp)
+	}
 
 	artifactService, err := as.NewArtifactsService(ctx, cfg)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create new artifacts service: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to create new artifacts service: %w", err)
 	}
 
-	routes.RegisterArtifactsServiceRoutes(artifactService, parser, app)
+	// Without an artifacts destination, artifact requests are left to the Python server.
+	if cfg.ArtifactsDestination != "" {
+		routes.RegisterArtifactsServiceRoutes(artifactService, parser, app)
+		routes.RegisterArtifactsServiceStreamingRoutes(artifactService, parser, app)
+	}
 
-	return app, nil
+	return app, uiApp, checks, nil
 }
@@ -0,0 +1,141 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go/pkg/config"
+)
+
+// newTestApp returns the REST API app of memory stores.
+func newTestApp(t *testing.T) *fiber.App {
+	t.Helper()
+
+	app, _, _, err := newApps(context.Background(), &config.Config{
+		TrackingStoreURI:      "memory://",
+		ModelRegistryStoreURI: "memory://",
+		DefaultArtifactRoot:   "s3://bucket/artifacts",
+	})
+	require.NoError(t, err)
+
+	return app
+}
+
+// call sends the request to app and decodes its JSON response.
+func call(t *testing.T, app *fiber.App, method, path string, request any) (int, map[string]any) {
+	t.Helper()
+
+	req := httptest.NewRequest(method, path, nil)
+
+	if request != nil {
+		body, err := json.Marshal(request)
+		require.NoError(t, err)
+
+		req = httptest.NewRequest(method, path, bytes.NewReader(body))
+		req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	}
+
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	var response map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&response))
+
+	return resp.StatusCode, response
+}
+
+func TestModelVersionSourceOfRun(t *testing.T) {
+	t.Parallel()
+
+	app := newTestApp(t)
+
+	status, experiment := call(t, app, http.MethodPost, "/mlflow/experiments/create", map[string]any{
+		"name": "experiment",
+	})
+	require.Equal(t, fiber.StatusOK, status, experiment)
+
+	status, run := call(t, app, http.MethodPost, "/mlflow/runs/create", map[string]any{
+		"experiment_id": experiment["experiment_id"],
+	})
+	require.Equal(t, fiber.StatusOK, status, run)
+
+	runInfo, _ := run["run"].(map[string]any)["info"].(map[string]any)
+
+	status, response := call(t, app, http.MethodPost, "/mlflow/registered-models/create", map[string]any{
+		"name": "model",
+	})
+	require.Equal(t, fiber.StatusOK, status, response)
+
+	status, response = call(t, app, http.MethodPost, "/mlflow/model-versions/create", map[string]any{
+		"name":   "model",
+		"source": "runs:/" + runInfo["run_id"].(string) + "/model",
+	})
+	require.Equal(t, fiber.StatusOK, status, response)
+
+	// The run is found in the store of the tracking service.
+	status, response = call(
+		t, app, http.MethodGet, "/mlflow/model-versions/get-download-uri?name=model&version=1", nil,
+	)
+	require.Equal(t, fiber.StatusOK, status, response)
+	assert.Equal(t, runInfo["artifact_uri"].(string)+"/model", response["artifact_uri"])
+}
+
+func TestSearchFilterErrors(t *testing.T) {
+	t.Parallel()
+
+	app := newTestApp(t)
+
+	testCases := []struct {
+		name            string
+		method          string
+		path            string
+		request         any
+		expectedMessage string
+	}{
+		{
+			name:    "Runs",
+			method:  http.MethodPost,
+			path:    "/mlflow/runs/search",
+			request: map[string]any{"experiment_ids": []string{"0"}, "filter": "attributes.foo = 1", "max_results": 10},
+			expectedMessage: "error parsing search filter: Invalid attribute key '{foo}' specified. " +
+				"Valid keys are '[run_id run_name user_id status start_time end_time artifact_uri]'",
+		},
+		{
+			name:    "Experiments",
+			method:  http.MethodPost,
+			path:    "/mlflow/experiments/search",
+			request: map[string]any{"filter": "tags.team > 'ml'", "max_results": 10},
+			expectedMessage: "error parsing search filter: " +
+				"only the =, !=, LIKE and ILIKE comparators are supported for tag.team, got >",
+		},
+		{
+			name:   "RegisteredModels",
+			method: http.MethodGet,
+			path:   "/mlflow/registered-models/search?filter=" + url.QueryEscape("tags.team IN ('ml')"),
+			expectedMessage: "error parsing search filter: " +
+				"only the =, !=, LIKE and ILIKE comparators are supported for tag.team, got IN",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			status, response := call(t, app, testCase.method, testCase.path, testCase.request)
+			assert.Equal(t, fiber.StatusBadRequest, status)
+			assert.Equal(t, "INVALID_PARAMETER_VALUE", response["error_code"])
+			assert.Equal(t, testCase.expectedMessage, response["message"])
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go/pkg/tracking/store/sql/models"
+	"github.com/mlflow/mlflow-go/pkg/utils"
+)
+
+var (
+	errUnknownRevision   = errors.New("unknown schema revision")
+	errMultipleRevisions = errors.New("multiple schema revisions")
+	errUnversionedTables = errors.New("tables exist without a schema revision")
+)
+
+// Migration is a revision of the schema, applied to the databases at its down revision,
+// like the alembic migrations of MLflow whose revision IDs it shares.
+type Migration struct {
+	Revision     string
+	DownRevision string
+	Description  string
+	Up           func(tx *gorm.DB) error
+}
+
+// migrations are the revisions applied by CreateSchema, in order.
+// The first one creates the schema of an empty database at once. The MLflow migrations before it aren't ported:
+// the databases at an older revision can only be upgraded by `mlflow db upgrade`, which needs Python.
+//
+//nolint:gochecknoglobals
+var migrations = []Migration{
+	{
+		Revision:    baselineRevision,
+		Description: "create the tracking and model registry tables",
+		Up:          createBaselineSchema,
+	},
+}
+
+// HeadRevision is the revision of the schema after all the migrations.
+func HeadRevision() string {
+	return migrations[len(migrations)-1].Revision
+}
+
+// CurrentRevision returns the revision recorded in the alembic_version table,
+// which is empty for a database which hasn't been migrated yet.
+func CurrentRevision(ctx context.Context, database *gorm.DB) (string, error) {
+	return currentRevision(database.WithContext(ctx))
+}
+
+func currentRevision(database *gorm.DB) (string, error) {
+	if !database.Migrator().HasTable(&models.AlembicVersion{}) {
+		return "", nil
+	}
+
+	var versions []models.AlembicVersion
+	if err := database.Find(&versions).Error; err != nil {
+		return "", fmt.Errorf("failed to read schema revision: %w", err)
+	}
+
+	switch len(versions) {
+	case 0:
+		return "", nil
+	case 1:
+		return *versions[0].VersionNum, nil
+	default:
+		return "", fmt.Errorf("%w: alembic_version has %d rows", errMultipleRevisions, len(versions))
+	}
+}
+
+// nextMigration returns the migration to apply after the revision, nil at the head revision.
+func nextMigration(revision string) (*Migration, error) {
+	if revision == "" {
+		return &migrations[0], nil
+	}
+
+	for index := range migrations {
+		if migrations[index].Revision != revision {
+			continue
+		}
+
+		if index == len(migrations)-1 {
+			return nil, nil //nolint:nilnil
+		}
+
+		return &migrations[index+1], nil
+	}
+
+	return nil, fmt.Errorf(
+		"%w %q: the database is either older than revision %s and must be upgraded with `mlflow db upgrade`, "+
+			"or newer than revision %s supported by this server",
+		errUnknownRevision, revision, SupportedRevisions()[0], HeadRevision(),
+	)
+}
+
+func createAlembicVersionTable(tx *gorm.DB) error {
+	return newSchemaWriter(tx).createTables(table{
+		name:           (&models.AlembicVersion{}).TableName(),
+		columns:        []column{notNull(varchar("version_num", 32))},
+		primaryKeyName: "alembic_version_pkc",
+		primaryKey:     []string{"version_num"},
+	})
+}
+
+// setRevision records the revision in the alembic_version table, like alembic does.
+func setRevision(tx *gorm.DB, from, revision string) error {
+	if from == "" {
+		if !tx.Migrator().HasTable(&models.AlembicVersion{}) {
+			if err := createAlembicVersionTable(tx); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Create(&models.AlembicVersion{VersionNum: &revision}).Error; err != nil {
+			return fmt.Errorf("failed to record schema revision %s: %w", revision, err)
+		}
+
+		return nil
+	}
+
+	if err := tx.Model(&models.AlembicVersion{}).
+		Where("version_num = ?", from).
+		Update("version_num", revision).Error; err != nil {
+		return fmt.Errorf("failed to record schema revision %s: %w", revision, err)
+	}
+
+	return nil
+}
+
+// migrateOnce applies the migration following the current revision in a transaction,
+// so that a failed migration leaves the database at its revision, except for the DDL of MySQL which isn't
+// transactional. It returns nil when the database is at the head revision.
+func migrateOnce(database *gorm.DB) (*Migration, error) {
+	var applied *Migration
+
+	err := database.Transaction(func(tx *gorm.DB) error {
+		revision, err := currentRevision(tx)
+		if err != nil {
+			return err
+		}
+
+		migration, err := nextMigration(revision)
+		if err != nil || migration == nil {
+			return err
+		}
+
+		if revision == "" && tx.Migrator().HasTable("experiments") {
+			return fmt.Errorf(
+				"%w: the database wasn't created by the MLflow migrations and must be migrated by hand",
+				errUnversionedTables,
+			)
+		}
+
+		if err := migration.Up(tx); err != nil {
+			return fmt.Errorf("failed to apply migration %s: %w", migration.Revision, err)
+		}
+
+		if err := setRevision(tx, revision, migration.Revision); err != nil {
+			return err
+		}
+
+		applied = migration
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	return applied, nil
+}
+
+// CreateSchema creates the schema of an empty database at the head revision, recording it in alembic_version
+// for the MLflow migrations to upgrade it later. It leaves the databases at a supported revision as they are,
+// and refuses the ones at an older revision, which must be upgraded by `mlflow db upgrade`.
+func CreateSchema(ctx context.Context, database *gorm.DB) error {
+	logger := utils.GetLoggerFromContext(ctx)
+	database = database.WithContext(ctx)
+
+	// The databases at a newer revision of the MLflow migrations which only adds to the schema are left as they are.
+	revision, err := currentRevision(database)
+	if err != nil {
+		return err
+	}
+
+	// So are the ones at an older revision the stores work with, which only `mlflow db upgrade` upgrades.
+	if slices.Contains(olderSupportedRevisions, revision) {
+		logger.Infof("Leaving the schema at revision %s, supported by this server", revision)
+
+		return nil
+	}
+
+	if revision != "" && !slices.ContainsFunc(migrations, isRevision(revision)) {
+		mismatch, err := additiveSchemaMismatch(database)
+		if err != nil {
+			return err
+		}
+
+		if mismatch == "" {
+			logger.Infof("Leaving the schema at revision %s, which only adds to revision %s", revision, HeadRevision())
+
+			return nil
+		}
+	}
+
+	for {
+		migration, err := migrateOnce(database)
+		if err != nil {
+			return err
+		}
+
+		if migration == nil {
+			return nil
+		}
+
+		logger.Infof("Applied migration %s: %s", migration.Revision, migration.Description)
+	}
+}
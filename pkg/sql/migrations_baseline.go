@@ -0,0 +1,341 @@
+package sql
+
+import "gorm.io/gorm"
+
+// baselineRevision is the revision of the MLflow migrations whose schema is created by createBaselineSchema,
+// for the databases created by the Go server to be upgraded by `mlflow db upgrade` and the other way around.
+const baselineRevision = "0584bdc529eb"
+
+const (
+	onDeleteCascade = "CASCADE"
+	onUpdateCascade = "CASCADE"
+)
+
+//nolint:gochecknoglobals
+var (
+	lifecycleStages = []string{"active", "deleted"}
+	runSourceTypes  = []string{"NOTEBOOK", "JOB", "LOCAL", "UNKNOWN", "PROJECT"}
+	runStatuses     = []string{"SCHEDULED", "FAILED", "FINISHED", "RUNNING", "KILLED"}
+)
+
+func runForeignKey() foreignKey {
+	return foreignKey{columns: []string{"run_uuid"}, refTable: "runs", refColumns: []string{"run_uuid"}}
+}
+
+func registeredModelForeignKey() foreignKey {
+	return foreignKey{
+		columns: []string{"name"}, refTable: "registered_models", refColumns: []string{"name"}, onUpdate: onUpdateCascade,
+	}
+}
+
+//nolint:funlen
+func trackingTables() []table {
+	return []table{
+		{
+			name: "experiments",
+			columns: []column{
+				ofType("experiment_id", typeSerial),
+				notNull(varchar("name", 256)),
+				varchar("artifact_location", 256),
+				varchar("lifecycle_stage", 32),
+				ofType("creation_time", typeBigInteger),
+				ofType("last_update_time", typeBigInteger),
+			},
+			primaryKeyName: "experiment_pk",
+			primaryKey:     []string{"experiment_id"},
+			unique:         [][]string{{"name"}},
+			checks: []check{
+				{name: "experiments_lifecycle_stage", column: "lifecycle_stage", values: lifecycleStages},
+			},
+		},
+		{
+			name: "experiment_tags",
+			columns: []column{
+				notNull(varchar("key", 250)),
+				varchar("value", 5000),
+				notNull(ofType("experiment_id", typeInteger)),
+			},
+			primaryKeyName: "experiment_tag_pk",
+			primaryKey:     []string{"key", "experiment_id"},
+			foreignKeys: []foreignKey{
+				{columns: []string{"experiment_id"}, refTable: "experiments", refColumns: []string{"experiment_id"}},
+			},
+		},
+		{
+			name: "runs",
+			columns: []column{
+				notNull(varchar("run_uuid", 32)),
+				varchar("name", 250),
+				varchar("source_type", 20),
+				varchar("source_name", 500),
+				varchar("entry_point_name", 50),
+				varchar("user_id", 256),
+				varchar("status", 9),
+				ofType("start_time", typeBigInteger),
+				ofType("end_time", typeBigInteger),
+				ofType("deleted_time", typeBigInteger),
+				varchar("source_version", 50),
+				varchar("lifecycle_stage", 20),
+				varchar("artifact_uri", 200),
+				ofType("experiment_id", typeInteger),
+			},
+			primaryKeyName: "run_pk",
+			primaryKey:     []string{"run_uuid"},
+			foreignKeys: []foreignKey{
+				{columns: []string{"experiment_id"}, refTable: "experiments", refColumns: []string{"experiment_id"}},
+			},
+			checks: []check{
+				{name: "source_type", column: "source_type", values: runSourceTypes},
+				{name: "status", column: "status", values: runStatuses},
+				{name: "runs_lifecycle_stage", column: "lifecycle_stage", values: lifecycleStages},
+			},
+		},
+		{
+			name: "tags",
+			columns: []column{
+				notNull(varchar("key", 250)),
+				varchar("value", 8000),
+				notNull(varchar("run_uuid", 32)),
+			},
+			primaryKeyName: "tag_pk",
+			primaryKey:     []string{"key", "run_uuid"},
+			foreignKeys:    []foreignKey{runForeignKey()},
+			indexes:        []index{{name: "index_tags_run_uuid", columns: []string{"run_uuid"}}},
+		},
+		{
+			name: "params",
+			columns: []column{
+				notNull(varchar("key", 250)),
+				notNull(varchar("value", 8000)),
+				notNull(varchar("run_uuid", 32)),
+			},
+			primaryKeyName: "param_pk",
+			primaryKey:     []string{"key", "run_uuid"},
+			foreignKeys:    []foreignKey{runForeignKey()},
+			indexes:        []index{{name: "index_params_run_uuid", columns: []string{"run_uuid"}}},
+		},
+		{
+			name: "metrics",
+			columns: []column{
+				notNull(varchar("key", 250)),
+				notNull(ofType("value", typeFloat)),
+				notNull(ofType("timestamp", typeBigInteger)),
+				notNull(varchar("run_uuid", 32)),
+				notNull(withDefault(ofType("step", typeBigInteger), "0")),
+				notNull(withDefault(ofType("is_nan", typeBoolean), "false")),
+			},
+			primaryKeyName: "metric_pk",
+			primaryKey:     []string{"key", "timestamp", "step", "run_uuid", "value", "is_nan"},
+			foreignKeys:    []foreignKey{runForeignKey()},
+			indexes:        []index{{name: "index_metrics_run_uuid", columns: []string{"run_uuid"}}},
+		},
+		{
+			name: "latest_metrics",
+			columns: []column{
+				notNull(varchar("key", 250)),
+				notNull(ofType("value", typeFloat)),
+				ofType("timestamp", typeBigInteger),
+				notNull(ofType("step", typeBigInteger)),
+				notNull(ofType("is_nan", typeBoolean)),
+				notNull(varchar("run_uuid", 32)),
+			},
+			primaryKeyName: "latest_metric_pk",
+			primaryKey:     []string{"key", "run_uuid"},
+			foreignKeys:    []foreignKey{runForeignKey()},
+			indexes:        []index{{name: "index_latest_metrics_run_uuid", columns: []string{"run_uuid"}}},
+		},
+		{
+			name: "datasets",
+			columns: []column{
+				notNull(varchar("dataset_uuid", 36)),
+				notNull(ofType("experiment_id", typeInteger)),
+				notNull(varchar("name", 500)),
+				notNull(varchar("digest", 36)),
+				notNull(varchar("dataset_source_type", 36)),
+				notNull(ofType("dataset_source", typeText)),
+				ofType("dataset_schema", typeMediumText),
+				ofType("dataset_profile", typeMediumText),
+			},
+			primaryKeyName: "dataset_pk",
+			primaryKey:     []string{"experiment_id", "name", "digest"},
+			foreignKeys: []foreignKey{{
+				columns:    []string{"experiment_id"},
+				refTable:   "experiments",
+				refColumns: []string{"experiment_id"},
+				onDelete:   onDeleteCascade,
+			}},
+			indexes: []index{
+				{name: "index_datasets_dataset_uuid", columns: []string{"dataset_uuid"}},
+				{
+					name:    "index_datasets_experiment_id_dataset_source_type",
+					columns: []string{"experiment_id", "dataset_source_type"},
+				},
+			},
+		},
+		{
+			name: "inputs",
+			columns: []column{
+				notNull(varchar("input_uuid", 36)),
+				notNull(varchar("source_type", 36)),
+				notNull(varchar("source_id", 36)),
+				notNull(varchar("destination_type", 36)),
+				notNull(varchar("destination_id", 36)),
+			},
+			primaryKeyName: "inputs_pk",
+			primaryKey:     []string{"source_type", "source_id", "destination_type", "destination_id"},
+			indexes: []index{
+				{name: "index_inputs_input_uuid", columns: []string{"input_uuid"}},
+				{
+					name:    "index_inputs_destination_type_destination_id_source_type",
+					columns: []string{"destination_type", "destination_id", "source_type"},
+				},
+			},
+		},
+		{
+			name: "input_tags",
+			columns: []column{
+				notNull(varchar("input_uuid", 36)),
+				notNull(varchar("name", 255)),
+				notNull(varchar("value", 500)),
+			},
+			primaryKeyName: "input_tags_pk",
+			primaryKey:     []string{"input_uuid", "name"},
+		},
+		{
+			name: "trace_info",
+			columns: []column{
+				notNull(varchar("request_id", 50)),
+				notNull(ofType("experiment_id", typeInteger)),
+				notNull(ofType("timestamp_ms", typeBigInteger)),
+				ofType("execution_time_ms", typeBigInteger),
+				notNull(varchar("status", 50)),
+			},
+			primaryKeyName: "trace_info_pk",
+			primaryKey:     []string{"request_id"},
+			foreignKeys: []foreignKey{{
+				name:       "fk_trace_info_experiment_id",
+				columns:    []string{"experiment_id"},
+				refTable:   "experiments",
+				refColumns: []string{"experiment_id"},
+			}},
+			indexes: []index{{
+				name:    "index_trace_info_experiment_id_timestamp_ms",
+				columns: []string{"experiment_id", "timestamp_ms"},
+			}},
+		},
+		traceChildTable("trace_tags", "trace_tag_pk"),
+		traceChildTable("trace_request_metadata", "trace_request_metadata_pk"),
+	}
+}
+
+// traceChildTable returns a table of key-values of the traces, deleted along with their trace.
+func traceChildTable(name, primaryKeyName string) table {
+	return table{
+		name: name,
+		columns: []column{
+			notNull(varchar("key", 250)),
+			varchar("value", 8000),
+			notNull(varchar("request_id", 50)),
+		},
+		primaryKeyName: primaryKeyName,
+		primaryKey:     []string{"key", "request_id"},
+		foreignKeys: []foreignKey{{
+			name:       "fk_" + name + "_request_id",
+			columns:    []string{"request_id"},
+			refTable:   "trace_info",
+			refColumns: []string{"request_id"},
+			onDelete:   onDeleteCascade,
+		}},
+		indexes: []index{{name: "index_" + name + "_request_id", columns: []string{"request_id"}}},
+	}
+}
+
+//nolint:funlen
+func modelRegistryTables() []table {
+	return []table{
+		{
+			name: "registered_models",
+			columns: []column{
+				notNull(varchar("name", 256)),
+				ofType("creation_time", typeBigInteger),
+				ofType("last_updated_time", typeBigInteger),
+				varchar("description", 5000),
+			},
+			primaryKeyName: "registered_model_pk",
+			primaryKey:     []string{"name"},
+		},
+		{
+			name: "model_versions",
+			columns: []column{
+				notNull(varchar("name", 256)),
+				notNull(ofType("version", typeInteger)),
+				ofType("creation_time", typeBigInteger),
+				ofType("last_updated_time", typeBigInteger),
+				varchar("description", 5000),
+				varchar("user_id", 256),
+				varchar("current_stage", 20),
+				varchar("source", 500),
+				varchar("storage_location", 500),
+				varchar("run_id", 32),
+				varchar("run_link", 500),
+				varchar("status", 20),
+				varchar("status_message", 500),
+			},
+			primaryKeyName: "model_version_pk",
+			primaryKey:     []string{"name", "version"},
+			foreignKeys:    []foreignKey{registeredModelForeignKey()},
+		},
+		{
+			name: "registered_model_tags",
+			columns: []column{
+				notNull(varchar("key", 250)),
+				varchar("value", 5000),
+				notNull(varchar("name", 256)),
+			},
+			primaryKeyName: "registered_model_tag_pk",
+			primaryKey:     []string{"key", "name"},
+			foreignKeys:    []foreignKey{registeredModelForeignKey()},
+		},
+		{
+			name: "model_version_tags",
+			columns: []column{
+				notNull(varchar("key", 250)),
+				varchar("value", 5000),
+				notNull(varchar("name", 256)),
+				notNull(ofType("version", typeInteger)),
+			},
+			primaryKeyName: "model_version_tag_pk",
+			primaryKey:     []string{"key", "name", "version"},
+			foreignKeys: []foreignKey{{
+				columns:    []string{"name", "version"},
+				refTable:   "model_versions",
+				refColumns: []string{"name", "version"},
+				onUpdate:   onUpdateCascade,
+			}},
+		},
+		{
+			name: "registered_model_aliases",
+			columns: []column{
+				notNull(varchar("alias", 256)),
+				notNull(ofType("version", typeInteger)),
+				notNull(varchar("name", 256)),
+			},
+			primaryKeyName: "registered_model_alias_pk",
+			primaryKey:     []string{"name", "alias"},
+			foreignKeys: []foreignKey{{
+				name:       "registered_model_alias_name_fkey",
+				columns:    []string{"name"},
+				refTable:   "registered_models",
+				refColumns: []string{"name"},
+				onUpdate:   onUpdateCascade,
+				onDelete:   onDeleteCascade,
+			}},
+		},
+	}
+}
+
+// createBaselineSchema creates the tables of the tracking and model registry stores,
+// as they are after the MLflow migrations up to baselineRevision.
+func createBaselineSchema(database *gorm.DB) error {
+	return newSchemaWriter(database).createTables(append(trackingTables(), modelRegistryTables()...)...)
+}
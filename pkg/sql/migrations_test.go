@@ -0,0 +1,231 @@
+package sql_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go/pkg/sql"
+)
+
+// The migrations are tested against the empty database of this variable, such as a PostgreSQL, MySQL or SQL Server
+// database in CI, or against a new SQLite database. The schema they create is compared with the one of the database
+// of the conformance suites, created by the MLflow migrations at the baseline revision, when it is set.
+const (
+	testEmptyDatabaseURIEnv = "MLFLOW_GO_TEST_EMPTY_DATABASE_URI"
+	testDatabaseURIEnv      = "MLFLOW_GO_TEST_DATABASE_URI"
+)
+
+func newTestDatabase(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	return newTestDatabaseWithOptions(t, sql.Options{})
+}
+
+func openTestDatabase(t *testing.T, uri string) *gorm.DB {
+	t.Helper()
+
+	database, err := sql.NewDatabase(context.Background(), uri, sql.Options{})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, sql.CloseDatabase(database))
+	})
+
+	return database
+}
+
+func newEmptyTestDatabase(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	if uri := os.Getenv(testEmptyDatabaseURIEnv); uri != "" {
+		return openTestDatabase(t, uri)
+	}
+
+	return newTestDatabase(t)
+}
+
+func TestCreateSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	database := newTestDatabase(t)
+
+	revision, err := sql.CurrentRevision(ctx, database)
+	require.NoError(t, err)
+	assert.Empty(t, revision)
+
+	require.NoError(t, sql.CreateSchema(ctx, database))
+
+	revision, err = sql.CurrentRevision(ctx, database)
+	require.NoError(t, err)
+	assert.Equal(t, sql.HeadRevision(), revision)
+
+	for _, table := range []string{
+		"experiments", "experiment_tags", "runs", "tags", "params", "metrics", "latest_metrics",
+		"datasets", "inputs", "input_tags", "trace_info", "trace_tags", "trace_request_metadata",
+		"registered_models", "model_versions", "registered_model_tags", "model_version_tags",
+		"registered_model_aliases",
+	} {
+		assert.True(t, database.Migrator().HasTable(table), table)
+	}
+
+	// A database with a schema is left as is.
+	require.NoError(t, sql.CreateSchema(ctx, database))
+
+	var count int64
+	require.NoError(t, database.Table("alembic_version").Count(&count).Error)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestCreateSchemaRejectsUnknownRevision(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	database := newTestDatabase(t)
+
+	require.NoError(t, sql.CreateSchema(ctx, database))
+	require.NoError(t, database.Exec("UPDATE alembic_version SET version_num = 'ffffffffffff'").Error)
+	require.NoError(t, database.Exec("DROP TABLE trace_request_metadata").Error)
+
+	err := sql.CreateSchema(ctx, database)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ffffffffffff")
+}
+
+func TestCreateSchemaRejectsUnversionedTables(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	database := newTestDatabase(t)
+
+	require.NoError(t, database.Exec("CREATE TABLE experiments (experiment_id INTEGER PRIMARY KEY)").Error)
+	require.Error(t, sql.CreateSchema(ctx, database))
+}
+
+func testCreatedAutoIncrement(t *testing.T, database *gorm.DB) {
+	t.Helper()
+
+	require.NoError(t, database.Exec(
+		"INSERT INTO experiments (name, lifecycle_stage) VALUES ('first', 'active'), ('second', 'active')",
+	).Error)
+
+	var experimentIDs []int32
+	require.NoError(t, database.Raw(
+		"SELECT experiment_id FROM experiments WHERE name IN ('first', 'second') ORDER BY name",
+	).Scan(&experimentIDs).Error)
+	require.Len(t, experimentIDs, 2)
+	assert.Positive(t, experimentIDs[0])
+	assert.Greater(t, experimentIDs[1], experimentIDs[0])
+}
+
+func testCreatedCheckConstraints(t *testing.T, database *gorm.DB) {
+	t.Helper()
+
+	require.Error(t, database.Exec(
+		"INSERT INTO experiments (name, lifecycle_stage) VALUES ('invalid', 'archived')",
+	).Error)
+
+	var experimentID int32
+	require.NoError(t, database.Raw("SELECT experiment_id FROM experiments WHERE name = 'first'").
+		Scan(&experimentID).Error)
+
+	for _, values := range []string{"'RUNNING', 'archived'", "'PAUSED', 'active'"} {
+		require.Error(t, database.Exec(
+			"INSERT INTO runs (run_uuid, experiment_id, source_type, status, lifecycle_stage) "+
+				"VALUES ('run', ?, 'LOCAL', "+values+")", experimentID,
+		).Error, values)
+	}
+
+	require.Error(t, database.Exec(
+		"INSERT INTO runs (run_uuid, experiment_id, source_type, status, lifecycle_stage) "+
+			"VALUES ('run', ?, 'REMOTE', 'RUNNING', 'active')", experimentID,
+	).Error)
+}
+
+// testCreatedCascades renames a registered model, whose new name is cascaded to its versions, their tags and
+// its aliases, through more than one path, which SQL Server rejects unless the keys are created in order.
+func testCreatedCascades(t *testing.T, database *gorm.DB) {
+	t.Helper()
+
+	require.NoError(t, database.Connection(func(conn *gorm.DB) error {
+		// The statements don't keep the errors of the previous ones.
+		conn = conn.Session(&gorm.Session{})
+
+		// SQLite checks the foreign keys of the connections enabling them, outside of transactions.
+		if conn.Dialector.Name() == "sqlite" {
+			require.NoError(t, conn.Exec("PRAGMA foreign_keys = ON").Error)
+		}
+
+		for _, statement := range []string{
+			"INSERT INTO registered_models (name) VALUES ('model')",
+			"INSERT INTO registered_model_tags (key, value, name) VALUES ('key', 'value', 'model')",
+			"INSERT INTO model_versions (name, version, current_stage) VALUES ('model', 1, 'None')",
+			"INSERT INTO model_version_tags (key, value, name, version) VALUES ('key', 'value', 'model', 1)",
+			"INSERT INTO registered_model_aliases (alias, version, name) VALUES ('champion', 1, 'model')",
+			"UPDATE registered_models SET name = 'renamed' WHERE name = 'model'",
+		} {
+			require.NoError(t, conn.Exec(statement).Error, statement)
+		}
+
+		for _, table := range []string{
+			"registered_model_tags", "model_versions", "model_version_tags", "registered_model_aliases",
+		} {
+			var names []string
+			require.NoError(t, conn.Table(table).Pluck("name", &names).Error)
+			assert.Equal(t, []string{"renamed"}, names, table)
+		}
+
+		// The versions of a model must be deleted before it, its aliases are deleted with it.
+		require.Error(t, conn.Exec("DELETE FROM registered_models WHERE name = 'renamed'").Error)
+
+		for _, statement := range []string{
+			"DELETE FROM model_version_tags WHERE name = 'renamed'",
+			"DELETE FROM model_versions WHERE name = 'renamed'",
+			"DELETE FROM registered_model_tags WHERE name = 'renamed'",
+			"DELETE FROM registered_models WHERE name = 'renamed'",
+		} {
+			require.NoError(t, conn.Exec(statement).Error, statement)
+		}
+
+		var count int64
+		require.NoError(t, conn.Table("registered_model_aliases").Count(&count).Error)
+		assert.Zero(t, count)
+
+		return nil
+	}))
+}
+
+func TestCreatedSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	database := newEmptyTestDatabase(t)
+
+	require.NoError(t, sql.CreateSchema(ctx, database))
+
+	t.Run("AutoIncrement", func(t *testing.T) {
+		testCreatedAutoIncrement(t, database)
+	})
+
+	t.Run("CheckConstraints", func(t *testing.T) {
+		testCreatedCheckConstraints(t, database)
+	})
+
+	t.Run("Cascades", func(t *testing.T) {
+		testCreatedCascades(t, database)
+	})
+
+	t.Run("MatchesMLflowSchema", func(t *testing.T) {
+		uri := os.Getenv(testDatabaseURIEnv)
+		if uri == "" {
+			t.Skipf("%s isn't set", testDatabaseURIEnv)
+		}
+
+		assert.Equal(t, describeSchema(t, openTestDatabase(t, uri)), describeSchema(t, database))
+	})
+}
@@ -0,0 +1,105 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go/pkg/config"
+)
+
+// Options are the options of the connections to a database, their zero values leaving the defaults
+// of database/sql and the statements without timeout nor retry.
+type Options struct {
+	MaxOpenConnections    int
+	MaxIdleConnections    int
+	ConnectionMaxLifetime time.Duration
+	// StatementTimeout bounds the time of each statement, except the ones whose rows are scanned by the caller.
+	StatementTimeout time.Duration
+	// MaxRetries is the number of times a transaction is retried after a serialization failure or a deadlock,
+	// waiting RetryBackoff before the first retry and twice as long before each of the next ones.
+	MaxRetries   int
+	RetryBackoff time.Duration
+}
+
+func NewOptions(cfg *config.Config) Options {
+	return Options{
+		MaxOpenConnections:    cfg.DatabaseMaxOpenConnections,
+		MaxIdleConnections:    cfg.DatabaseMaxIdleConnections,
+		ConnectionMaxLifetime: cfg.DatabaseConnectionMaxLifetime.Duration,
+		StatementTimeout:      cfg.DatabaseStatementTimeout.Duration,
+		MaxRetries:            cfg.DatabaseMaxRetries,
+		RetryBackoff:          cfg.DatabaseRetryBackoff.Duration,
+	}
+}
+
+func configurePool(database *gorm.DB, options Options) error {
+	sqlDB, err := database.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get database instance: %w", err)
+	}
+
+	if options.MaxOpenConnections > 0 {
+		sqlDB.SetMaxOpenConns(options.MaxOpenConnections)
+	}
+
+	if options.MaxIdleConnections > 0 {
+		sqlDB.SetMaxIdleConns(options.MaxIdleConnections)
+	}
+
+	if options.ConnectionMaxLifetime > 0 {
+		sqlDB.SetConnMaxLifetime(options.ConnectionMaxLifetime)
+	}
+
+	return nil
+}
+
+const statementTimeoutKey = "mlflow:statement_timeout"
+
+// statementContext is the context of a statement before its timeout, restored once it's run
+// for the next statements of the same session.
+type statementContext struct {
+	parent context.Context //nolint:containedctx
+	cancel context.CancelFunc
+}
+
+// registerStatementTimeout runs the statements with a context bounded by the timeout.
+// The rows of the Row callbacks being scanned after them, these statements are left without timeout.
+func registerStatementTimeout(database *gorm.DB, timeout time.Duration) error {
+	before := func(tx *gorm.DB) {
+		ctx, cancel := context.WithTimeout(tx.Statement.Context, timeout)
+		tx.InstanceSet(statementTimeoutKey, statementContext{parent: tx.Statement.Context, cancel: cancel})
+		tx.Statement.Context = ctx
+	}
+
+	after := func(tx *gorm.DB) {
+		if value, ok := tx.InstanceGet(statementTimeoutKey); ok {
+			statement, _ := value.(statementContext)
+			statement.cancel()
+			tx.Statement.Context = statement.parent
+		}
+	}
+
+	callbacks := database.Callback()
+
+	for name, registers := range map[string][2]func(string, func(*gorm.DB)) error{
+		"create": {callbacks.Create().Before("gorm:create").Register, callbacks.Create().After("gorm:create").Register},
+		// The preloads are queried after the query, with its context.
+		"query":  {callbacks.Query().Before("gorm:query").Register, callbacks.Query().After("gorm:preload").Register},
+		"update": {callbacks.Update().Before("gorm:update").Register, callbacks.Update().After("gorm:update").Register},
+		"delete": {callbacks.Delete().Before("gorm:delete").Register, callbacks.Delete().After("gorm:delete").Register},
+		"raw":    {callbacks.Raw().Before("gorm:raw").Register, callbacks.Raw().After("gorm:raw").Register},
+	} {
+		if err := registers[0](statementTimeoutKey+"_before", before); err != nil {
+			return fmt.Errorf("failed to register %s statement timeout: %w", name, err)
+		}
+
+		if err := registers[1](statementTimeoutKey+"_after", after); err != nil {
+			return fmt.Errorf("failed to register %s statement timeout: %w", name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package sql_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go/pkg/sql"
+)
+
+func newTestDatabaseWithOptions(t *testing.T, options sql.Options) *gorm.DB {
+	t.Helper()
+
+	database, err := sql.NewDatabase(
+		context.Background(), "sqlite:///"+filepath.ToSlash(filepath.Join(t.TempDir(), "mlflow.db")), options,
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, sql.CloseDatabase(database))
+	})
+
+	return database
+}
+
+func TestStatementTimeout(t *testing.T) {
+	t.Parallel()
+
+	database := newTestDatabaseWithOptions(t, sql.Options{StatementTimeout: 50 * time.Millisecond})
+
+	err := database.Exec(
+		"WITH RECURSIVE c(x) AS (SELECT 1 UNION ALL SELECT x + 1 FROM c LIMIT 10000000000) SELECT count(*) FROM c",
+	).Error
+	require.Error(t, err)
+
+	// The statements after the one which timed out get their own timeout.
+	require.NoError(t, database.Exec("CREATE TABLE t (x INTEGER)").Error)
+}
+
+type timeoutParent struct {
+	ID       int
+	Children []timeoutChild `gorm:"foreignKey:ParentID"`
+}
+
+type timeoutChild struct {
+	ID       int
+	ParentID int
+}
+
+func TestStatementTimeoutOfPreloads(t *testing.T) {
+	t.Parallel()
+
+	database := newTestDatabaseWithOptions(t, sql.Options{StatementTimeout: time.Minute})
+
+	for _, statement := range []string{
+		"CREATE TABLE timeout_parents (id INTEGER PRIMARY KEY)",
+		"CREATE TABLE timeout_children (id INTEGER PRIMARY KEY, parent_id INTEGER)",
+		"INSERT INTO timeout_parents (id) VALUES (1)",
+		"INSERT INTO timeout_children (id, parent_id) VALUES (1, 1), (2, 1)",
+	} {
+		require.NoError(t, database.Exec(statement).Error)
+	}
+
+	deadlines := make(map[string]time.Time)
+
+	require.NoError(t, database.Callback().Query().Before("gorm:query").Register("test:deadline", func(tx *gorm.DB) {
+		deadline, ok := tx.Statement.Context.Deadline()
+		assert.True(t, ok, tx.Statement.Table)
+		require.NoError(t, tx.Statement.Context.Err())
+
+		deadlines[tx.Statement.Table] = deadline
+	}))
+
+	var parents []timeoutParent
+	require.NoError(t, database.Preload("Children").Find(&parents).Error)
+	require.Len(t, parents, 1)
+	assert.Len(t, parents[0].Children, 2)
+
+	// The preloads are part of the statement, they run before its timeout.
+	assert.Equal(t, deadlines["timeout_parents"], deadlines["timeout_children"])
+}
+
+func TestTransactionRetries(t *testing.T) {
+	t.Parallel()
+
+	database := newTestDatabaseWithOptions(t, sql.Options{MaxRetries: 2, RetryBackoff: time.Millisecond})
+
+	attempts := 0
+	require.NoError(t, sql.Transaction(database, func(*gorm.DB) error {
+		attempts++
+		if attempts < 3 {
+			return sqlite3.Error{Code: sqlite3.ErrBusy}
+		}
+
+		return nil
+	}))
+	assert.Equal(t, 3, attempts)
+
+	attempts = 0
+	err := sql.Transaction(database, func(*gorm.DB) error {
+		attempts++
+
+		return sqlite3.Error{Code: sqlite3.ErrBusy}
+	})
+	require.Error(t, err)
+	assert.Equal(t, 3, attempts)
+
+	errNotRetryable := errors.New("not retryable")
+	attempts = 0
+	err = sql.Transaction(database, func(*gorm.DB) error {
+		attempts++
+
+		return errNotRetryable
+	})
+	require.ErrorIs(t, err, errNotRetryable)
+	assert.Equal(t, 1, attempts)
+}
@@ -0,0 +1,171 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"runtime"
+	"sync/atomic"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// WritesContextKey is the context key of the Writes of a request.
+// It is exported so that they can also be set on the request context of Fiber, with Locals.
+type WritesContextKey struct{}
+
+// Writes records whether a request wrote to the primary database, for its next reads not to be sent to
+// a read replica which may not have received the writes yet.
+type Writes struct {
+	done atomic.Bool
+}
+
+func NewContextWithWrites(ctx context.Context) context.Context {
+	return context.WithValue(ctx, WritesContextKey{}, &Writes{})
+}
+
+type primaryContextKey struct{}
+
+// NewContextWithPrimary makes Reader return the primary database, for the reads of the operations which write:
+// what they check or write back must not come from a replica which may be missing the latest writes.
+func NewContextWithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryContextKey{}, true)
+}
+
+// Reader returns the database of the read-only operations: the read replica when there's one and the context
+// has Writes without any, the primary otherwise. Without Writes in the context, such as for the calls outside
+// the requests of the server, the reads which may follow writes in the same context are kept on the primary.
+// The read pool of NewReadPool, seeing the writes once they're committed, is used unless the context comes
+// from NewContextWithPrimary.
+func Reader(ctx context.Context, primary, replica *gorm.DB) *gorm.DB {
+	if forced, _ := ctx.Value(primaryContextKey{}).(bool); replica == nil || forced {
+		return primary.WithContext(ctx)
+	}
+
+	if _, ok := replica.Config.Plugins[readPoolPluginName]; ok {
+		return replica.WithContext(ctx)
+	}
+
+	writes, ok := ctx.Value(WritesContextKey{}).(*Writes)
+	if !ok || writes.done.Load() {
+		return primary.WithContext(ctx)
+	}
+
+	return replica.WithContext(ctx)
+}
+
+const trackWritesKey = "mlflow:track_writes"
+
+// trackWrites records the statements writing to the primary database in the Writes of their context.
+func trackWrites(primary *gorm.DB) error {
+	recordWrite := func(tx *gorm.DB) {
+		if writes, ok := tx.Statement.Context.Value(WritesContextKey{}).(*Writes); ok {
+			writes.done.Store(true)
+		}
+	}
+
+	callbacks := primary.Callback()
+
+	for _, register := range []func(string, func(*gorm.DB)) error{
+		callbacks.Create().Before("gorm:create").Register,
+		callbacks.Update().Before("gorm:update").Register,
+		callbacks.Delete().Before("gorm:delete").Register,
+		callbacks.Raw().Before("gorm:raw").Register,
+	} {
+		if err := register(trackWritesKey, recordWrite); err != nil {
+			return fmt.Errorf("failed to track writes: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// NewReplica connects to the read replica of the primary database, which is opened read-only.
+func NewReplica(ctx context.Context, primary *gorm.DB, replicaURL string, options Options) (*gorm.DB, error) {
+	if err := trackWrites(primary); err != nil {
+		return nil, err
+	}
+
+	replica, err := NewDatabase(ctx, replicaURL, options)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := SetReadOnly(replica, "writes go to the primary database"); err != nil {
+		_ = CloseDatabase(replica)
+
+		return nil, err
+	}
+
+	return replica, nil
+}
+
+const readPoolPluginName = "mlflow:read_pool"
+
+// readPoolPlugin marks the read pool of a SQLite database, for Reader to tell it from a read replica.
+type readPoolPlugin struct{}
+
+func (readPoolPlugin) Name() string {
+	return readPoolPluginName
+}
+
+func (readPoolPlugin) Initialize(*gorm.DB) error {
+	return nil
+}
+
+// NewReadPool opens query-only connections to the SQLite database of the store URL, alongside the single
+// connection of NewDatabase serializing the writes. The database being in WAL mode, they read it while
+// it's written. Their number is the max open connections of the options, or the number of CPUs by default.
+func NewReadPool(ctx context.Context, storeURL string, options Options) (*gorm.DB, error) {
+	uri, err := url.Parse(storeURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse store URL %q: %w", storeURL, err)
+	}
+
+	dsn, err := sqliteDSN(uri, url.Values{
+		"_busy_timeout": {sqliteBusyTimeout},
+		"_cslike":       {"true"},
+		"_query_only":   {"true"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := openDatabase(ctx, sqlite.Open(dsn), options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open read pool of database %q: %w", uri.String(), err)
+	}
+
+	if err := configureReadPool(pool, options); err != nil {
+		_ = CloseDatabase(pool)
+
+		return nil, err
+	}
+
+	return pool, nil
+}
+
+func configureReadPool(pool *gorm.DB, options Options) error {
+	sqlDB, err := pool.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get database instance: %w", err)
+	}
+
+	size := options.MaxOpenConnections
+	if size == 0 {
+		size = runtime.NumCPU()
+		sqlDB.SetMaxOpenConns(size)
+	}
+
+	// Keep the connections open, for the reads not to open new ones and run their pragmas again.
+	if options.MaxIdleConnections == 0 {
+		sqlDB.SetMaxIdleConns(size)
+	}
+
+	if err := pool.Use(readPoolPlugin{}); err != nil {
+		return fmt.Errorf("failed to register read pool plugin: %w", err)
+	}
+
+	return SetReadOnly(pool, "writes go to the single connection of the database")
+}
@@ -0,0 +1,105 @@
+package sql_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go/pkg/sql"
+)
+
+func databaseName(t *testing.T, database *gorm.DB) string {
+	t.Helper()
+
+	var name string
+	require.NoError(t, database.Raw("SELECT name FROM origin").Scan(&name).Error)
+
+	return name
+}
+
+func TestReader(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	primary := newTestDatabase(t)
+	replicaURI := "sqlite:///" + filepath.ToSlash(filepath.Join(t.TempDir(), "replica.db"))
+
+	require.NoError(t, primary.Exec("CREATE TABLE origin (name TEXT)").Error)
+	require.NoError(t, primary.Exec("INSERT INTO origin VALUES ('primary')").Error)
+
+	replicaDatabase, err := sql.NewDatabase(ctx, replicaURI, sql.Options{})
+	require.NoError(t, err)
+	require.NoError(t, replicaDatabase.Exec("CREATE TABLE origin (name TEXT)").Error)
+	require.NoError(t, replicaDatabase.Exec("INSERT INTO origin VALUES ('replica')").Error)
+	require.NoError(t, sql.CloseDatabase(replicaDatabase))
+
+	replica, err := sql.NewReplica(ctx, primary, replicaURI, sql.Options{})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, sql.CloseDatabase(replica))
+	})
+
+	// Without the writes of a request, the reads stay on the primary.
+	assert.Equal(t, "primary", databaseName(t, sql.Reader(ctx, primary, replica)))
+	assert.Equal(t, "primary", databaseName(t, sql.Reader(ctx, primary, nil)))
+
+	requestCtx := sql.NewContextWithWrites(ctx)
+	assert.Equal(t, "replica", databaseName(t, sql.Reader(requestCtx, primary, replica)))
+
+	// The reads of the operations which write stay on the primary.
+	assert.Equal(t, "primary", databaseName(t, sql.Reader(sql.NewContextWithPrimary(requestCtx), primary, replica)))
+
+	require.NoError(t, primary.WithContext(requestCtx).Exec("UPDATE origin SET name = 'primary'").Error)
+	assert.Equal(t, "primary", databaseName(t, sql.Reader(requestCtx, primary, replica)))
+
+	require.Error(t, replica.Exec("DELETE FROM origin").Error)
+}
+
+func TestReadPool(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	storeURI := "sqlite:///" + filepath.ToSlash(filepath.Join(t.TempDir(), "mlflow.db"))
+
+	primary, err := sql.NewDatabase(ctx, storeURI, sql.Options{})
+	require.NoError(t, err)
+
+	pool, err := sql.NewReadPool(ctx, storeURI, sql.Options{})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, sql.CloseDatabase(pool))
+		require.NoError(t, sql.CloseDatabase(primary))
+	})
+
+	var journalMode string
+	require.NoError(t, pool.Raw("PRAGMA journal_mode").Scan(&journalMode).Error)
+	assert.Equal(t, "wal", journalMode)
+
+	require.NoError(t, primary.Exec("CREATE TABLE origin (name TEXT)").Error)
+
+	// The read pool is used even after writes, which it sees once they're committed.
+	requestCtx := sql.NewContextWithWrites(ctx)
+	require.NoError(t, primary.WithContext(requestCtx).Exec("INSERT INTO origin VALUES ('primary')").Error)
+	assert.Equal(t, "primary", databaseName(t, sql.Reader(requestCtx, primary, pool)))
+
+	// The reads don't wait for the transaction holding the single connection of the writes.
+	transaction := primary.Begin()
+	require.NoError(t, transaction.Exec("UPDATE origin SET name = 'transaction'").Error)
+
+	readCtx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+
+	assert.Equal(t, "primary", databaseName(t, sql.Reader(readCtx, primary, pool)))
+	require.NoError(t, transaction.Commit().Error)
+	assert.Equal(t, "transaction", databaseName(t, sql.Reader(ctx, primary, pool)))
+
+	require.Error(t, pool.Exec("DELETE FROM origin").Error)
+	require.Error(t, pool.Raw("DELETE FROM origin RETURNING name").Scan(&journalMode).Error)
+}
@@ -0,0 +1,100 @@
+package sql
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/mattn/go-sqlite3"
+	mssql "github.com/microsoft/go-mssqldb"
+	"gorm.io/gorm"
+)
+
+const retryPluginName = "mlflow:retry"
+
+// retryPlugin holds the retry policy of the transactions of a database, shared by its sessions.
+type retryPlugin struct {
+	maxRetries int
+	backoff    time.Duration
+}
+
+func (retryPlugin) Name() string {
+	return retryPluginName
+}
+
+func (retryPlugin) Initialize(*gorm.DB) error {
+	return nil
+}
+
+const (
+	postgresSerializationFailure = "40001"
+	postgresDeadlockDetected     = "40P01"
+	mysqlLockWaitTimeout         = 1205
+	mysqlDeadlock                = 1213
+	sqlServerDeadlockVictim      = 1205
+)
+
+// isRetryable tells whether a transaction failed on a conflict with a concurrent transaction,
+// and may succeed when it's run again.
+func isRetryable(err error) bool {
+	var postgresError *pgconn.PgError
+	if errors.As(err, &postgresError) {
+		return postgresError.Code == postgresSerializationFailure || postgresError.Code == postgresDeadlockDetected
+	}
+
+	var mysqlError *mysql.MySQLError
+	if errors.As(err, &mysqlError) {
+		return mysqlError.Number == mysqlDeadlock || mysqlError.Number == mysqlLockWaitTimeout
+	}
+
+	var sqlServerError mssql.Error
+	if errors.As(err, &sqlServerError) {
+		return sqlServerError.Number == sqlServerDeadlockVictim
+	}
+
+	var sqliteError sqlite3.Error
+	if errors.As(err, &sqliteError) {
+		return sqliteError.Code == sqlite3.ErrBusy || sqliteError.Code == sqlite3.ErrLocked
+	}
+
+	return false
+}
+
+// delay returns the time to wait before a retry, doubled at each attempt with a jitter
+// for the conflicting transactions not to be retried at the same time.
+func (p retryPlugin) delay(attempt int) time.Duration {
+	backoff := p.backoff << attempt
+
+	return backoff/2 + rand.N(backoff/2+1) //nolint:gosec,mnd
+}
+
+// Transaction runs fn in a transaction, which is retried with a backoff after a serialization failure
+// or a deadlock, up to the number of retries of the options of the database.
+// fn must be safe to run again, the changes of a failed attempt being rolled back.
+func Transaction(database *gorm.DB, fn func(tx *gorm.DB) error) error {
+	plugin, _ := database.Config.Plugins[retryPluginName].(retryPlugin)
+
+	for attempt := 0; ; attempt++ {
+		err := database.Transaction(fn)
+		if err == nil || attempt >= plugin.maxRetries || !isRetryable(err) {
+			return err
+		}
+
+		database.Logger.Warn(
+			database.Statement.Context, "retrying transaction after attempt %d failed: %v", attempt+1, err,
+		)
+
+		timer := time.NewTimer(plugin.delay(attempt))
+
+		select {
+		case <-database.Statement.Context.Done():
+			timer.Stop()
+
+			return fmt.Errorf("transaction not retried: %w", errors.Join(err, database.Statement.Context.Err()))
+		case <-timer.C:
+		}
+	}
+}
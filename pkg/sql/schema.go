@@ -0,0 +1,275 @@
+package sql
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// columnType is the portable type of a column, rendered as the type
+// SQLAlchemy gives to it on each dialect so that the schema matches the one of the MLflow migrations.
+type columnType int
+
+const (
+	typeString columnType = iota
+	typeText
+	// typeMediumText is a text column which is larger than TEXT on MySQL.
+	typeMediumText
+	typeInteger
+	typeBigInteger
+	typeFloat
+	typeBoolean
+	// typeSerial is an auto incremented integer primary key.
+	typeSerial
+)
+
+type column struct {
+	name    string
+	typ     columnType
+	size    int
+	notNull bool
+	// defaultValue is a SQL expression, except for the booleans which are rendered for the dialect.
+	defaultValue string
+}
+
+type foreignKey struct {
+	name       string
+	columns    []string
+	refTable   string
+	refColumns []string
+	onUpdate   string
+	onDelete   string
+}
+
+type check struct {
+	name   string
+	column string
+	values []string
+}
+
+type index struct {
+	name    string
+	columns []string
+}
+
+type table struct {
+	name           string
+	columns        []column
+	primaryKeyName string
+	primaryKey     []string
+	unique         [][]string
+	foreignKeys    []foreignKey
+	checks         []check
+	indexes        []index
+}
+
+func varchar(name string, size int) column {
+	return column{name: name, typ: typeString, size: size}
+}
+
+func notNull(col column) column {
+	col.notNull = true
+
+	return col
+}
+
+func withDefault(col column, value string) column {
+	col.defaultValue = value
+
+	return col
+}
+
+func ofType(name string, typ columnType) column {
+	return column{name: name, typ: typ}
+}
+
+// schemaWriter renders the statements of the tables for the dialect of a database.
+type schemaWriter struct {
+	database *gorm.DB
+	dialect  string
+}
+
+func newSchemaWriter(database *gorm.DB) schemaWriter {
+	return schemaWriter{database: database, dialect: database.Dialector.Name()}
+}
+
+func (w schemaWriter) quote(name string) string {
+	var builder strings.Builder
+
+	w.database.Dialector.QuoteTo(&builder, name)
+
+	return builder.String()
+}
+
+func (w schemaWriter) quoteAll(names []string) string {
+	quoted := make([]string, 0, len(names))
+	for _, name := range names {
+		quoted = append(quoted, w.quote(name))
+	}
+
+	return strings.Join(quoted, ", ")
+}
+
+//nolint:cyclop
+func (w schemaWriter) columnType(col column) string {
+	switch col.typ {
+	case typeString:
+		return fmt.Sprintf("VARCHAR(%d)", col.size)
+	case typeText, typeMediumText:
+		switch {
+		case w.dialect == "sqlserver":
+			return "VARCHAR(max)"
+		case w.dialect == "mysql" && col.typ == typeMediumText:
+			return "MEDIUMTEXT"
+		default:
+			return "TEXT"
+		}
+	case typeInteger:
+		return "INTEGER"
+	case typeBigInteger:
+		return "BIGINT"
+	case typeFloat:
+		if w.dialect == "sqlite" {
+			return "FLOAT"
+		}
+
+		return "FLOAT(53)"
+	case typeBoolean:
+		switch w.dialect {
+		case "sqlserver":
+			return "BIT"
+		case "mysql":
+			return "BOOL"
+		default:
+			return "BOOLEAN"
+		}
+	case typeSerial:
+		if w.dialect == "postgres" {
+			return "SERIAL"
+		}
+
+		return "INTEGER"
+	default:
+		panic(fmt.Sprintf("unknown column type %d", col.typ))
+	}
+}
+
+// identity returns the clause making a serial column auto incremented, which is implied by the
+// SERIAL type of PostgreSQL and by the rowid of the single integer primary key of SQLite.
+func (w schemaWriter) identity(col column) string {
+	if col.typ != typeSerial {
+		return ""
+	}
+
+	switch w.dialect {
+	case "mysql":
+		return " AUTO_INCREMENT"
+	case "sqlserver":
+		return " IDENTITY(1,1)"
+	default:
+		return ""
+	}
+}
+
+func (w schemaWriter) defaultValue(col column) string {
+	if col.typ != typeBoolean || w.dialect == "postgres" || w.dialect == "sqlite" {
+		return col.defaultValue
+	}
+
+	switch col.defaultValue {
+	case "false":
+		return "0"
+	case "true":
+		return "1"
+	default:
+		return col.defaultValue
+	}
+}
+
+func (w schemaWriter) createTable(tbl table) []string {
+	definitions := make([]string, 0, len(tbl.columns)+len(tbl.foreignKeys)+len(tbl.checks)+1)
+
+	for _, col := range tbl.columns {
+		definition := w.quote(col.name) + " " + w.columnType(col)
+
+		if col.notNull || col.typ == typeSerial {
+			definition += " NOT NULL"
+		}
+
+		definition += w.identity(col)
+
+		if col.defaultValue != "" {
+			definition += " DEFAULT " + w.defaultValue(col)
+		}
+
+		definitions = append(definitions, definition)
+	}
+
+	if len(tbl.primaryKey) > 0 {
+		definitions = append(definitions, fmt.Sprintf(
+			"CONSTRAINT %s PRIMARY KEY (%s)", w.quote(tbl.primaryKeyName), w.quoteAll(tbl.primaryKey),
+		))
+	}
+
+	for _, columns := range tbl.unique {
+		definitions = append(definitions, fmt.Sprintf("UNIQUE (%s)", w.quoteAll(columns)))
+	}
+
+	for _, key := range tbl.foreignKeys {
+		definition := fmt.Sprintf(
+			"FOREIGN KEY(%s) REFERENCES %s (%s)", w.quoteAll(key.columns), w.quote(key.refTable), w.quoteAll(key.refColumns),
+		)
+
+		if key.name != "" {
+			definition = fmt.Sprintf("CONSTRAINT %s %s", w.quote(key.name), definition)
+		}
+
+		if key.onDelete != "" {
+			definition += " ON DELETE " + key.onDelete
+		}
+
+		if key.onUpdate != "" {
+			definition += " ON UPDATE " + key.onUpdate
+		}
+
+		definitions = append(definitions, definition)
+	}
+
+	for _, constraint := range tbl.checks {
+		values := make([]string, 0, len(constraint.values))
+		for _, value := range constraint.values {
+			values = append(values, "'"+value+"'")
+		}
+
+		definitions = append(definitions, fmt.Sprintf(
+			"CONSTRAINT %s CHECK (%s IN (%s))",
+			w.quote(constraint.name), w.quote(constraint.column), strings.Join(values, ", "),
+		))
+	}
+
+	statements := []string{
+		fmt.Sprintf("CREATE TABLE %s (\n\t%s\n)", w.quote(tbl.name), strings.Join(definitions, ",\n\t")),
+	}
+
+	for _, idx := range tbl.indexes {
+		statements = append(statements, fmt.Sprintf(
+			"CREATE INDEX %s ON %s (%s)", w.quote(idx.name), w.quote(tbl.name), w.quoteAll(idx.columns),
+		))
+	}
+
+	return statements
+}
+
+// createTables creates tables in the given order, the referenced tables coming first.
+func (w schemaWriter) createTables(tables ...table) error {
+	for _, tbl := range tables {
+		for _, statement := range w.createTable(tbl) {
+			if err := w.database.Exec(statement).Error; err != nil {
+				return fmt.Errorf("failed to create table %q: %w", tbl.name, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,361 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go/pkg/config"
+	"github.com/mlflow/mlflow-go/pkg/utils"
+)
+
+// olderSupportedRevisions are the revisions of the MLflow migrations before the baseline which the models of the
+// stores work with, from the oldest. The baseline revision only deletes the datasets of the experiments on cascade,
+// and the server never deletes experiments. Before f5a4f2784254, the values of the run tags are shorter than the
+// ones SetTag accepts.
+//
+//nolint:gochecknoglobals
+var olderSupportedRevisions = []string{"f5a4f2784254"}
+
+var (
+	errReadOnly           = errors.New("the database is read-only")
+	errIncompatibleSchema = errors.New("incompatible database schema")
+)
+
+// SchemaCheck is the outcome of the comparison of the schema revision of a database
+// with the revisions supported by the server.
+type SchemaCheck struct {
+	Revision    string `json:"revision"`
+	MinRevision string `json:"min_revision"`
+	MaxRevision string `json:"max_revision"`
+	Compatible  bool   `json:"compatible"`
+	// ReadOnly is set on the check of a database with an incompatible schema, opened read-only.
+	ReadOnly bool   `json:"read_only"`
+	Message  string `json:"message,omitempty"`
+}
+
+// SchemaChecker is implemented by the stores which check the schema of their database when they're created.
+type SchemaChecker interface {
+	SchemaCheck() *SchemaCheck
+}
+
+// SupportedRevisions are the schema revisions the server works with, from the oldest to the newest.
+func SupportedRevisions() []string {
+	revisions := slices.Clone(olderSupportedRevisions)
+
+	for _, migration := range migrations {
+		revisions = append(revisions, migration.Revision)
+	}
+
+	return revisions
+}
+
+func isRevision(revision string) func(Migration) bool {
+	return func(migration Migration) bool {
+		return migration.Revision == revision
+	}
+}
+
+// CheckSchema reads the schema revision of the database and compares it with the supported revisions.
+func CheckSchema(ctx context.Context, database *gorm.DB) (*SchemaCheck, error) {
+	revision, err := CurrentRevision(ctx, database)
+	if err != nil {
+		return nil, err
+	}
+
+	supportedRevisions := SupportedRevisions()
+	check := &SchemaCheck{
+		Revision:    revision,
+		MinRevision: supportedRevisions[0],
+		MaxRevision: HeadRevision(),
+		Compatible:  slices.Contains(supportedRevisions, revision),
+	}
+
+	switch {
+	case check.Compatible:
+	case revision == "":
+		check.Message = "the database has no schema revision, " +
+			"it must be created by `mlflow db upgrade` or by the server with the create_database_schema option"
+	default:
+		if err := checkUnknownRevision(database.WithContext(ctx), check); err != nil {
+			return nil, err
+		}
+	}
+
+	return check, nil
+}
+
+// checkUnknownRevision checks the schema of a database at a revision unknown to the server, which is compatible
+// when it only adds to the schema of the head revision, like the newer MLflow migrations adding tables and columns.
+func checkUnknownRevision(database *gorm.DB, check *SchemaCheck) error {
+	mismatch, err := additiveSchemaMismatch(database)
+	if err != nil {
+		return err
+	}
+
+	if mismatch == "" {
+		check.Compatible = true
+		check.Message = fmt.Sprintf(
+			"the schema revision %s is unknown to this server, which supports the revisions %s to %s, "+
+				"but it only adds tables and optional columns to the schema of revision %s",
+			check.Revision, check.MinRevision, check.MaxRevision, check.MaxRevision,
+		)
+
+		return nil
+	}
+
+	check.Message = fmt.Sprintf(
+		"the schema revision %s is unknown to this server, which supports the revisions %s to %s, "+
+			"and %s: the database is either older and must be upgraded with `mlflow db upgrade`, "+
+			"or newer and must be used with a newer server",
+		check.Revision, check.MinRevision, check.MaxRevision, mismatch,
+	)
+
+	return nil
+}
+
+// tableColumn is a column of a table, as listed by the database.
+type tableColumn struct {
+	Name          string
+	IsNullable    string
+	ColumnDefault *string
+	DataType      string
+	// CharacterMaximumLength is the length of the text columns, which is nil or -1 for the ones without a maximum.
+	CharacterMaximumLength *int64
+}
+
+// listTableColumns returns the columns of a table, which are empty when the table doesn't exist.
+func listTableColumns(database *gorm.DB, tableName string) ([]tableColumn, error) {
+	query := `
+		SELECT column_name AS name, is_nullable AS is_nullable, column_default AS column_default,
+			data_type AS data_type, character_maximum_length AS character_maximum_length
+		FROM information_schema.columns
+		WHERE table_schema = %s AND table_name = ?`
+
+	switch database.Dialector.Name() {
+	case "sqlite":
+		query = `
+			SELECT name, CASE "notnull" WHEN 0 THEN 'YES' ELSE 'NO' END AS is_nullable, dflt_value AS column_default,
+				type AS data_type, NULL AS character_maximum_length
+			FROM pragma_table_info(?)`
+	case "mysql":
+		query = fmt.Sprintf(query, "DATABASE()")
+	case "postgres":
+		query = fmt.Sprintf(query, "current_schema()")
+	case "sqlserver":
+		query = fmt.Sprintf(query, "SCHEMA_NAME()")
+	}
+
+	var columns []tableColumn
+	if err := database.Raw(query, tableName).Scan(&columns).Error; err != nil {
+		return nil, fmt.Errorf("failed to list columns of table %s: %w", tableName, err)
+	}
+
+	// SQLite lists the declared types, with their length, such as VARCHAR(250).
+	for index := range columns {
+		dataType, length, _ := strings.Cut(strings.ToLower(columns[index].DataType), "(")
+		columns[index].DataType = strings.TrimSpace(dataType)
+
+		if size, err := strconv.ParseInt(strings.TrimSuffix(length, ")"), 10, 64); err == nil {
+			columns[index].CharacterMaximumLength = &size
+		}
+	}
+
+	return columns, nil
+}
+
+// dataTypes are the families of the data types listed by the databases, whose values the models read alike.
+//
+//nolint:gochecknoglobals
+var dataTypes = map[string]columnType{
+	"char":              typeString,
+	"character":         typeString,
+	"character varying": typeString,
+	"longtext":          typeString,
+	"mediumtext":        typeString,
+	"nchar":             typeString,
+	"ntext":             typeString,
+	"nvarchar":          typeString,
+	"text":              typeString,
+	"varchar":           typeString,
+	"bigint":            typeInteger,
+	"int":               typeInteger,
+	"integer":           typeInteger,
+	"smallint":          typeInteger,
+	"double":            typeFloat,
+	"double precision":  typeFloat,
+	"float":             typeFloat,
+	"real":              typeFloat,
+	"bit":               typeBoolean,
+	"bool":              typeBoolean,
+	"boolean":           typeBoolean,
+	// The booleans of MySQL.
+	"tinyint": typeBoolean,
+}
+
+// typeFamily returns the family of the data types of the columns of a type.
+func typeFamily(typ columnType) columnType {
+	switch typ {
+	case typeText, typeMediumText:
+		return typeString
+	case typeBigInteger, typeSerial:
+		return typeInteger
+	default:
+		return typ
+	}
+}
+
+// The lengths of the text columns: the ones of MySQL have a maximum, unlike the ones of the other databases.
+const (
+	mysqlTextLength       = 65535
+	mysqlMediumTextLength = 16777215
+	unlimitedLength       = math.MaxInt64
+)
+
+// textLength returns the length of the values of a text column, with false for the other columns.
+func (w schemaWriter) textLength(col column) (int64, bool) {
+	switch {
+	case col.typ == typeString:
+		return int64(col.size), true
+	case col.typ == typeText && w.dialect == "mysql":
+		return mysqlTextLength, true
+	case col.typ == typeMediumText && w.dialect == "mysql":
+		return mysqlMediumTextLength, true
+	case col.typ == typeText, col.typ == typeMediumText:
+		return unlimitedLength, true
+	default:
+		return 0, false
+	}
+}
+
+// columnTypeMismatch compares the type of a column of the database with the one of the head revision, the models
+// working with the data types of the same family, and with longer text columns.
+// It returns how the column differs, or an empty string.
+func (w schemaWriter) columnTypeMismatch(col column, listed tableColumn) string {
+	if family, ok := dataTypes[listed.DataType]; !ok || family != typeFamily(col.typ) {
+		return fmt.Sprintf("has the type %s instead of %s", listed.DataType, w.columnType(col))
+	}
+
+	length, ok := w.textLength(col)
+	if !ok {
+		return ""
+	}
+
+	listedLength := int64(unlimitedLength)
+	if listed.CharacterMaximumLength != nil && *listed.CharacterMaximumLength != -1 {
+		listedLength = *listed.CharacterMaximumLength
+	}
+
+	if listedLength < length {
+		return fmt.Sprintf("has the type %s(%d), shorter than %s", listed.DataType, listedLength, w.columnType(col))
+	}
+
+	return ""
+}
+
+// additiveSchemaMismatch compares the tables of the database with the ones of the head revision, the models of the
+// stores working with more tables and with more columns as long as they are nullable or have a default.
+// It returns the first difference the models don't work with, or an empty string.
+func additiveSchemaMismatch(database *gorm.DB) (string, error) {
+	writer := newSchemaWriter(database)
+
+	for _, tbl := range append(trackingTables(), modelRegistryTables()...) {
+		columns, err := listTableColumns(database, tbl.name)
+		if err != nil {
+			return "", err
+		}
+
+		if len(columns) == 0 {
+			return fmt.Sprintf("the table %s is missing", tbl.name), nil
+		}
+
+		added := make(map[string]tableColumn, len(columns))
+		for _, col := range columns {
+			added[col.Name] = col
+		}
+
+		for _, col := range tbl.columns {
+			listed, ok := added[col.name]
+			if !ok {
+				return fmt.Sprintf("the column %s of the table %s is missing", col.name, tbl.name), nil
+			}
+
+			if mismatch := writer.columnTypeMismatch(col, listed); mismatch != "" {
+				return fmt.Sprintf("the column %s of the table %s %s", col.name, tbl.name, mismatch), nil
+			}
+
+			delete(added, col.name)
+		}
+
+		names := make([]string, 0, len(added))
+		for name := range added {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		for _, name := range names {
+			if added[name].IsNullable != "YES" && added[name].ColumnDefault == nil {
+				return fmt.Sprintf("the column %s of the table %s is required and has no default", name, tbl.name), nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// SetReadOnly makes the statements writing to the database fail, leaving the queries as they are.
+func SetReadOnly(database *gorm.DB, reason string) error {
+	rejectWrite := func(tx *gorm.DB) {
+		_ = tx.AddError(fmt.Errorf("%w: %s", errReadOnly, reason))
+	}
+
+	callbacks := database.Callback()
+
+	for _, register := range []func(string, func(*gorm.DB)) error{
+		callbacks.Create().Before("gorm:create").Register,
+		callbacks.Update().Before("gorm:update").Register,
+		callbacks.Delete().Before("gorm:delete").Register,
+		callbacks.Raw().Before("gorm:raw").Register,
+	} {
+		if err := register("mlflow:read_only", rejectWrite); err != nil {
+			return fmt.Errorf("failed to make database read-only: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// EnforceSchema checks the schema of the database of a store being created, which is refused when it's incompatible
+// or opened read-only, depending on the schema_mismatch option.
+func EnforceSchema(ctx context.Context, database *gorm.DB, cfg *config.Config) (*SchemaCheck, error) {
+	check, err := CheckSchema(ctx, database)
+	if err != nil {
+		return nil, err
+	}
+
+	if check.Compatible {
+		return check, nil
+	}
+
+	if cfg.SchemaMismatch != config.SchemaMismatchReadOnly {
+		return nil, fmt.Errorf("%w: %s", errIncompatibleSchema, check.Message)
+	}
+
+	if err := SetReadOnly(database, check.Message); err != nil {
+		return nil, err
+	}
+
+	check.ReadOnly = true
+
+	utils.GetLoggerFromContext(ctx).Warnf("Opening the database read-only: %s", check.Message)
+
+	return check, nil
+}
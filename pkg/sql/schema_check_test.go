@@ -0,0 +1,161 @@
+package sql_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go/pkg/config"
+	"github.com/mlflow/mlflow-go/pkg/sql"
+)
+
+func TestCheckSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	database := newTestDatabase(t)
+
+	check, err := sql.CheckSchema(ctx, database)
+	require.NoError(t, err)
+	assert.False(t, check.Compatible)
+	assert.NotEmpty(t, check.Message)
+
+	require.NoError(t, sql.CreateSchema(ctx, database))
+
+	check, err = sql.CheckSchema(ctx, database)
+	require.NoError(t, err)
+	assert.Equal(t, &sql.SchemaCheck{
+		Revision:    sql.HeadRevision(),
+		MinRevision: sql.SupportedRevisions()[0],
+		MaxRevision: sql.HeadRevision(),
+		Compatible:  true,
+	}, check)
+}
+
+func TestCheckSchemaOfOlderRevision(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	database := newTestDatabase(t)
+
+	require.NoError(t, sql.CreateSchema(ctx, database))
+	require.NoError(t, database.Exec("UPDATE alembic_version SET version_num = 'f5a4f2784254'").Error)
+
+	check, err := sql.CheckSchema(ctx, database)
+	require.NoError(t, err)
+	assert.Equal(t, &sql.SchemaCheck{
+		Revision:    "f5a4f2784254",
+		MinRevision: "f5a4f2784254",
+		MaxRevision: sql.HeadRevision(),
+		Compatible:  true,
+	}, check)
+
+	// The older revisions are left to `mlflow db upgrade`.
+	require.NoError(t, sql.CreateSchema(ctx, database))
+
+	revision, err := sql.CurrentRevision(ctx, database)
+	require.NoError(t, err)
+	assert.Equal(t, "f5a4f2784254", revision)
+}
+
+func TestEnforceSchemaOfUnknownRevision(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	database := newTestDatabase(t)
+
+	require.NoError(t, sql.CreateSchema(ctx, database))
+	require.NoError(t, database.Exec("UPDATE alembic_version SET version_num = 'ffffffffffff'").Error)
+	require.NoError(t, database.Exec("DROP TABLE trace_request_metadata").Error)
+
+	_, err := sql.EnforceSchema(ctx, database, &config.Config{SchemaMismatch: config.SchemaMismatchFail})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ffffffffffff")
+
+	check, err := sql.EnforceSchema(ctx, database, &config.Config{SchemaMismatch: config.SchemaMismatchReadOnly})
+	require.NoError(t, err)
+	assert.False(t, check.Compatible)
+	assert.True(t, check.ReadOnly)
+
+	// The queries keep working, the writes fail.
+	var count int64
+	require.NoError(t, database.Table("experiments").Count(&count).Error)
+	require.Error(t, database.Exec("DELETE FROM experiments").Error)
+	require.Error(t, database.Table("experiments").Where("1 = 1").Delete(nil).Error)
+}
+
+func TestCheckSchemaOfNewerRevision(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	database := newTestDatabase(t)
+
+	// A newer revision of the MLflow migrations adds a table and optional columns.
+	require.NoError(t, sql.CreateSchema(ctx, database))
+
+	for _, statement := range []string{
+		"UPDATE alembic_version SET version_num = 'ffffffffffff'",
+		"CREATE TABLE logged_models (model_id VARCHAR(36) PRIMARY KEY)",
+		"ALTER TABLE inputs ADD COLUMN step BIGINT NOT NULL DEFAULT 0",
+		"ALTER TABLE runs ADD COLUMN description TEXT",
+	} {
+		require.NoError(t, database.Exec(statement).Error, statement)
+	}
+
+	check, err := sql.EnforceSchema(ctx, database, &config.Config{SchemaMismatch: config.SchemaMismatchFail})
+	require.NoError(t, err)
+	assert.True(t, check.Compatible)
+	assert.False(t, check.ReadOnly)
+	assert.Contains(t, check.Message, "ffffffffffff")
+
+	// The migrations leave the schema as it is.
+	require.NoError(t, sql.CreateSchema(ctx, database))
+
+	revision, err := sql.CurrentRevision(ctx, database)
+	require.NoError(t, err)
+	assert.Equal(t, "ffffffffffff", revision)
+
+	// The models can't insert rows without the values of the required columns they don't know about.
+	for _, statement := range []string{
+		"DROP TABLE trace_request_metadata",
+		"CREATE TABLE trace_request_metadata " +
+			"(key VARCHAR(250), value VARCHAR(8000), request_id VARCHAR(50), created_by VARCHAR(256) NOT NULL)",
+	} {
+		require.NoError(t, database.Exec(statement).Error, statement)
+	}
+
+	check, err = sql.CheckSchema(ctx, database)
+	require.NoError(t, err)
+	assert.False(t, check.Compatible)
+	assert.Contains(t, check.Message, "created_by")
+
+	// Nor read the columns they know about, once renamed.
+	require.NoError(t, database.Exec("DROP TABLE trace_request_metadata").Error)
+	require.NoError(t, database.Exec(
+		"CREATE TABLE trace_request_metadata (key VARCHAR(250), value VARCHAR(8000), trace_id VARCHAR(50))",
+	).Error)
+
+	check, err = sql.CheckSchema(ctx, database)
+	require.NoError(t, err)
+	assert.False(t, check.Compatible)
+	assert.Contains(t, check.Message, "request_id")
+
+	// Nor the columns they know about, once their type changed or they got shorter.
+	for _, definition := range []string{
+		"key VARCHAR(250), value INTEGER, request_id VARCHAR(50)",
+		"key VARCHAR(250), value VARCHAR(5000), request_id VARCHAR(50)",
+	} {
+		require.NoError(t, database.Exec("DROP TABLE trace_request_metadata").Error)
+		require.NoError(t, database.Exec("CREATE TABLE trace_request_metadata ("+definition+")").Error)
+
+		check, err = sql.CheckSchema(ctx, database)
+		require.NoError(t, err)
+		assert.False(t, check.Compatible, definition)
+		assert.Contains(t, check.Message, "the column value of the table trace_request_metadata", definition)
+
+		// The migrations don't leave such a database as it is.
+		require.Error(t, sql.CreateSchema(ctx, database), definition)
+	}
+}
@@ -0,0 +1,258 @@
+package sql_test
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// schemaDescription lists the parts of a schema which matter to the stores, rendered without the names
+// the databases generate, so that the schemas created by the Go server and by MLflow can be compared.
+type schemaDescription struct {
+	Columns     map[string][]string
+	Indexes     map[string][]string
+	ForeignKeys []string
+	Checks      []string
+}
+
+// currentSchemas are the expressions of the schema of the tables in the information_schema views.
+//
+//nolint:gochecknoglobals
+var currentSchemas = map[string]string{
+	"mysql":     "DATABASE()",
+	"postgres":  "current_schema()",
+	"sqlserver": "SCHEMA_NAME()",
+}
+
+func queryStrings(t *testing.T, database *gorm.DB, query string, values ...any) [][]string {
+	t.Helper()
+
+	rows, err := database.Raw(query, values...).Rows()
+	require.NoError(t, err)
+
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	require.NoError(t, err)
+
+	var results [][]string
+
+	for rows.Next() {
+		result := make([]string, len(columns))
+		pointers := make([]any, len(columns))
+
+		for index := range result {
+			pointers[index] = &result[index]
+		}
+
+		require.NoError(t, rows.Scan(pointers...))
+
+		results = append(results, result)
+	}
+
+	require.NoError(t, rows.Err())
+
+	return results
+}
+
+// describeColumns reads the columns of SQLite from their table info, as its migrator doesn't parse the quoted names.
+func describeColumns(t *testing.T, database *gorm.DB, table string) []string {
+	t.Helper()
+
+	if database.Dialector.Name() == "sqlite" {
+		columns := make([]string, 0)
+
+		for _, row := range queryStrings(t, database,
+			"SELECT name, upper(type), \"notnull\", pk, coalesce(dflt_value, '') FROM pragma_table_info(?)", table,
+		) {
+			description := row[0] + " " + row[1]
+
+			if row[2] == "1" {
+				description += " NOT NULL"
+			}
+
+			if row[3] != "0" {
+				description += " PRIMARY KEY"
+			}
+
+			if row[4] != "" {
+				description += " DEFAULT " + row[4]
+			}
+
+			columns = append(columns, description)
+		}
+
+		sort.Strings(columns)
+
+		return columns
+	}
+
+	columnTypes, err := database.Migrator().ColumnTypes(table)
+	require.NoError(t, err)
+
+	columns := make([]string, 0, len(columnTypes))
+
+	for _, columnType := range columnTypes {
+		description := columnType.Name() + " " + strings.ToUpper(columnType.DatabaseTypeName())
+
+		if length, ok := columnType.Length(); ok {
+			description += fmt.Sprintf("(%d)", length)
+		}
+
+		if nullable, ok := columnType.Nullable(); ok && !nullable {
+			description += " NOT NULL"
+		}
+
+		if primaryKey, ok := columnType.PrimaryKey(); ok && primaryKey {
+			description += " PRIMARY KEY"
+		}
+
+		if autoIncrement, ok := columnType.AutoIncrement(); ok && autoIncrement {
+			description += " AUTO INCREMENT"
+		}
+
+		if value, ok := columnType.DefaultValue(); ok && value != "" {
+			description += " DEFAULT " + value
+		}
+
+		columns = append(columns, description)
+	}
+
+	sort.Strings(columns)
+
+	return columns
+}
+
+// describeIndexes leaves out the names of the primary keys and unique constraints, which are generated by some
+// databases, such as SQL Server.
+func describeIndexes(t *testing.T, database *gorm.DB, table string) []string {
+	t.Helper()
+
+	tableIndexes, err := database.Migrator().GetIndexes(table)
+	require.NoError(t, err)
+
+	indexes := make([]string, 0, len(tableIndexes))
+
+	for _, index := range tableIndexes {
+		description := index.Name()
+
+		if primaryKey, ok := index.PrimaryKey(); ok && primaryKey {
+			description = "PRIMARY KEY"
+		} else if unique, ok := index.Unique(); ok && unique {
+			description = "UNIQUE"
+		}
+
+		indexes = append(indexes, fmt.Sprintf("%s (%s)", description, strings.Join(index.Columns(), ", ")))
+	}
+
+	sort.Strings(indexes)
+
+	return indexes
+}
+
+// describeForeignKeys leaves out the names of the foreign keys, which are generated for the unnamed ones.
+func describeForeignKeys(t *testing.T, database *gorm.DB, tables []string) []string {
+	t.Helper()
+
+	// The rows are the table, name, column, update rule and delete rule of the columns of the foreign keys.
+	var rows [][]string
+
+	if database.Dialector.Name() == "sqlite" {
+		for _, table := range tables {
+			for _, row := range queryStrings(t, database,
+				`SELECT id, "from", on_update, on_delete FROM pragma_foreign_key_list(?) ORDER BY id, seq`, table,
+			) {
+				rows = append(rows, []string{table, row[0], row[1], row[2], row[3]})
+			}
+		}
+	} else {
+		rows = queryStrings(t, database, fmt.Sprintf(`
+			SELECT kcu.table_name, rc.constraint_name, kcu.column_name, rc.update_rule, rc.delete_rule
+			FROM information_schema.referential_constraints rc
+			JOIN information_schema.key_column_usage kcu
+			ON kcu.constraint_schema = rc.constraint_schema AND kcu.constraint_name = rc.constraint_name
+			WHERE rc.constraint_schema = %s
+			ORDER BY kcu.table_name, rc.constraint_name, kcu.ordinal_position`,
+			currentSchemas[database.Dialector.Name()],
+		))
+	}
+
+	columns := make(map[[2]string][]string)
+	rules := make(map[[2]string]string)
+
+	for _, row := range rows {
+		key := [2]string{row[0], row[1]}
+		columns[key] = append(columns[key], row[2])
+		rules[key] = fmt.Sprintf("ON UPDATE %s ON DELETE %s", row[3], row[4])
+	}
+
+	foreignKeys := make([]string, 0, len(columns))
+	for key, keyColumns := range columns {
+		foreignKeys = append(foreignKeys, fmt.Sprintf("%s (%s) %s", key[0], strings.Join(keyColumns, ", "), rules[key]))
+	}
+
+	sort.Strings(foreignKeys)
+
+	return foreignKeys
+}
+
+// describeChecks returns the check constraints, by name with their clause. SQLite keeps them in the statements
+// creating the tables, whose formatting differs between MLflow and the Go server, so only their number is compared.
+func describeChecks(t *testing.T, database *gorm.DB, tables []string) []string {
+	t.Helper()
+
+	checks := make([]string, 0)
+
+	if database.Dialector.Name() == "sqlite" {
+		for _, table := range tables {
+			for _, row := range queryStrings(t, database,
+				"SELECT sql FROM sqlite_master WHERE type = 'table' AND name = ?", table,
+			) {
+				checks = append(checks, fmt.Sprintf("%s: %d checks", table, strings.Count(strings.ToUpper(row[0]), "CHECK")))
+			}
+		}
+
+		return checks
+	}
+
+	// PostgreSQL lists the NOT NULL columns as checks, which are already part of the columns.
+	for _, row := range queryStrings(t, database, fmt.Sprintf(`
+		SELECT constraint_name, check_clause
+		FROM information_schema.check_constraints
+		WHERE constraint_schema = %s AND constraint_name NOT LIKE '%%_not_null'`,
+		currentSchemas[database.Dialector.Name()],
+	)) {
+		checks = append(checks, row[0]+": "+row[1])
+	}
+
+	sort.Strings(checks)
+
+	return checks
+}
+
+func describeSchema(t *testing.T, database *gorm.DB) schemaDescription {
+	t.Helper()
+
+	tables, err := database.Migrator().GetTables()
+	require.NoError(t, err)
+
+	sort.Strings(tables)
+
+	description := schemaDescription{
+		Columns:     make(map[string][]string, len(tables)),
+		Indexes:     make(map[string][]string, len(tables)),
+		ForeignKeys: describeForeignKeys(t, database, tables),
+		Checks:      describeChecks(t, database, tables),
+	}
+
+	for _, table := range tables {
+		description.Columns[table] = describeColumns(t, database, table)
+		description.Indexes[table] = describeIndexes(t, database, table)
+	}
+
+	return description
+}
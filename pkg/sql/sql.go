@@ -23,6 +23,11 @@ var (
 	errInUseConnections         = errors.New("there are still in use connections")
 )
 
+// Schemes are the schemes of the database URIs supported by NewDatabase.
+func Schemes() []string {
+	return []string{"mssql", "mysql", "postgres", "postgresql", "sqlite"}
+}
+
 //nolint:ireturn
 func getDialector(uri *url.URL) (gorm.Dialector, error) {
 	uri.Scheme, _, _ = strings.Cut(uri.Scheme, "+")
@@ -37,20 +42,14 @@ func getDialector(uri *url.URL) (gorm.Dialector, error) {
 	case "postgres", "postgresql":
 		return postgres.Open(uri.String()), nil
 	case "sqlite":
-		uri.Scheme = ""
-		uri.Path = uri.Path[1:]
-		dsn := uri.String()
-
-		if uri.Path == ":memory:" {
-			return nil, errSqliteMemory
-		}
-
-		if runtime.GOOS == "windows" {
-			if uri.RawQuery != "" {
-				return nil, errSqliteQueryParamsWindows
-			}
-
-			dsn = strings.ReplaceAll(uri.Path, "/", "\\")
+		dsn, err := sqliteDSN(uri, url.Values{
+			"_busy_timeout": {sqliteBusyTimeout},
+			"_cslike":       {"true"},
+			"_journal_mode": {"WAL"},
+			"_txlock":       {"immediate"},
+		})
+		if err != nil {
+			return nil, err
 		}
 
 		return sqlite.Open(dsn), nil
@@ -59,23 +58,54 @@ func getDialector(uri *url.URL) (gorm.Dialector, error) {
 	}
 }
 
-func initSqlite(database *gorm.DB) error {
-	database.Exec("PRAGMA case_sensitive_like = true;")
+// sqliteBusyTimeout is the time in milliseconds a connection to SQLite waits for the lock of another one,
+// such as the one of a Python process logging to the same file, before failing with `database is locked`.
+const sqliteBusyTimeout = "5000"
+
+// sqliteDSN returns the DSN of the SQLite database of the URI, with the parameters of the connections
+// which aren't already set by its query.
+func sqliteDSN(uri *url.URL, params url.Values) (string, error) {
+	uri.Scheme = ""
+	uri.Path = uri.Path[1:]
+
+	if uri.Path == ":memory:" {
+		return "", errSqliteMemory
+	}
+
+	if runtime.GOOS == "windows" {
+		if uri.RawQuery != "" {
+			return "", errSqliteQueryParamsWindows
+		}
+
+		return strings.ReplaceAll(uri.Path, "/", "\\") + "?" + params.Encode(), nil
+	}
+
+	query := uri.Query()
+	for key, values := range params {
+		if !query.Has(key) {
+			query[key] = values
+		}
+	}
 
+	uri.RawQuery = query.Encode()
+
+	return uri.String(), nil
+}
+
+func initSqlite(database *gorm.DB) error {
 	sqlDB, err := database.DB()
 	if err != nil {
 		return fmt.Errorf("failed to get database instance: %w", err)
 	}
-	// set SetMaxOpenConns to be 1 only in case of SQLite to avoid `database is locked`
-	// in case of parallel calls to some endpoints that use `transactions`.
+	// set SetMaxOpenConns to be 1 only in case of SQLite to serialize the writes, which would otherwise fail
+	// with `database is locked` in case of parallel calls to some endpoints that use `transactions`.
+	// The reads don't wait for them, using the connections of the read pool of NewReadPool.
 	sqlDB.SetMaxOpenConns(1)
 
 	return nil
 }
 
-func NewDatabase(ctx context.Context, storeURL string) (*gorm.DB, error) {
-	logger := utils.GetLoggerFromContext(ctx)
-
+func NewDatabase(ctx context.Context, storeURL string, options Options) (*gorm.DB, error) {
 	uri, err := url.Parse(storeURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse store URL %q: %w", storeURL, err)
@@ -86,10 +116,7 @@ func NewDatabase(ctx context.Context, storeURL string) (*gorm.DB, error) {
 		return nil, err
 	}
 
-	database, err := gorm.Open(dialector, &gorm.Config{
-		TranslateError: true,
-		Logger:         NewLoggerAdaptor(logger, LoggerAdaptorConfig{}),
-	})
+	database, err := openDatabase(ctx, dialector, options)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database %q: %w", uri.String(), err)
 	}
@@ -103,6 +130,41 @@ func NewDatabase(ctx context.Context, storeURL string) (*gorm.DB, error) {
 	return database, nil
 }
 
+func openDatabase(ctx context.Context, dialector gorm.Dialector, options Options) (*gorm.DB, error) {
+	database, err := gorm.Open(dialector, &gorm.Config{
+		TranslateError: true,
+		Logger:         NewLoggerAdaptor(utils.GetLoggerFromContext(ctx), LoggerAdaptorConfig{}),
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	if err := configureDatabase(database, options); err != nil {
+		return nil, err
+	}
+
+	return database, nil
+}
+
+// configureDatabase applies the options to the database, before initSqlite which leaves a single connection to SQLite.
+func configureDatabase(database *gorm.DB, options Options) error {
+	if err := configurePool(database, options); err != nil {
+		return err
+	}
+
+	if options.StatementTimeout > 0 {
+		if err := registerStatementTimeout(database, options.StatementTimeout); err != nil {
+			return err
+		}
+	}
+
+	if err := database.Use(retryPlugin{maxRetries: options.MaxRetries, backoff: options.RetryBackoff}); err != nil {
+		return fmt.Errorf("failed to register retry plugin: %w", err)
+	}
+
+	return nil
+}
+
 func CloseDatabase(gormDatabase *gorm.DB) error {
 	database, err := gormDatabase.DB()
 	if err != nil {
@@ -155,7 +155,7 @@ func (ts TrackingService) SearchExperiments(
 		input.GetPageToken(),
 	)
 	if err != nil {
-		return nil, contract.NewError(protos.ErrorCode_INTERNAL_ERROR, fmt.Sprintf("error getting experiments: %v", err))
+		return nil, err
 	}
 
 	response := protos.SearchExperiments_Response{
@@ -7,6 +7,7 @@ import (
 	"github.com/mlflow/mlflow-go/pkg/contract"
 	"github.com/mlflow/mlflow-go/pkg/entities"
 	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/utils"
 )
 
 func (ts TrackingService) LogMetric(
@@ -33,23 +34,14 @@ func (ts TrackingService) LogParam(
 func (ts TrackingService) GetMetricHistory(
 	ctx context.Context, input *protos.GetMetricHistory,
 ) (*protos.GetMetricHistory_Response, *contract.Error) {
-	if input.PageToken != nil {
-		//nolint:lll
-		return nil, contract.NewError(
-			protos.ErrorCode_INVALID_PARAMETER_VALUE,
-			fmt.Sprintf(
-				"The SQLAlchemyStore backend does not support pagination for the `get_metric_history` API. Supplied argument `page_token` '%s' must be `None`.",
-				*input.PageToken,
-			),
-		)
-	}
-
 	runID := input.GetRunId()
 	if input.RunUuid != nil {
 		runID = input.GetRunUuid()
 	}
 
-	metrics, err := ts.Store.GetMetricHistory(ctx, runID, input.GetMetricKey())
+	metrics, nextPageToken, err := ts.Store.GetMetricHistory(
+		ctx, runID, input.GetMetricKey(), input.GetPageToken(), int(input.GetMaxResults()),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -58,6 +50,63 @@ func (ts TrackingService) GetMetricHistory(
 		Metrics: make([]*protos.Metric, len(metrics)),
 	}
 
+	if nextPageToken != "" {
+		response.NextPageToken = &nextPageToken
+	}
+
+	for i, metric := range metrics {
+		response.Metrics[i] = metric.ToProto()
+	}
+
+	return &response, nil
+}
+
+// Default and maximum number of sampled steps per run, see MAX_RESULTS_PER_RUN in mlflow.
+const maxResultsPerRun = 2500
+
+func (ts TrackingService) GetMetricHistoryBulkInterval(
+	ctx context.Context, input *protos.GetMetricHistoryBulkInterval,
+) (*protos.GetMetricHistoryBulkInterval_Response, *contract.Error) {
+	var startStep, endStep *int64
+
+	switch {
+	case input.StartStep != nil && input.EndStep != nil:
+		if input.GetStartStep() > input.GetEndStep() {
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf(
+					"end_step must be greater than start_step. Found start_step=%d and end_step=%d.",
+					input.GetStartStep(),
+					input.GetEndStep(),
+				),
+			)
+		}
+
+		startStep = utils.PtrTo(int64(input.GetStartStep()))
+		endStep = utils.PtrTo(int64(input.GetEndStep()))
+	case input.StartStep != nil || input.EndStep != nil:
+		return nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			"If either start step or end step are specified, both must be specified.",
+		)
+	}
+
+	maxResults := maxResultsPerRun
+	if input.MaxResults != nil {
+		maxResults = int(input.GetMaxResults())
+	}
+
+	metrics, err := ts.Store.GetMetricHistoryBulkInterval(
+		ctx, input.GetRunIds(), input.GetMetricKey(), startStep, endStep, maxResults,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response := protos.GetMetricHistoryBulkInterval_Response{
+		Metrics: make([]*protos.MetricWithRunId, len(metrics)),
+	}
+
 	for i, metric := range metrics {
 		response.Metrics[i] = metric.ToProto()
 	}
@@ -0,0 +1,76 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/tracking/service/query/parser"
+)
+
+// SyntaxError is returned when a filter can't be lexed or parsed.
+// It renders the line of the filter holding the offending token, with a caret under it:
+//
+//	unexpected 'adam', expected one of =, !=, <, <=, >, >=, LIKE, ILIKE, IN, NOT IN, IS
+//	params.optimizer 'adam'
+//	                 ^
+type SyntaxError struct {
+	Filter string
+	// Pos is the byte offset of the offending token in Filter.
+	Pos int
+	Err error
+}
+
+func (e *SyntaxError) Error() string {
+	pos := min(max(e.Pos, 0), len(e.Filter))
+
+	lineStart := strings.LastIndexByte(e.Filter[:pos], '\n') + 1
+
+	lineEnd := len(e.Filter)
+	if index := strings.IndexByte(e.Filter[pos:], '\n'); index >= 0 {
+		lineEnd = pos + index
+	}
+
+	// Keep the tabs of the line so that the caret lines up with the token.
+	var padding strings.Builder
+
+	for _, character := range e.Filter[lineStart:pos] {
+		if character == '\t' {
+			padding.WriteRune('\t')
+		} else {
+			padding.WriteRune(' ')
+		}
+	}
+
+	return fmt.Sprintf("%s\n%s\n%s^", e.Err, e.Filter[lineStart:lineEnd], padding.String())
+}
+
+func (e *SyntaxError) Unwrap() error {
+	return e.Err
+}
+
+// NewFilterError converts the error of parsing a search filter to the error returned by the endpoints.
+// Syntax and validation errors are part of the message, as the inner error isn't sent to the client.
+func NewFilterError(err error) *contract.Error {
+	message := "error parsing search filter"
+
+	var (
+		syntaxError     *SyntaxError
+		validationError *parser.ValidationError
+		contractError   *contract.Error
+	)
+
+	switch {
+	case errors.As(err, &syntaxError):
+		message += ": " + syntaxError.Error()
+	case errors.As(err, &validationError):
+		message += ": " + validationError.Error()
+	// Some validators report the invalid attribute keys with the error of the endpoints.
+	case errors.As(err, &contractError):
+		message += ": " + contractError.Message
+	}
+
+	return contract.NewErrorWith(protos.ErrorCode_INVALID_PARAMETER_VALUE, message, err)
+}
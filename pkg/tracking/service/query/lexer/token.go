@@ -34,20 +34,28 @@ const (
 	Like
 	ILike
 	And
+	Or
+	Is
+	Null
 )
 
 //nolint:gochecknoglobals
 var reservedLu = map[string]TokenKind{
 	"AND":   And,
+	"OR":    Or,
 	"NOT":   Not,
 	"IN":    In,
 	"LIKE":  Like,
 	"ILIKE": ILike,
+	"IS":    Is,
+	"NULL":  Null,
 }
 
 type Token struct {
 	Kind  TokenKind
 	Value string
+	// Pos is the byte offset of the token in the source.
+	Pos int
 }
 
 func (token Token) Debug() string {
@@ -87,6 +95,8 @@ func TokenKindString(kind TokenKind) string {
 		return "greater_equals"
 	case And:
 		return "and"
+	case Or:
+		return "or"
 	case Dot:
 		return "dot"
 	case Comma:
@@ -99,13 +109,17 @@ func TokenKindString(kind TokenKind) string {
 		return "like"
 	case ILike:
 		return "ilike"
+	case Is:
+		return "is"
+	case Null:
+		return "null"
 	default:
 		return fmt.Sprintf("unknown(%d)", kind)
 	}
 }
 
-func newUniqueToken(kind TokenKind, value string) Token {
+func newUniqueToken(kind TokenKind, value string, pos int) Token {
 	return Token{
-		kind, value,
+		kind, value, pos,
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"unicode/utf8"
 )
 
 type regexPattern struct {
@@ -19,12 +20,14 @@ type lexer struct {
 	line     int
 }
 
+// Error is a lexing error at the byte offset Pos of the source.
 type Error struct {
 	message string
+	Pos     int
 }
 
-func NewLexerError(format string, a ...any) *Error {
-	return &Error{message: fmt.Sprintf(format, a...)}
+func NewLexerError(pos int, format string, a ...any) *Error {
+	return &Error{message: fmt.Sprintf(format, a...), Pos: pos}
 }
 
 func (e *Error) Error() string {
@@ -49,11 +52,17 @@ func Tokenize(source *string) ([]Token, error) {
 		}
 
 		if !matched {
-			return lex.Tokens, NewLexerError("unrecognized token near '%v'", lex.remainder())
+			if strings.ContainsAny(lex.remainder()[:1], "\"'`") {
+				return lex.Tokens, NewLexerError(lex.pos, "unterminated string literal")
+			}
+
+			character, _ := utf8.DecodeRuneInString(lex.remainder())
+
+			return lex.Tokens, NewLexerError(lex.pos, "unrecognized character %q", character)
 		}
 	}
 
-	lex.push(newUniqueToken(EOF, "EOF"))
+	lex.push(newUniqueToken(EOF, "EOF", lex.pos))
 
 	return lex.Tokens, nil
 }
@@ -107,8 +116,8 @@ type regexHandler func(lex *lexer, regex *regexp.Regexp)
 // This handler is used with most simple tokens.
 func defaultHandler(kind TokenKind, value string) regexHandler {
 	return func(lex *lexer, _ *regexp.Regexp) {
+		lex.push(newUniqueToken(kind, value, lex.pos))
 		lex.advanceN(len(value))
-		lex.push(newUniqueToken(kind, value))
 	}
 }
 
@@ -116,13 +125,13 @@ func stringHandler(lex *lexer, regex *regexp.Regexp) {
 	match := regex.FindStringIndex(lex.remainder())
 	stringLiteral := lex.remainder()[match[0]:match[1]]
 
-	lex.push(newUniqueToken(String, stringLiteral))
+	lex.push(newUniqueToken(String, stringLiteral, lex.pos))
 	lex.advanceN(len(stringLiteral))
 }
 
 func numberHandler(lex *lexer, regex *regexp.Regexp) {
 	match := regex.FindString(lex.remainder())
-	lex.push(newUniqueToken(Number, match))
+	lex.push(newUniqueToken(Number, match, lex.pos))
 	lex.advanceN(len(match))
 }
 
@@ -131,9 +140,9 @@ func symbolHandler(lex *lexer, regex *regexp.Regexp) {
 	keyword := strings.ToUpper(match)
 
 	if kind, found := reservedLu[keyword]; found {
-		lex.push(newUniqueToken(kind, match))
+		lex.push(newUniqueToken(kind, match, lex.pos))
 	} else {
-		lex.push(newUniqueToken(Identifier, match))
+		lex.push(newUniqueToken(Identifier, match, lex.pos))
 	}
 
 	lex.advanceN(len(match))
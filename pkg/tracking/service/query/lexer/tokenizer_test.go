@@ -1,6 +1,7 @@
 package lexer_test
 
 import (
+	"errors"
 	"strings"
 	"testing"
 
@@ -112,3 +113,42 @@ func TestInvalidInput(t *testing.T) {
 		})
 	}
 }
+
+func TestTokenPositions(t *testing.T) {
+	t.Parallel()
+
+	input := "metrics.acc > 0.9 AND tags.`a b` = 'c'"
+
+	tokens, err := lexer.Tokenize(&input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []int{0, 7, 8, 12, 14, 18, 22, 26, 27, 33, 35, 38}
+	if len(tokens) != len(expected) {
+		t.Fatalf("expected %d tokens, got %d", len(expected), len(tokens))
+	}
+
+	for index, token := range tokens {
+		if token.Pos != expected[index] {
+			t.Errorf("expected %s at %d, got %d", token.Debug(), expected[index], token.Pos)
+		}
+	}
+}
+
+func TestInvalidInputPosition(t *testing.T) {
+	t.Parallel()
+
+	input := "params.acc = 'LR"
+
+	_, err := lexer.Tokenize(&input)
+
+	var lexerError *lexer.Error
+	if !errors.As(err, &lexerError) {
+		t.Fatalf("expected a lexer error, got %v", err)
+	}
+
+	if lexerError.Pos != 13 {
+		t.Errorf("expected the error at 13, got %d", lexerError.Pos)
+	}
+}
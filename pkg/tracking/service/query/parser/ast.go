@@ -55,6 +55,17 @@ func (n StringListExpr) String() string {
 	return strings.Join(items, ", ")
 }
 
+// NULL, the right hand side of IS NULL and IS NOT NULL.
+type NullExpr struct{}
+
+func (n NullExpr) value() interface{} {
+	return nil
+}
+
+func (n NullExpr) String() string {
+	return "NULL"
+}
+
 //-----------------------
 // Identifier Expressions
 // ----------------------
@@ -90,6 +101,8 @@ const (
 	ILike
 	In //nolint:varnamelen
 	NotIn
+	IsNull
+	IsNotNull
 )
 
 //nolint:cyclop
@@ -115,6 +128,10 @@ func (op OperatorKind) String() string {
 		return "IN"
 	case NotIn:
 		return "NOT IN"
+	case IsNull:
+		return "IS NULL"
+	case IsNotNull:
+		return "IS NOT NULL"
 	default:
 		return "UNKNOWN"
 	}
@@ -128,10 +145,64 @@ type CompareExpr struct {
 }
 
 func (expr *CompareExpr) String() string {
+	if expr.Operator == IsNull || expr.Operator == IsNotNull {
+		return fmt.Sprintf("%s %s", expr.Left, expr.Operator)
+	}
+
 	return fmt.Sprintf("%s %s %s", expr.Left, expr.Operator, expr.Right)
 }
 
+func (*CompareExpr) node() {}
+
+// --------------------
+// Boolean Expressions
+// --------------------
+
+// Expr is a node of the boolean expression tree of a filter.
+// The leaves are comparisons, CompareExpr once parsed and ValidCompareExpr once validated.
+type Expr interface {
+	fmt.Stringer
+	node()
+}
+
+func joinExprs(exprs []Expr, separator string) string {
+	items := make([]string, 0, len(exprs))
+	for _, expr := range exprs {
+		items = append(items, expr.String())
+	}
+
+	return "(" + strings.Join(items, separator) + ")"
+}
+
 // AND.
 type AndExpr struct {
-	Exprs []*CompareExpr
+	Exprs []Expr
+}
+
+func (expr *AndExpr) String() string {
+	return joinExprs(expr.Exprs, " AND ")
 }
+
+func (*AndExpr) node() {}
+
+// OR.
+type OrExpr struct {
+	Exprs []Expr
+}
+
+func (expr *OrExpr) String() string {
+	return joinExprs(expr.Exprs, " OR ")
+}
+
+func (*OrExpr) node() {}
+
+// NOT.
+type NotExpr struct {
+	Expr Expr
+}
+
+func (expr *NotExpr) String() string {
+	return fmt.Sprintf("NOT %s", expr.Expr)
+}
+
+func (*NotExpr) node() {}
@@ -3,6 +3,7 @@ package parser
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/mlflow/mlflow-go/pkg/tracking/service/query/lexer"
 )
@@ -27,10 +28,6 @@ func (p *parser) hasTokens() bool {
 	return p.pos < len(p.tokens) && p.currentTokenKind() != lexer.EOF
 }
 
-func (p *parser) printCurrentToken() string {
-	return p.tokens[p.pos].Debug()
-}
-
 func (p *parser) currentToken() lexer.Token {
 	return p.tokens[p.pos]
 }
@@ -42,25 +39,47 @@ func (p *parser) advance() lexer.Token {
 	return tk
 }
 
+// Error is a parsing error at the byte offset Pos of the source,
+// Expected listing the tokens which would have been valid there.
 type Error struct {
-	message string
+	message  string
+	Pos      int
+	Expected []string
 }
 
-func NewParserError(format string, a ...any) *Error {
-	return &Error{message: fmt.Sprintf(format, a...)}
+func NewParserError(pos int, expected []string, format string, a ...any) *Error {
+	return &Error{message: fmt.Sprintf(format, a...), Pos: pos, Expected: expected}
 }
 
 func (e *Error) Error() string {
-	return e.message
+	switch len(e.Expected) {
+	case 0:
+		return e.message
+	case 1:
+		return fmt.Sprintf("%s, expected %s", e.message, e.Expected[0])
+	default:
+		return fmt.Sprintf("%s, expected one of %s", e.message, strings.Join(e.Expected, ", "))
+	}
 }
 
+// unexpected returns the error for the current token, which isn't one of the expected ones.
+func (p *parser) unexpected(expected ...string) *Error {
+	token := p.currentToken()
+
+	found := token.Value
+	if token.Kind == lexer.EOF {
+		found = "end of filter"
+	}
+
+	return NewParserError(token.Pos, expected, "unexpected %s", found)
+}
+
+var expectedOperators = []string{"=", "!=", "<", "<=", ">", ">=", "LIKE", "ILIKE", "IN", "NOT IN", "IS"}
+
 func (p *parser) parseIdentifier() (Identifier, error) {
 	emptyIdentifier := Identifier{Identifier: "", Key: ""}
-	if p.hasTokens() && p.currentTokenKind() != lexer.Identifier {
-		return emptyIdentifier, NewParserError(
-			"expected identifier, got %s",
-			p.printCurrentToken(),
-		)
+	if !p.hasTokens() || p.currentTokenKind() != lexer.Identifier {
+		return emptyIdentifier, p.unexpected("identifier")
 	}
 
 	identToken := p.advance()
@@ -79,10 +98,7 @@ func (p *parser) parseIdentifier() (Identifier, error) {
 
 			return Identifier{Identifier: identToken.Value, Key: column}, nil
 		default:
-			return emptyIdentifier, NewParserError(
-				"expected IDENTIFIER or STRING, got %s",
-				p.printCurrentToken(),
-			)
+			return emptyIdentifier, p.unexpected("key", "quoted key")
 		}
 	} else {
 		return Identifier{Identifier: "", Key: identToken.Value}, nil
@@ -90,27 +106,25 @@ func (p *parser) parseIdentifier() (Identifier, error) {
 }
 
 func (p *parser) parseOperator() (OperatorKind, error) {
-	//nolint:exhaustive
-	switch p.advance().Kind {
-	case lexer.Equals:
-		return Equals, nil
-	case lexer.NotEquals:
-		return NotEquals, nil
-	case lexer.Less:
-		return Less, nil
-	case lexer.LessEquals:
-		return LessEquals, nil
-	case lexer.Greater:
-		return Greater, nil
-	case lexer.GreaterEquals:
-		return GreaterEquals, nil
-	case lexer.Like:
-		return Like, nil
-	case lexer.ILike:
-		return ILike, nil
-	default:
-		return -1, NewParserError("expected operator, got %s", p.printCurrentToken())
+	operators := map[lexer.TokenKind]OperatorKind{
+		lexer.Equals:        Equals,
+		lexer.NotEquals:     NotEquals,
+		lexer.Less:          Less,
+		lexer.LessEquals:    LessEquals,
+		lexer.Greater:       Greater,
+		lexer.GreaterEquals: GreaterEquals,
+		lexer.Like:          Like,
+		lexer.ILike:         ILike,
+	}
+
+	operator, ok := operators[p.currentTokenKind()]
+	if !ok {
+		return -1, p.unexpected(expectedOperators...)
 	}
+
+	p.advance() // Consume the operator
+
+	return operator, nil
 }
 
 //nolint:ireturn
@@ -130,19 +144,13 @@ func (p *parser) parseValue() (Value, error) {
 
 		return StringExpr{Value: value}, nil
 	default:
-		return nil, NewParserError(
-			"Expected NUMBER or STRING, got %s",
-			p.printCurrentToken(),
-		)
+		return nil, p.unexpected("number", "quoted string")
 	}
 }
 
 func (p *parser) parseInSetExpr(ident Identifier) (*CompareExpr, error) {
 	if p.currentTokenKind() != lexer.OpenParen {
-		return nil, NewParserError(
-			"expected '(', got %s",
-			p.printCurrentToken(),
-		)
+		return nil, p.unexpected("(")
 	}
 
 	p.advance() // Consume the OPEN_PAREN
@@ -151,10 +159,7 @@ func (p *parser) parseInSetExpr(ident Identifier) (*CompareExpr, error) {
 
 	for p.hasTokens() && p.currentTokenKind() != lexer.CloseParen {
 		if p.currentTokenKind() != lexer.String {
-			return nil, NewParserError(
-				"expected STRING, got %s",
-				p.printCurrentToken(),
-			)
+			return nil, p.unexpected("quoted string", ")")
 		}
 
 		value := p.advance().Value
@@ -168,10 +173,7 @@ func (p *parser) parseInSetExpr(ident Identifier) (*CompareExpr, error) {
 	}
 
 	if p.currentTokenKind() != lexer.CloseParen {
-		return nil, NewParserError(
-			"expected ')', got %s",
-			p.printCurrentToken(),
-		)
+		return nil, p.unexpected("quoted string", ")")
 	}
 
 	p.advance() // Consume the CLOSE_PAREN
@@ -195,10 +197,7 @@ func (p *parser) parseExpression() (*CompareExpr, error) {
 		p.advance() // Consume the NOT
 
 		if p.currentTokenKind() != lexer.In {
-			return nil, NewParserError(
-				"expected IN after NOT, got %s",
-				p.printCurrentToken(),
-			)
+			return nil, p.unexpected("IN")
 		}
 
 		p.advance() // Consume the IN
@@ -211,6 +210,27 @@ func (p *parser) parseExpression() (*CompareExpr, error) {
 		expr.Operator = NotIn
 
 		return expr, nil
+	case lexer.Is:
+		p.advance() // Consume the IS
+
+		operator := IsNull
+		if p.currentTokenKind() == lexer.Not {
+			p.advance() // Consume the NOT
+
+			operator = IsNotNull
+		}
+
+		if p.currentTokenKind() != lexer.Null {
+			if operator == IsNotNull {
+				return nil, p.unexpected("NULL")
+			}
+
+			return nil, p.unexpected("NULL", "NOT NULL")
+		}
+
+		p.advance() // Consume the NULL
+
+		return &CompareExpr{Left: ident, Operator: operator, Right: NullExpr{}}, nil
 	default:
 		operator, err := p.parseOperator()
 		if err != nil {
@@ -226,38 +246,132 @@ func (p *parser) parseExpression() (*CompareExpr, error) {
 	}
 }
 
-func (p *parser) parse() (*AndExpr, error) {
-	exprs := make([]*CompareExpr, 0)
+// parseOr, parseAnd, parseNot and parsePrimary follow the grammar below,
+// from the lowest to the highest precedence:
+//
+//	or      := and (OR and)*
+//	and     := not (AND not)*
+//	not     := NOT not | primary
+//	primary := '(' or ')' | comparison
+//
+// Nested conjunctions and disjunctions are flattened, a AND (b AND c) is parsed as a AND b AND c.
 
-	leftExpr, err := p.parseExpression()
-	if err != nil {
-		return nil, fmt.Errorf("error while parsing initial expression: %w", err)
+//nolint:ireturn
+func (p *parser) parseOr() (Expr, error) {
+	exprs := make([]Expr, 0)
+
+	for {
+		expr, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		if orExpr, ok := expr.(*OrExpr); ok {
+			exprs = append(exprs, orExpr.Exprs...)
+		} else {
+			exprs = append(exprs, expr)
+		}
+
+		if p.currentTokenKind() != lexer.Or {
+			break
+		}
+
+		p.advance() // Consume the OR
 	}
 
-	exprs = append(exprs, leftExpr)
+	if len(exprs) == 1 {
+		return exprs[0], nil
+	}
 
-	// While there are tokens and the next token is AND
-	for p.currentTokenKind() == lexer.And {
-		p.advance() // Consume the AND
+	return &OrExpr{Exprs: exprs}, nil
+}
+
+//nolint:ireturn
+func (p *parser) parseAnd() (Expr, error) {
+	exprs := make([]Expr, 0)
 
-		rightExpr, err := p.parseExpression()
+	for {
+		expr, err := p.parseNot()
 		if err != nil {
 			return nil, err
 		}
 
-		exprs = append(exprs, rightExpr)
+		if andExpr, ok := expr.(*AndExpr); ok {
+			exprs = append(exprs, andExpr.Exprs...)
+		} else {
+			exprs = append(exprs, expr)
+		}
+
+		if p.currentTokenKind() != lexer.And {
+			break
+		}
+
+		p.advance() // Consume the AND
 	}
 
-	if p.hasTokens() {
-		return nil, NewParserError(
-			"unexpected leftover token(s) after parsing: %s",
-			p.printCurrentToken(),
-		)
+	if len(exprs) == 1 {
+		return exprs[0], nil
 	}
 
 	return &AndExpr{Exprs: exprs}, nil
 }
 
+//nolint:ireturn
+func (p *parser) parseNot() (Expr, error) {
+	if p.currentTokenKind() != lexer.Not {
+		return p.parsePrimary()
+	}
+
+	p.advance() // Consume the NOT
+
+	expr, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+
+	return &NotExpr{Expr: expr}, nil
+}
+
+//nolint:ireturn
+func (p *parser) parsePrimary() (Expr, error) {
+	if p.currentTokenKind() != lexer.OpenParen {
+		return p.parseExpression()
+	}
+
+	p.advance() // Consume the OPEN_PAREN
+
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.currentTokenKind() != lexer.CloseParen {
+		return nil, p.unexpected("AND", "OR", ")")
+	}
+
+	p.advance() // Consume the CLOSE_PAREN
+
+	return expr, nil
+}
+
+func (p *parser) parse() (*AndExpr, error) {
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing expression: %w", err)
+	}
+
+	if p.hasTokens() {
+		return nil, p.unexpected("AND", "OR", "end of filter")
+	}
+
+	// The root is always a conjunction, the common case of search filters.
+	if andExpr, ok := expr.(*AndExpr); ok {
+		return andExpr, nil
+	}
+
+	return &AndExpr{Exprs: []Expr{expr}}, nil
+}
+
 func Parse(tokens []lexer.Token) (*AndExpr, error) {
 	parser := newParser(tokens)
 
@@ -1,6 +1,7 @@
 package parser_test
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
@@ -21,8 +22,8 @@ func TestQueries(t *testing.T) {
 		{
 			input: "metrics.accuracy > 0.72",
 			expected: &parser.AndExpr{
-				Exprs: []*parser.CompareExpr{
-					{
+				Exprs: []parser.Expr{
+					&parser.CompareExpr{
 						Left:     parser.Identifier{"metrics", "accuracy"},
 						Operator: parser.Greater,
 						Right:    parser.NumberExpr{Value: 0.72},
@@ -33,8 +34,8 @@ func TestQueries(t *testing.T) {
 		{
 			input: "metrics.\"accuracy\" > 0.72",
 			expected: &parser.AndExpr{
-				Exprs: []*parser.CompareExpr{
-					{
+				Exprs: []parser.Expr{
+					&parser.CompareExpr{
 						Left:     parser.Identifier{"metrics", "accuracy"},
 						Operator: parser.Greater,
 						Right:    parser.NumberExpr{Value: 0.72},
@@ -45,13 +46,13 @@ func TestQueries(t *testing.T) {
 		{
 			input: "metrics.accuracy > 0.72 AND metrics.loss <= 0.15",
 			expected: &parser.AndExpr{
-				Exprs: []*parser.CompareExpr{
-					{
+				Exprs: []parser.Expr{
+					&parser.CompareExpr{
 						Left:     parser.Identifier{"metrics", "accuracy"},
 						Operator: parser.Greater,
 						Right:    parser.NumberExpr{Value: 0.72},
 					},
-					{
+					&parser.CompareExpr{
 						Left:     parser.Identifier{"metrics", "loss"},
 						Operator: parser.LessEquals,
 						Right:    parser.NumberExpr{Value: 0.15},
@@ -62,8 +63,8 @@ func TestQueries(t *testing.T) {
 		{
 			input: "params.batch_size = \"2\"",
 			expected: &parser.AndExpr{
-				Exprs: []*parser.CompareExpr{
-					{
+				Exprs: []parser.Expr{
+					&parser.CompareExpr{
 						Left:     parser.Identifier{"params", "batch_size"},
 						Operator: parser.Equals,
 						Right:    parser.StringExpr{Value: "2"},
@@ -74,8 +75,8 @@ func TestQueries(t *testing.T) {
 		{
 			input: "tags.task ILIKE \"classif%\"",
 			expected: &parser.AndExpr{
-				Exprs: []*parser.CompareExpr{
-					{
+				Exprs: []parser.Expr{
+					&parser.CompareExpr{
 						Left:     parser.Identifier{"tags", "task"},
 						Operator: parser.ILike,
 						Right:    parser.StringExpr{Value: "classif%"},
@@ -86,8 +87,8 @@ func TestQueries(t *testing.T) {
 		{
 			input: "datasets.digest IN ('s8ds293b', 'jks834s2')",
 			expected: &parser.AndExpr{
-				Exprs: []*parser.CompareExpr{
-					{
+				Exprs: []parser.Expr{
+					&parser.CompareExpr{
 						Left:     parser.Identifier{"datasets", "digest"},
 						Operator: parser.In,
 						Right:    parser.StringListExpr{Values: []string{"s8ds293b", "jks834s2"}},
@@ -98,8 +99,8 @@ func TestQueries(t *testing.T) {
 		{
 			input: "attributes.created > 1664067852747",
 			expected: &parser.AndExpr{
-				[]*parser.CompareExpr{
-					{
+				[]parser.Expr{
+					&parser.CompareExpr{
 						Left:     parser.Identifier{"attributes", "created"},
 						Operator: parser.Greater,
 						Right:    parser.NumberExpr{Value: 1664067852747},
@@ -110,8 +111,8 @@ func TestQueries(t *testing.T) {
 		{
 			input: "params.batch_size != \"None\"",
 			expected: &parser.AndExpr{
-				Exprs: []*parser.CompareExpr{
-					{
+				Exprs: []parser.Expr{
+					&parser.CompareExpr{
 						Left:     parser.Identifier{"params", "batch_size"},
 						Operator: parser.NotEquals,
 						Right:    parser.StringExpr{Value: "None"},
@@ -122,8 +123,8 @@ func TestQueries(t *testing.T) {
 		{
 			input: "datasets.digest NOT IN ('s8ds293b', 'jks834s2')",
 			expected: &parser.AndExpr{
-				Exprs: []*parser.CompareExpr{
-					{
+				Exprs: []parser.Expr{
+					&parser.CompareExpr{
 						Left:     parser.Identifier{"datasets", "digest"},
 						Operator: parser.NotIn,
 						Right:    parser.StringListExpr{Values: []string{"s8ds293b", "jks834s2"}},
@@ -131,6 +132,99 @@ func TestQueries(t *testing.T) {
 				},
 			},
 		},
+		{
+			input: "params.optimizer IS NULL AND tags.task IS NOT NULL",
+			expected: &parser.AndExpr{
+				Exprs: []parser.Expr{
+					&parser.CompareExpr{
+						Left:     parser.Identifier{"params", "optimizer"},
+						Operator: parser.IsNull,
+						Right:    parser.NullExpr{},
+					},
+					&parser.CompareExpr{
+						Left:     parser.Identifier{"tags", "task"},
+						Operator: parser.IsNotNull,
+						Right:    parser.NullExpr{},
+					},
+				},
+			},
+		},
+		{
+			input: "(params.optimizer = 'adam' OR params.optimizer = 'sgd') AND metrics.acc > 0.9",
+			expected: &parser.AndExpr{
+				Exprs: []parser.Expr{
+					&parser.OrExpr{
+						Exprs: []parser.Expr{
+							&parser.CompareExpr{
+								Left:     parser.Identifier{"params", "optimizer"},
+								Operator: parser.Equals,
+								Right:    parser.StringExpr{Value: "adam"},
+							},
+							&parser.CompareExpr{
+								Left:     parser.Identifier{"params", "optimizer"},
+								Operator: parser.Equals,
+								Right:    parser.StringExpr{Value: "sgd"},
+							},
+						},
+					},
+					&parser.CompareExpr{
+						Left:     parser.Identifier{"metrics", "acc"},
+						Operator: parser.Greater,
+						Right:    parser.NumberExpr{Value: 0.9},
+					},
+				},
+			},
+		},
+		{
+			// AND binds tighter than OR, NOT tighter than AND.
+			input: "metrics.a > 1 OR NOT metrics.b > 2 AND metrics.c > 3",
+			expected: &parser.AndExpr{
+				Exprs: []parser.Expr{
+					&parser.OrExpr{
+						Exprs: []parser.Expr{
+							&parser.CompareExpr{
+								Left:     parser.Identifier{"metrics", "a"},
+								Operator: parser.Greater,
+								Right:    parser.NumberExpr{Value: 1},
+							},
+							&parser.AndExpr{
+								Exprs: []parser.Expr{
+									&parser.NotExpr{
+										Expr: &parser.CompareExpr{
+											Left:     parser.Identifier{"metrics", "b"},
+											Operator: parser.Greater,
+											Right:    parser.NumberExpr{Value: 2},
+										},
+									},
+									&parser.CompareExpr{
+										Left:     parser.Identifier{"metrics", "c"},
+										Operator: parser.Greater,
+										Right:    parser.NumberExpr{Value: 3},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			input: "(metrics.a > 1 AND (metrics.b > 2))",
+			expected: &parser.AndExpr{
+				Exprs: []parser.Expr{
+					&parser.CompareExpr{
+						Left:     parser.Identifier{"metrics", "a"},
+						Operator: parser.Greater,
+						Right:    parser.NumberExpr{Value: 1},
+					},
+					&parser.CompareExpr{
+						Left:     parser.Identifier{"metrics", "b"},
+						Operator: parser.Greater,
+						Right:    parser.NumberExpr{Value: 2},
+					},
+				},
+			},
+		},
 	}
 
 	for _, sample := range samples {
@@ -161,6 +255,12 @@ func TestInvalidSyntax(t *testing.T) {
 
 	samples := []string{
 		"attribute.status IS 'RUNNING'",
+		"(metrics.a > 1 OR metrics.b > 2",
+		"metrics.a > 1 AND",
+		"metrics.a > 1 OR ()",
+		"NOT",
+		"params.optimizer IS 'adam'",
+		"params.optimizer IS NOT",
 	}
 
 	for _, sample := range samples {
@@ -180,3 +280,61 @@ func TestInvalidSyntax(t *testing.T) {
 		})
 	}
 }
+
+func TestSyntaxErrorPositions(t *testing.T) {
+	t.Parallel()
+
+	samples := []struct {
+		input    string
+		pos      int
+		expected []string
+	}{
+		{
+			input:    "params.optimizer 'adam'",
+			pos:      17,
+			expected: []string{"=", "!=", "<", "<=", ">", ">=", "LIKE", "ILIKE", "IN", "NOT IN", "IS"},
+		},
+		{
+			input:    "metrics.acc > 0.9 AND",
+			pos:      21,
+			expected: []string{"identifier"},
+		},
+		{
+			input:    "(metrics.a > 1 OR metrics.b > 2",
+			pos:      31,
+			expected: []string{"AND", "OR", ")"},
+		},
+		{
+			input:    "params.x IS NOT 'a'",
+			pos:      16,
+			expected: []string{"NULL"},
+		},
+	}
+
+	for _, sample := range samples {
+		currentSample := sample
+		t.Run(currentSample.input, func(t *testing.T) {
+			t.Parallel()
+
+			tokens, err := lexer.Tokenize(&currentSample.input)
+			if err != nil {
+				t.Fatalf("unexpected lex error: %v", err)
+			}
+
+			_, err = parser.Parse(tokens)
+
+			var parserError *parser.Error
+			if !errors.As(err, &parserError) {
+				t.Fatalf("expected a parser error, got %v", err)
+			}
+
+			if parserError.Pos != currentSample.pos {
+				t.Errorf("expected the error at %d, got %d", currentSample.pos, parserError.Pos)
+			}
+
+			if !reflect.DeepEqual(parserError.Expected, currentSample.expected) {
+				t.Errorf("expected %v, got %v", currentSample.expected, parserError.Expected)
+			}
+		})
+	}
+}
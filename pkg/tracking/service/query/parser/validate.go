@@ -38,6 +38,7 @@ const (
 	Tag
 	Attribute
 	Dataset
+	RequestMetadata
 )
 
 func (v ValidIdentifier) String() string {
@@ -52,6 +53,8 @@ func (v ValidIdentifier) String() string {
 		return "attribute"
 	case Dataset:
 		return "dataset"
+	case RequestMetadata:
+		return "request_metadata"
 	default:
 		return "unknown"
 	}
@@ -65,9 +68,15 @@ type ValidCompareExpr struct {
 }
 
 func (v ValidCompareExpr) String() string {
+	if v.Operator == IsNull || v.Operator == IsNotNull {
+		return fmt.Sprintf("%s.%s %s", v.Identifier, v.Key, v.Operator)
+	}
+
 	return fmt.Sprintf("%s.%s %s %v", v.Identifier, v.Key, v.Operator, v.Value)
 }
 
+func (*ValidCompareExpr) node() {}
+
 type ValidationError struct {
 	message string
 }
@@ -86,6 +95,8 @@ const (
 	tagIdentifier       = "tag"
 	attributeIdentifier = "attribute"
 	datasetIdentifier   = "dataset"
+
+	requestMetadataIdentifier = "request_metadata"
 )
 
 var identifiers = []string{
@@ -300,6 +311,25 @@ func validateAttributeValue(key string, value Value) (interface{}, error) {
 	}
 }
 
+// IS NULL and IS NOT NULL test whether a run has a metric, param or tag with the given key.
+func validateNullExpression(identifier ValidIdentifier, key string, operator OperatorKind) (*ValidCompareExpr, error) {
+	//nolint:exhaustive
+	switch identifier {
+	case Metric, Parameter, Tag:
+		return &ValidCompareExpr{
+			Identifier: identifier,
+			Key:        key,
+			Operator:   operator,
+			Value:      nil,
+		}, nil
+	default:
+		return nil, fmt.Errorf(
+			"Error on parsing filter expression: %w",
+			NewValidationError("%s is only supported for metrics, params and tags, got %s", operator, identifier),
+		)
+	}
+}
+
 // Validate an expression according to the mlflow domain.
 // This represent is a simple type-checker for the expression.
 // Not every identifier is valid according to the mlflow domain.
@@ -315,6 +345,10 @@ func ValidateExpression(expression *CompareExpr) (*ValidCompareExpr, error) {
 		return nil, fmt.Errorf("Error on parsing filter expression: %w", err)
 	}
 
+	if expression.Operator == IsNull || expression.Operator == IsNotNull {
+		return validateNullExpression(validIdentifier, validKey, expression.Operator)
+	}
+
 	value, err := validateValue(validIdentifier, validKey, expression.Right)
 	if err != nil {
 		return nil, fmt.Errorf("Error on parsing filter expression: %w", err)
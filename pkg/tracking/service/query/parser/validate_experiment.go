@@ -0,0 +1,133 @@
+package parser
+
+import (
+	"fmt"
+)
+
+/*
+
+Experiments are searched with the same grammar as runs, but with a different set of identifiers:
+
+attribute: name, string values
+           creation_time and last_update_time, integer values
+tag: any key, string values
+
+*/
+
+const (
+	ExperimentName           = "name"
+	ExperimentCreationTime   = "creation_time"
+	ExperimentLastUpdateTime = "last_update_time"
+)
+
+var searchableExperimentAttributes = []string{
+	ExperimentName,
+	ExperimentCreationTime,
+	ExperimentLastUpdateTime,
+}
+
+func parseValidExperimentIdentifier(identifier string) (ValidIdentifier, error) {
+	switch identifier {
+	case tagIdentifier, "tags":
+		return Tag, nil
+	case "", attributeIdentifier, "attr", "attributes":
+		return Attribute, nil
+	default:
+		return -1, NewValidationError("invalid identifier %q", identifier)
+	}
+}
+
+func validateExperimentStringValue(identifier ValidIdentifier, key string, expression *CompareExpr) error {
+	switch expression.Operator {
+	case Equals, NotEquals, Like, ILike:
+	default:
+		return NewValidationError(
+			"only the =, !=, LIKE and ILIKE comparators are supported for %s.%s, got %s",
+			identifier, key, expression.Operator,
+		)
+	}
+
+	if _, ok := expression.Right.(StringExpr); !ok {
+		return NewValidationError(
+			"expected a quoted string value for %s.%s. Found %s",
+			identifier, key, expression.Right,
+		)
+	}
+
+	return nil
+}
+
+func validateExperimentAttributeValue(key string, expression *CompareExpr) (interface{}, error) {
+	switch key {
+	case ExperimentCreationTime, ExperimentLastUpdateTime:
+		switch expression.Operator {
+		case Equals, NotEquals, Less, LessEquals, Greater, GreaterEquals:
+		default:
+			return nil, NewValidationError("invalid comparator %s for numeric attribute %s", expression.Operator, key)
+		}
+
+		number, ok := expression.Right.(NumberExpr)
+		if !ok || number.Value != float64(int64(number.Value)) {
+			return nil, NewValidationError(
+				"expected an integer value for numeric attribute %s. Found %s",
+				key,
+				expression.Right,
+			)
+		}
+
+		return int64(number.Value), nil
+	default:
+		if err := validateExperimentStringValue(Attribute, key, expression); err != nil {
+			return nil, err
+		}
+
+		return expression.Right.value(), nil
+	}
+}
+
+// ValidateExperimentExpression is the counterpart of ValidateExpression for the search experiments filter.
+// Its errors are ValidationErrors, whose message is sent to the client.
+func ValidateExperimentExpression(expression *CompareExpr) (*ValidCompareExpr, error) {
+	validIdentifier, err := parseValidExperimentIdentifier(expression.Left.Identifier)
+	if err != nil {
+		return nil, fmt.Errorf("Error on parsing filter expression: %w", err)
+	}
+
+	key := expression.Left.Key
+
+	var value interface{}
+
+	if validIdentifier == Tag {
+		if err := validateExperimentStringValue(validIdentifier, key, expression); err != nil {
+			return nil, fmt.Errorf("Error on parsing filter expression: %w", err)
+		}
+
+		value = expression.Right.value()
+	} else {
+		valid := false
+
+		for _, attribute := range searchableExperimentAttributes {
+			valid = valid || attribute == key
+		}
+
+		if !valid {
+			return nil, NewValidationError(
+				"Invalid attribute key '%s' specified for experiments. Valid keys are '%v'",
+				key,
+				searchableExperimentAttributes,
+			)
+		}
+
+		value, err = validateExperimentAttributeValue(key, expression)
+		if err != nil {
+			return nil, fmt.Errorf("Error on parsing filter expression: %w", err)
+		}
+	}
+
+	return &ValidCompareExpr{
+		Identifier: validIdentifier,
+		Key:        key,
+		Operator:   expression.Operator,
+		Value:      value,
+	}, nil
+}
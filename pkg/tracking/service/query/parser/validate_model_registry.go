@@ -0,0 +1,170 @@
+package parser
+
+import (
+	"fmt"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+)
+
+/*
+
+Registered models and model versions are searched with the same grammar as runs,
+but with a different set of identifiers:
+
+attribute: name for registered models,
+           name, run_id, source_path and version_number for model versions
+tag: any key, string values
+
+*/
+
+const (
+	ModelName                       = "name"
+	ModelVersionRunID               = "run_id"
+	ModelVersionSourcePath          = "source_path"
+	ModelVersionNumber              = "version_number"
+	registeredModelsEntity          = "registered models"
+	modelVersionsEntity             = "model versions"
+	modelRegistryComparatorsMessage = "only the =, !=, LIKE and ILIKE comparators are supported for %s, got %s"
+)
+
+var (
+	searchableRegisteredModelAttributes = []string{ModelName}
+	searchableModelVersionAttributes    = []string{
+		ModelName,
+		ModelVersionRunID,
+		ModelVersionSourcePath,
+		ModelVersionNumber,
+	}
+)
+
+func parseValidModelRegistryIdentifier(identifier string) (ValidIdentifier, error) {
+	switch identifier {
+	case tagIdentifier, "tags":
+		return Tag, nil
+	case "", attributeIdentifier, "attr", "attributes":
+		return Attribute, nil
+	default:
+		return -1, NewValidationError("invalid identifier %q", identifier)
+	}
+}
+
+func validateModelRegistryStringValue(identifier ValidIdentifier, key string, expression *CompareExpr) error {
+	switch expression.Operator {
+	case Equals, NotEquals, Like, ILike:
+	default:
+		return NewValidationError(modelRegistryComparatorsMessage, fmt.Sprintf("%s.%s", identifier, key), expression.Operator)
+	}
+
+	if _, ok := expression.Right.(StringExpr); !ok {
+		return NewValidationError(
+			"expected a quoted string value for %s. Found %s",
+			identifier, expression.Right,
+		)
+	}
+
+	return nil
+}
+
+func validateModelVersionAttributeValue(key string, expression *CompareExpr) (interface{}, error) {
+	switch key {
+	case ModelVersionNumber:
+		number, ok := expression.Right.(NumberExpr)
+		if !ok || number.Value != float64(int64(number.Value)) {
+			return nil, NewValidationError(
+				"expected an integer value for %s. Found %s",
+				key,
+				expression.Right,
+			)
+		}
+
+		switch expression.Operator {
+		case Like, ILike, In, NotIn:
+			return nil, NewValidationError("invalid comparator %s for %s", expression.Operator, key)
+		default:
+			return int64(number.Value), nil
+		}
+	case ModelVersionRunID:
+		switch expression.Operator {
+		case Equals, NotEquals:
+			if _, ok := expression.Right.(StringExpr); ok {
+				return expression.Right.value(), nil
+			}
+		case In, NotIn:
+			return expression.Right.value(), nil
+		default:
+		}
+
+		return nil, NewValidationError(
+			"only the =, !=, IN and NOT IN comparators with quoted string values are supported for %s",
+			key,
+		)
+	default:
+		if err := validateModelRegistryStringValue(Attribute, key, expression); err != nil {
+			return nil, err
+		}
+
+		return expression.Right.value(), nil
+	}
+}
+
+func validateModelRegistryExpression(
+	expression *CompareExpr, entity string, attributes []string,
+) (*ValidCompareExpr, error) {
+	validIdentifier, err := parseValidModelRegistryIdentifier(expression.Left.Identifier)
+	if err != nil {
+		return nil, fmt.Errorf("Error on parsing filter expression: %w", err)
+	}
+
+	key := expression.Left.Key
+
+	var value interface{}
+
+	if validIdentifier == Tag {
+		if err := validateModelRegistryStringValue(validIdentifier, key, expression); err != nil {
+			return nil, fmt.Errorf("Error on parsing filter expression: %w", err)
+		}
+
+		value = expression.Right.value()
+	} else {
+		valid := false
+
+		for _, attribute := range attributes {
+			valid = valid || attribute == key
+		}
+
+		if !valid {
+			return nil, contract.NewError(protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf(
+					"Invalid attribute key '%s' specified for %s. Valid keys are '%v'",
+					key,
+					entity,
+					attributes,
+				),
+			)
+		}
+
+		value, err = validateModelVersionAttributeValue(key, expression)
+		if err != nil {
+			return nil, fmt.Errorf("Error on parsing filter expression: %w", err)
+		}
+	}
+
+	return &ValidCompareExpr{
+		Identifier: validIdentifier,
+		Key:        key,
+		Operator:   expression.Operator,
+		Value:      value,
+	}, nil
+}
+
+// ValidateRegisteredModelExpression is the counterpart of ValidateExpression
+// for the search registered models filter.
+func ValidateRegisteredModelExpression(expression *CompareExpr) (*ValidCompareExpr, error) {
+	return validateModelRegistryExpression(expression, registeredModelsEntity, searchableRegisteredModelAttributes)
+}
+
+// ValidateModelVersionExpression is the counterpart of ValidateExpression for the search model versions filter.
+func ValidateModelVersionExpression(expression *CompareExpr) (*ValidCompareExpr, error) {
+	return validateModelRegistryExpression(expression, modelVersionsEntity, searchableModelVersionAttributes)
+}
@@ -0,0 +1,131 @@
+package parser
+
+import (
+	"fmt"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+)
+
+/*
+
+Traces are searched with the same grammar as runs, but with a different set of identifiers:
+
+attribute (or trace): request_id, status, timestamp_ms and execution_time_ms
+tag: any key, string values
+request_metadata: any key, string values
+
+*/
+
+const (
+	TraceRequestID       = "request_id"
+	TraceStatus          = "status"
+	TraceTimestampMS     = "timestamp_ms"
+	TraceExecutionTimeMS = "execution_time_ms"
+)
+
+var searchableTraceAttributes = []string{
+	TraceRequestID,
+	TraceStatus,
+	TraceTimestampMS,
+	TraceExecutionTimeMS,
+}
+
+func parseValidTraceIdentifier(identifier string) (ValidIdentifier, error) {
+	switch identifier {
+	case tagIdentifier, "tags":
+		return Tag, nil
+	case "", attributeIdentifier, "attr", "attributes", "trace":
+		return Attribute, nil
+	case requestMetadataIdentifier, "metadata":
+		return RequestMetadata, nil
+	default:
+		return -1, NewValidationError("invalid identifier %q", identifier)
+	}
+}
+
+func parseTraceAttributeKey(key string) (string, error) {
+	switch key {
+	case TraceRequestID, TraceStatus:
+		return key, nil
+	case TraceTimestampMS, "timestamp":
+		return TraceTimestampMS, nil
+	case TraceExecutionTimeMS, "execution_time":
+		return TraceExecutionTimeMS, nil
+	default:
+		return "", contract.NewError(protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf(
+				"Invalid attribute key '%s' specified for traces. Valid keys are '%v'",
+				key,
+				searchableTraceAttributes,
+			),
+		)
+	}
+}
+
+func validateTraceValue(identifier ValidIdentifier, key string, operator OperatorKind, value Value) (interface{}, error) {
+	if identifier == Attribute && (key == TraceTimestampMS || key == TraceExecutionTimeMS) {
+		if _, ok := value.(NumberExpr); !ok {
+			return nil, NewValidationError(
+				"expected numeric value type for numeric attribute: %s. Found %s",
+				key,
+				value,
+			)
+		}
+
+		return value.value(), nil
+	}
+
+	switch operator {
+	case Less, LessEquals, Greater, GreaterEquals:
+		return nil, NewValidationError(
+			"invalid comparator %s for %s.%s, only numeric attributes support it",
+			operator,
+			identifier,
+			key,
+		)
+	case In, NotIn:
+		if _, ok := value.(StringListExpr); !ok || identifier != Attribute {
+			return nil, NewValidationError(
+				"only trace attributes support comparison with a list of quoted string values",
+			)
+		}
+	default:
+		if _, ok := value.(StringExpr); !ok {
+			return nil, NewValidationError(
+				"expected a quoted string value for %s. Found %s",
+				identifier, value,
+			)
+		}
+	}
+
+	return value.value(), nil
+}
+
+// ValidateTraceExpression is the counterpart of ValidateExpression for the search traces filter.
+func ValidateTraceExpression(expression *CompareExpr) (*ValidCompareExpr, error) {
+	validIdentifier, err := parseValidTraceIdentifier(expression.Left.Identifier)
+	if err != nil {
+		return nil, fmt.Errorf("Error on parsing filter expression: %w", err)
+	}
+
+	validKey := expression.Left.Key
+	if validIdentifier == Attribute {
+		validKey, err = parseTraceAttributeKey(validKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	value, err := validateTraceValue(validIdentifier, validKey, expression.Operator, expression.Right)
+	if err != nil {
+		return nil, fmt.Errorf("Error on parsing filter expression: %w", err)
+	}
+
+	return &ValidCompareExpr{
+		Identifier: validIdentifier,
+		Key:        validKey,
+		Operator:   expression.Operator,
+		Value:      value,
+	}, nil
+}
@@ -1,37 +1,150 @@
 package query
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/mlflow/mlflow-go/pkg/tracking/service/query/lexer"
 	"github.com/mlflow/mlflow-go/pkg/tracking/service/query/parser"
 )
 
-func ParseFilter(input string) ([]*parser.ValidCompareExpr, error) {
+type validator func(expression *parser.CompareExpr) (*parser.ValidCompareExpr, error)
+
+// validateTree returns a copy of the tree with its comparisons validated.
+//
+//nolint:ireturn
+func validateTree(expr parser.Expr, validate validator) (parser.Expr, error) {
+	validateAll := func(exprs []parser.Expr) ([]parser.Expr, error) {
+		validExprs := make([]parser.Expr, 0, len(exprs))
+
+		for _, expr := range exprs {
+			validExpr, err := validateTree(expr, validate)
+			if err != nil {
+				return nil, err
+			}
+
+			validExprs = append(validExprs, validExpr)
+		}
+
+		return validExprs, nil
+	}
+
+	switch expr := expr.(type) {
+	case *parser.AndExpr:
+		exprs, err := validateAll(expr.Exprs)
+		if err != nil {
+			return nil, err
+		}
+
+		return &parser.AndExpr{Exprs: exprs}, nil
+	case *parser.OrExpr:
+		exprs, err := validateAll(expr.Exprs)
+		if err != nil {
+			return nil, err
+		}
+
+		return &parser.OrExpr{Exprs: exprs}, nil
+	case *parser.NotExpr:
+		validExpr, err := validateTree(expr.Expr, validate)
+		if err != nil {
+			return nil, err
+		}
+
+		return &parser.NotExpr{Expr: validExpr}, nil
+	case *parser.CompareExpr:
+		validExpr, err := validate(expr)
+		if err != nil {
+			return nil, err
+		}
+
+		return validExpr, nil
+	default:
+		return nil, parser.NewValidationError("unexpected expression %s", expr)
+	}
+}
+
+func parseAndValidateTree(input string, validate validator) (*parser.AndExpr, error) {
 	if input == "" {
-		return make([]*parser.ValidCompareExpr, 0), nil
+		return &parser.AndExpr{Exprs: make([]parser.Expr, 0)}, nil
 	}
 
 	tokens, err := lexer.Tokenize(&input)
 	if err != nil {
+		var lexerError *lexer.Error
+		if errors.As(err, &lexerError) {
+			return nil, &SyntaxError{Filter: input, Pos: lexerError.Pos, Err: lexerError}
+		}
+
 		return nil, fmt.Errorf("error while lexing %s: %w", input, err)
 	}
 
 	ast, err := parser.Parse(tokens)
 	if err != nil {
+		var parserError *parser.Error
+		if errors.As(err, &parserError) {
+			return nil, &SyntaxError{Filter: input, Pos: parserError.Pos, Err: parserError}
+		}
+
 		return nil, fmt.Errorf("error while parsing %s: %w", input, err)
 	}
 
+	validAST, err := validateTree(ast, validate)
+	if err != nil {
+		return nil, fmt.Errorf("error while validating %s: %w", input, err)
+	}
+
+	//nolint:forcetypeassert
+	return validAST.(*parser.AndExpr), nil
+}
+
+// parseAndValidate is used by the filters which only support conjunctions of comparisons.
+func parseAndValidate(input string, validate validator) ([]*parser.ValidCompareExpr, error) {
+	ast, err := parseAndValidateTree(input, validate)
+	if err != nil {
+		return nil, err
+	}
+
 	validExpressions := make([]*parser.ValidCompareExpr, 0, len(ast.Exprs))
 
 	for _, expr := range ast.Exprs {
-		ve, err := parser.ValidateExpression(expr)
-		if err != nil {
-			return nil, fmt.Errorf("error while validating %s: %w", input, err)
+		validExpression, ok := expr.(*parser.ValidCompareExpr)
+		if !ok {
+			return nil, fmt.Errorf(
+				"error while validating %s: %w",
+				input,
+				parser.NewValidationError("OR, NOT and parentheses are not supported in this filter"),
+			)
 		}
 
-		validExpressions = append(validExpressions, ve)
+		validExpressions = append(validExpressions, validExpression)
 	}
 
 	return validExpressions, nil
 }
+
+// ParseFilter parses the filter of a SearchRuns request,
+// which may combine comparisons with AND, OR, NOT and parentheses.
+func ParseFilter(input string) (*parser.AndExpr, error) {
+	return parseAndValidateTree(input, parser.ValidateExpression)
+}
+
+// ParseTraceFilter parses the filter of a SearchTraces request.
+func ParseTraceFilter(input string) ([]*parser.ValidCompareExpr, error) {
+	return parseAndValidate(input, parser.ValidateTraceExpression)
+}
+
+// ParseRegisteredModelFilter parses the filter of a SearchRegisteredModels request.
+func ParseRegisteredModelFilter(input string) ([]*parser.ValidCompareExpr, error) {
+	return parseAndValidate(input, parser.ValidateRegisteredModelExpression)
+}
+
+// ParseModelVersionFilter parses the filter of a SearchModelVersions request.
+func ParseModelVersionFilter(input string) ([]*parser.ValidCompareExpr, error) {
+	return parseAndValidate(input, parser.ValidateModelVersionExpression)
+}
+
+// ParseExperimentFilter parses the filter of a SearchExperiments request,
+// which supports AND, OR, NOT and parentheses like the runs filter.
+func ParseExperimentFilter(input string) (*parser.AndExpr, error) {
+	return parseAndValidateTree(input, parser.ValidateExperimentExpression)
+}
@@ -1,10 +1,14 @@
 package query_test
 
 import (
+	"errors"
 	"strings"
 	"testing"
 
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/protos"
 	"github.com/mlflow/mlflow-go/pkg/tracking/service/query"
+	"github.com/mlflow/mlflow-go/pkg/tracking/service/query/parser"
 )
 
 func TestValidQueries(t *testing.T) {
@@ -21,6 +25,10 @@ func TestValidQueries(t *testing.T) {
 		"params.solver LIKE \"l%\"",
 		"datasets.digest IN ('77a19fc0')",
 		"attributes.run_id IN ('meh')",
+		"(params.optimizer = 'adam' OR params.optimizer = 'sgd') AND metrics.acc > 0.9",
+		"NOT (tags.task ILIKE 'classif%' OR attributes.status = 'FAILED')",
+		"params.optimizer IS NULL",
+		"tags.task is not null AND metrics.acc IS NULL",
 	}
 
 	for _, sample := range samples {
@@ -88,6 +96,14 @@ func TestInvalidQueries(t *testing.T) {
 			input:         "datasets.context = 60",
 			expectedError: "expected datasets.context to be either a string or list of strings",
 		},
+		{
+			input:         "attributes.end_time IS NULL",
+			expectedError: "IS NULL is only supported for metrics, params and tags",
+		},
+		{
+			input:         "datasets.name IS NOT NULL",
+			expectedError: "IS NOT NULL is only supported for metrics, params and tags",
+		},
 	}
 
 	for _, sample := range samples {
@@ -110,3 +126,300 @@ func TestInvalidQueries(t *testing.T) {
 		})
 	}
 }
+
+func TestValidTraceQueries(t *testing.T) {
+	t.Parallel()
+
+	samples := []string{
+		"status = 'OK'",
+		"trace.status = 'OK' AND trace.timestamp_ms > 1711089570679",
+		"attributes.execution_time < 100",
+		"attributes.request_id IN ('tr-1', 'tr-2')",
+		"tags.`mlflow.traceName` = 'predict'",
+		"tags.foo ILIKE 'b%'",
+		"request_metadata.`mlflow.sourceRun` = 'abc'",
+		"metadata.key LIKE 'val%'",
+	}
+
+	for _, sample := range samples {
+		currentSample := sample
+		t.Run(currentSample, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := query.ParseTraceFilter(currentSample)
+			if err != nil {
+				t.Errorf("unexpected parse error: %v", err)
+			}
+		})
+	}
+}
+
+func TestInvalidTraceQueries(t *testing.T) {
+	t.Parallel()
+
+	samples := []invalidSample{
+		{
+			input:         "metrics.foo = 1",
+			expectedError: "invalid identifier",
+		},
+		{
+			input:         "attributes.run_id = 'abc'",
+			expectedError: "Invalid attribute key 'run_id' specified for traces",
+		},
+		{
+			input:         "trace.timestamp_ms = 'now'",
+			expectedError: "expected numeric value type for numeric attribute",
+		},
+		{
+			input:         "tags.foo > 'bar'",
+			expectedError: "only numeric attributes support it",
+		},
+		{
+			input:         "tags.foo IN ('bar')",
+			expectedError: "only trace attributes support comparison with a list",
+		},
+		{
+			input:         "status = 'OK' OR status = 'ERROR'",
+			expectedError: "OR, NOT and parentheses are not supported in this filter",
+		},
+		{
+			input:         "request_metadata.foo = 1",
+			expectedError: "expected a quoted string value",
+		},
+	}
+
+	for _, sample := range samples {
+		currentSample := sample
+		t.Run(currentSample.input, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := query.ParseTraceFilter(currentSample.input)
+			if err == nil {
+				t.Fatalf("expected parse error but got nil")
+			}
+
+			if !strings.Contains(err.Error(), currentSample.expectedError) {
+				t.Errorf(
+					"expected error to contain %q, got %q",
+					currentSample.expectedError,
+					err.Error(),
+				)
+			}
+		})
+	}
+}
+
+func TestValidModelRegistryQueries(t *testing.T) {
+	t.Parallel()
+
+	registeredModelSamples := []string{
+		"name = 'model'",
+		"name ILIKE '%Model%'",
+		"attributes.name != 'model'",
+		"tags.team LIKE 'ml%'",
+	}
+
+	for _, sample := range registeredModelSamples {
+		currentSample := sample
+		t.Run(currentSample, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := query.ParseRegisteredModelFilter(currentSample)
+			if err != nil {
+				t.Errorf("unexpected parse error: %v", err)
+			}
+		})
+	}
+
+	modelVersionSamples := []string{
+		"name = 'model' AND version_number > 1",
+		"run_id IN ('a', 'b')",
+		"run_id NOT IN ('a')",
+		"source_path LIKE 's3://bucket/%'",
+		"tags.`mlflow.source` = 'notebook'",
+	}
+
+	for _, sample := range modelVersionSamples {
+		currentSample := sample
+		t.Run(currentSample, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := query.ParseModelVersionFilter(currentSample)
+			if err != nil {
+				t.Errorf("unexpected parse error: %v", err)
+			}
+		})
+	}
+}
+
+func TestInvalidModelVersionQueries(t *testing.T) {
+	t.Parallel()
+
+	samples := []invalidSample{
+		{
+			input:         "metrics.foo = 1",
+			expectedError: "invalid identifier",
+		},
+		{
+			input:         "stage = 'Production'",
+			expectedError: "Invalid attribute key 'stage' specified for model versions",
+		},
+		{
+			input:         "version_number = '1'",
+			expectedError: "expected an integer value for version_number",
+		},
+		{
+			input:         "run_id LIKE 'a%'",
+			expectedError: "only the =, !=, IN and NOT IN comparators",
+		},
+		{
+			input:         "name IN ('a')",
+			expectedError: "only the =, !=, LIKE and ILIKE comparators",
+		},
+		{
+			input:         "tags.foo = 1",
+			expectedError: "expected a quoted string value",
+		},
+	}
+
+	for _, sample := range samples {
+		currentSample := sample
+		t.Run(currentSample.input, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := query.ParseModelVersionFilter(currentSample.input)
+			if err == nil {
+				t.Fatalf("expected parse error but got nil")
+			}
+
+			if !strings.Contains(err.Error(), currentSample.expectedError) {
+				t.Errorf(
+					"expected error to contain %q, got %q",
+					currentSample.expectedError,
+					err.Error(),
+				)
+			}
+		})
+	}
+}
+
+func TestValidExperimentQueries(t *testing.T) {
+	t.Parallel()
+
+	samples := []string{
+		"name = 'Default'",
+		"attributes.name ILIKE '%test%' AND creation_time >= 1711089570679",
+		"tags.`mlflow.note.content` LIKE 'prod%'",
+		"(tags.team = 'ml' OR tags.team = 'data') AND NOT last_update_time < 100",
+	}
+
+	for _, sample := range samples {
+		currentSample := sample
+		t.Run(currentSample, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := query.ParseExperimentFilter(currentSample)
+			if err != nil {
+				t.Errorf("unexpected parse error: %v", err)
+			}
+		})
+	}
+}
+
+func TestInvalidExperimentQueries(t *testing.T) {
+	t.Parallel()
+
+	samples := []invalidSample{
+		{
+			input:         "params.foo = 'bar'",
+			expectedError: "invalid identifier",
+		},
+		{
+			input:         "experiment_id = '1'",
+			expectedError: "Invalid attribute key 'experiment_id' specified for experiments",
+		},
+		{
+			input:         "creation_time = 'now'",
+			expectedError: "expected an integer value for numeric attribute creation_time",
+		},
+		{
+			input:         "last_update_time LIKE '1%'",
+			expectedError: "invalid comparator LIKE for numeric attribute last_update_time",
+		},
+		{
+			input:         "name > 'a'",
+			expectedError: "only the =, !=, LIKE and ILIKE comparators are supported for attribute.name",
+		},
+		{
+			input:         "tags.foo = 1",
+			expectedError: "expected a quoted string value for tag.foo",
+		},
+	}
+
+	for _, sample := range samples {
+		currentSample := sample
+		t.Run(currentSample.input, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := query.ParseExperimentFilter(currentSample.input)
+			if err == nil {
+				t.Fatalf("expected parse error but got nil")
+			}
+
+			if !strings.Contains(err.Error(), currentSample.expectedError) {
+				t.Errorf(
+					"expected error to contain %q, got %q",
+					currentSample.expectedError,
+					err.Error(),
+				)
+			}
+
+			// The messages of validation errors are sent to the client.
+			var validationError *parser.ValidationError
+			if !errors.As(err, &validationError) {
+				t.Errorf("expected a validation error, got %T", err)
+			}
+		})
+	}
+}
+
+func TestSyntaxErrorMessages(t *testing.T) {
+	t.Parallel()
+
+	samples := []invalidSample{
+		{
+			input: "params.optimizer 'adam'",
+			expectedError: "error parsing search filter: " +
+				"unexpected 'adam', expected one of =, !=, <, <=, >, >=, LIKE, ILIKE, IN, NOT IN, IS\n" +
+				"params.optimizer 'adam'\n" +
+				"                 ^",
+		},
+		{
+			input: "metrics.a > 1\n\tAND params.b ~ 'x'",
+			expectedError: "error parsing search filter: unrecognized character '~'\n" +
+				"\tAND params.b ~ 'x'\n" +
+				"\t             ^",
+		},
+	}
+
+	for _, sample := range samples {
+		currentSample := sample
+		t.Run(currentSample.input, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := query.ParseFilter(currentSample.input)
+			if err == nil {
+				t.Fatalf("expected parse error but got nil")
+			}
+
+			contractError := query.NewFilterError(err)
+			if contractError.Code != contract.ErrorCode(protos.ErrorCode_INVALID_PARAMETER_VALUE) {
+				t.Errorf("expected INVALID_PARAMETER_VALUE, got %s", contractError.Code)
+			}
+
+			if contractError.Message != currentSample.expectedError {
+				t.Errorf("expected message %q, got %q", currentSample.expectedError, contractError.Message)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/mlflow/mlflow-go/pkg/contract"
 	"github.com/mlflow/mlflow-go/pkg/entities"
 	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/sql"
 	"github.com/mlflow/mlflow-go/pkg/tracking/store/sql/models"
 )
 
@@ -32,7 +33,7 @@ func (ts TrackingService) SearchRuns(
 		input.GetPageToken(),
 	)
 	if err != nil {
-		return nil, contract.NewError(protos.ErrorCode_INTERNAL_ERROR, fmt.Sprintf("error getting runs: %v", err))
+		return nil, err
 	}
 
 	response := protos.SearchRuns_Response{
@@ -110,7 +111,8 @@ func (ts TrackingService) CreateRun(
 func (ts TrackingService) UpdateRun(
 	ctx context.Context, input *protos.UpdateRun,
 ) (*protos.UpdateRun_Response, *contract.Error) {
-	run, err := ts.Store.GetRun(ctx, input.GetRunId())
+	// The run is read from the primary database, as its status and name are written back.
+	run, err := ts.Store.GetRun(sql.NewContextWithPrimary(ctx), input.GetRunId())
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,81 @@
+package service_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go/pkg/config"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/sql"
+	"github.com/mlflow/mlflow-go/pkg/tracking/service"
+	"github.com/mlflow/mlflow-go/pkg/utils"
+)
+
+func newSQLiteURI(t *testing.T, name string) string {
+	t.Helper()
+
+	return "sqlite:///" + filepath.ToSlash(filepath.Join(t.TempDir(), name))
+}
+
+// The runs being updated are read from the primary database, not from a read replica missing them.
+func TestUpdateRunWithReadReplica(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	replicaURI := newSQLiteURI(t, "replica.db")
+
+	replicaService, err := service.NewTrackingService(ctx, &config.Config{
+		TrackingStoreURI:     replicaURI,
+		DefaultArtifactRoot:  t.TempDir(),
+		CreateDatabaseSchema: true,
+	})
+	require.NoError(t, err)
+	require.NoError(t, replicaService.Destroy())
+
+	trackingService, err := service.NewTrackingService(ctx, &config.Config{
+		TrackingStoreURI:            newSQLiteURI(t, "mlflow.db"),
+		TrackingStoreReadReplicaURI: replicaURI,
+		DefaultArtifactRoot:         t.TempDir(),
+		CreateDatabaseSchema:        true,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, trackingService.Destroy())
+	})
+
+	created, contractError := trackingService.CreateRun(sql.NewContextWithWrites(ctx), &protos.CreateRun{
+		ExperimentId: utils.PtrTo("0"),
+		RunName:      utils.PtrTo("run"),
+	})
+	require.Nil(t, contractError)
+
+	runID := created.GetRun().GetInfo().GetRunId()
+
+	// The reads of the next requests go to the replica.
+	_, contractError = trackingService.GetRun(sql.NewContextWithWrites(ctx), &protos.GetRun{RunId: &runID})
+	require.NotNil(t, contractError)
+	require.Equal(t, protos.ErrorCode_RESOURCE_DOES_NOT_EXIST, protos.ErrorCode(contractError.Code))
+
+	updated, contractError := trackingService.UpdateRun(sql.NewContextWithWrites(ctx), &protos.UpdateRun{
+		RunId:  &runID,
+		Status: protos.RunStatus_FINISHED.Enum(),
+	})
+	require.Nil(t, contractError)
+	require.Equal(t, "run", updated.GetRunInfo().GetRunName())
+	require.Equal(t, protos.RunStatus_FINISHED, updated.GetRunInfo().GetStatus())
+
+	_, contractError = trackingService.DeleteRun(sql.NewContextWithWrites(ctx), &protos.DeleteRun{RunId: &runID})
+	require.Nil(t, contractError)
+
+	_, contractError = trackingService.RestoreRun(sql.NewContextWithWrites(ctx), &protos.RestoreRun{RunId: &runID})
+	require.Nil(t, contractError)
+
+	run, contractError := trackingService.GetRun(ctx, &protos.GetRun{RunId: &runID})
+	require.Nil(t, contractError)
+	require.Equal(t, protos.RunStatus_FINISHED, run.GetRun().GetInfo().GetStatus())
+	require.Equal(t, "active", run.GetRun().GetInfo().GetLifecycleStage())
+}
@@ -6,7 +6,11 @@ import (
 
 	"github.com/mlflow/mlflow-go/pkg/config"
 	"github.com/mlflow/mlflow-go/pkg/tracking/store"
-	"github.com/mlflow/mlflow-go/pkg/tracking/store/sql"
+
+	// Registers the file, memory and SQL tracking stores.
+	_ "github.com/mlflow/mlflow-go/pkg/tracking/store/file"
+	_ "github.com/mlflow/mlflow-go/pkg/tracking/store/memory"
+	_ "github.com/mlflow/mlflow-go/pkg/tracking/store/sql"
 )
 
 type TrackingService struct {
@@ -15,9 +19,9 @@ type TrackingService struct {
 }
 
 func NewTrackingService(ctx context.Context, config *config.Config) (*TrackingService, error) {
-	store, err := sql.NewTrackingSQLStore(ctx, config)
+	store, err := store.NewTrackingStore(ctx, config)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create new sql store: %w", err)
+		return nil, fmt.Errorf("failed to create new store: %w", err)
 	}
 
 	return &TrackingService{
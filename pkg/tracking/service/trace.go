@@ -100,6 +100,36 @@ func (ts TrackingService) GetTraceInfo(
 	}, nil
 }
 
+func (ts TrackingService) SearchTraces(
+	ctx context.Context, input *protos.SearchTraces,
+) (*protos.SearchTraces_Response, *contract.Error) {
+	traces, nextPageToken, err := ts.Store.SearchTraces(
+		ctx,
+		input.GetExperimentIds(),
+		input.GetFilter(),
+		int(input.GetMaxResults()),
+		input.GetOrderBy(),
+		input.GetPageToken(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response := protos.SearchTraces_Response{
+		Traces: make([]*protos.TraceInfo, len(traces)),
+	}
+
+	if nextPageToken != "" {
+		response.NextPageToken = &nextPageToken
+	}
+
+	for i, trace := range traces {
+		response.Traces[i] = trace.ToProto()
+	}
+
+	return &response, nil
+}
+
 func (ts TrackingService) DeleteTraces(
 	ctx context.Context, input *protos.DeleteTraces,
 ) (*protos.DeleteTraces_Response, *contract.Error) {
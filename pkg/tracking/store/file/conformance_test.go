@@ -0,0 +1,24 @@
+package file_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go/pkg/tracking/store"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store/storetest"
+)
+
+func TestTrackingFileStoreConformance(t *testing.T) {
+	t.Parallel()
+
+	fileStore, _ := newTestStore(t)
+
+	t.Cleanup(func() {
+		require.NoError(t, fileStore.Destroy())
+	})
+
+	storetest.RunFileStore(t, func(*testing.T) store.TrackingStore {
+		return fileStore
+	})
+}
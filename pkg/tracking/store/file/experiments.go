@@ -0,0 +1,517 @@
+package file
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/entities"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/tracking/service/query"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store/search"
+	"github.com/mlflow/mlflow-go/pkg/utils"
+)
+
+const (
+	lifecycleStageActive  = "active"
+	lifecycleStageDeleted = "deleted"
+)
+
+// experimentMeta is the meta.yaml of an experiment, the tags being in its tags folder.
+type experimentMeta struct {
+	ArtifactLocation string `yaml:"artifact_location"`
+	CreationTime     int64  `yaml:"creation_time"`
+	ExperimentID     string `yaml:"experiment_id"`
+	LastUpdateTime   int64  `yaml:"last_update_time"`
+	LifecycleStage   string `yaml:"lifecycle_stage"`
+	Name             string `yaml:"name"`
+}
+
+func checkExperimentIsActive(experiment *entities.Experiment) *contract.Error {
+	if experiment.LifecycleStage != lifecycleStageActive {
+		return contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf(
+				"The experiment %q must be in the 'active' state.\n"+
+					"Current state is %q.",
+				experiment.ExperimentID,
+				experiment.LifecycleStage,
+			),
+		)
+	}
+
+	return nil
+}
+
+// experimentDirectories returns the directories of the experiments, the deleted ones being in the trash folder.
+func (s *TrackingFileStore) experimentDirectories() ([]string, error) {
+	directories := make([]string, 0)
+
+	for _, parent := range []string{s.root, filepath.Join(s.root, TrashFolderName)} {
+		names, err := listDirectories(parent)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range names {
+			if strings.HasPrefix(name, ".") {
+				continue
+			}
+
+			directory := filepath.Join(parent, name)
+
+			ok, err := exists(filepath.Join(directory, MetaDataFileName))
+			if err != nil {
+				return nil, err
+			}
+
+			if ok {
+				directories = append(directories, directory)
+			}
+		}
+	}
+
+	return directories, nil
+}
+
+// experimentDirectory returns the directory of an experiment, "" if it doesn't exist.
+func (s *TrackingFileStore) experimentDirectory(experimentID string) (string, error) {
+	for _, directory := range []string{
+		filepath.Join(s.root, experimentID),
+		filepath.Join(s.root, TrashFolderName, experimentID),
+	} {
+		ok, err := exists(filepath.Join(directory, MetaDataFileName))
+		if err != nil {
+			return "", err
+		}
+
+		if ok {
+			return directory, nil
+		}
+	}
+
+	return "", nil
+}
+
+func readExperiment(directory string) (*entities.Experiment, error) {
+	var meta experimentMeta
+	if err := readYAML(filepath.Join(directory, MetaDataFileName), &meta); err != nil {
+		return nil, err
+	}
+
+	values, err := readValues(filepath.Join(directory, TagsFolderName))
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]*entities.ExperimentTag, 0, len(values))
+	for key, value := range values {
+		tags = append(tags, &entities.ExperimentTag{Key: key, Value: value})
+	}
+
+	slices.SortFunc(tags, func(a, b *entities.ExperimentTag) int { return strings.Compare(a.Key, b.Key) })
+
+	if meta.LifecycleStage == "" {
+		meta.LifecycleStage = lifecycleStageActive
+	}
+
+	return &entities.Experiment{
+		Name:             meta.Name,
+		ExperimentID:     meta.ExperimentID,
+		ArtifactLocation: meta.ArtifactLocation,
+		LifecycleStage:   meta.LifecycleStage,
+		LastUpdateTime:   meta.LastUpdateTime,
+		CreationTime:     meta.CreationTime,
+		Tags:             tags,
+	}, nil
+}
+
+func writeExperimentMeta(directory string, experiment *entities.Experiment) error {
+	return writeYAML(filepath.Join(directory, MetaDataFileName), experimentMeta{
+		ArtifactLocation: experiment.ArtifactLocation,
+		CreationTime:     experiment.CreationTime,
+		ExperimentID:     experiment.ExperimentID,
+		LastUpdateTime:   experiment.LastUpdateTime,
+		LifecycleStage:   experiment.LifecycleStage,
+		Name:             experiment.Name,
+	})
+}
+
+// getExperiment returns an experiment and its directory.
+func (s *TrackingFileStore) getExperiment(experimentID string) (*entities.Experiment, string, *contract.Error) {
+	if err := checkName("experiment id", experimentID); err != nil {
+		return nil, "", err
+	}
+
+	directory, err := s.experimentDirectory(experimentID)
+	if err != nil {
+		return nil, "", newInternalError("failed to get experiment", err)
+	}
+
+	if directory == "" {
+		return nil, "", contract.NewError(
+			protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+			fmt.Sprintf("No Experiment with id=%s exists", experimentID),
+		)
+	}
+
+	experiment, err := readExperiment(directory)
+	if err != nil {
+		return nil, "", newInternalError("failed to get experiment", err)
+	}
+
+	return experiment, directory, nil
+}
+
+func (s *TrackingFileStore) readExperiments() ([]*entities.Experiment, error) {
+	directories, err := s.experimentDirectories()
+	if err != nil {
+		return nil, err
+	}
+
+	experiments := make([]*entities.Experiment, 0, len(directories))
+
+	for _, directory := range directories {
+		experiment, err := readExperiment(directory)
+		if err != nil {
+			return nil, err
+		}
+
+		experiments = append(experiments, experiment)
+	}
+
+	return experiments, nil
+}
+
+func (s *TrackingFileStore) GetExperiment(_ context.Context, id string) (*entities.Experiment, *contract.Error) {
+	experiment, _, err := s.getExperiment(id)
+
+	return experiment, err
+}
+
+//nolint:perfsprint
+func (s *TrackingFileStore) GetExperimentByName(
+	_ context.Context, name string,
+) (*entities.Experiment, *contract.Error) {
+	experiments, err := s.readExperiments()
+	if err != nil {
+		return nil, newInternalError(fmt.Sprintf("failed to get experiment by name %s", name), err)
+	}
+
+	for _, experiment := range experiments {
+		if experiment.Name == name {
+			return experiment, nil
+		}
+	}
+
+	return nil, contract.NewError(
+		protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+		fmt.Sprintf("Could not find experiment with name %s", name),
+	)
+}
+
+// newExperimentID returns a random positive 63 bits integer, like _generate_unique_integer_id of MLflow.
+func newExperimentID() (string, error) {
+	var random [8]byte
+	if _, err := rand.Read(random[:]); err != nil {
+		return "", fmt.Errorf("failed to generate experiment id: %w", err)
+	}
+
+	return strconv.FormatUint(binary.BigEndian.Uint64(random[:])>>1, 10), nil
+}
+
+// createExperiment writes a new experiment, its artifact location defaulting to one in the default artifact root.
+func (s *TrackingFileStore) createExperiment(
+	experimentID, name, artifactLocation string, tags []*entities.ExperimentTag,
+) (*entities.Experiment, error) {
+	if artifactLocation == "" {
+		location, err := utils.AppendToURIPath(s.config.DefaultArtifactRoot, experimentID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to join artifact location: %w", err)
+		}
+
+		artifactLocation = location
+	}
+
+	now := time.Now().UnixMilli()
+	experiment := &entities.Experiment{
+		Name:             name,
+		ExperimentID:     experimentID,
+		ArtifactLocation: artifactLocation,
+		LifecycleStage:   lifecycleStageActive,
+		LastUpdateTime:   now,
+		CreationTime:     now,
+		Tags:             tags,
+	}
+
+	directory := filepath.Join(s.root, experimentID)
+	if err := os.MkdirAll(directory, directoryPermissions); err != nil {
+		return nil, fmt.Errorf("failed to create experiment directory: %w", err)
+	}
+
+	if err := writeExperimentMeta(directory, experiment); err != nil {
+		return nil, err
+	}
+
+	for _, tag := range tags {
+		if err := writeValue(filepath.Join(directory, TagsFolderName), tag.Key, tag.Value); err != nil {
+			return nil, err
+		}
+	}
+
+	return experiment, nil
+}
+
+func (s *TrackingFileStore) CreateExperiment(
+	ctx context.Context,
+	name string,
+	artifactLocation string,
+	tags []*entities.ExperimentTag,
+) (string, *contract.Error) {
+	for _, tag := range tags {
+		if err := checkName("tag key", tag.Key); err != nil {
+			return "", err
+		}
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, err := s.GetExperimentByName(ctx, name); err == nil {
+		return "", contract.NewError(
+			protos.ErrorCode_RESOURCE_ALREADY_EXISTS,
+			fmt.Sprintf("Experiment(name=%s) already exists.", name),
+		)
+	} else if protos.ErrorCode(err.Code) != protos.ErrorCode_RESOURCE_DOES_NOT_EXIST {
+		return "", err
+	}
+
+	var experimentID string
+
+	for experimentID == "" {
+		id, err := newExperimentID()
+		if err != nil {
+			return "", newInternalError("failed to create experiment", err)
+		}
+
+		directory, err := s.experimentDirectory(id)
+		if err != nil {
+			return "", newInternalError("failed to create experiment", err)
+		}
+
+		if directory == "" {
+			experimentID = id
+		}
+	}
+
+	if _, err := s.createExperiment(experimentID, name, artifactLocation, tags); err != nil {
+		return "", newInternalError("failed to create experiment", err)
+	}
+
+	return experimentID, nil
+}
+
+func (s *TrackingFileStore) RenameExperiment(ctx context.Context, experimentID, name string) *contract.Error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	experiment, directory, contractError := s.getExperiment(experimentID)
+	if contractError != nil {
+		return contractError
+	}
+
+	if existing, err := s.GetExperimentByName(ctx, name); err == nil && existing.ExperimentID != experimentID {
+		return contract.NewError(
+			protos.ErrorCode_RESOURCE_ALREADY_EXISTS,
+			fmt.Sprintf("Experiment(name=%s) already exists.", name),
+		)
+	}
+
+	experiment.Name = name
+	experiment.LastUpdateTime = time.Now().UnixMilli()
+
+	if err := writeExperimentMeta(directory, experiment); err != nil {
+		return newInternalError("failed to update experiment", err)
+	}
+
+	return nil
+}
+
+// setExperimentRunsLifecycleStage updates the runs of an experiment along with the experiment,
+// deletedTime being the time they are deleted at, nil when they are restored.
+func setExperimentRunsLifecycleStage(directory, lifecycleStage string, deletedTime *int64) error {
+	runIDs, err := listRunIDs(directory)
+	if err != nil {
+		return err
+	}
+
+	for _, runID := range runIDs {
+		runDirectory := filepath.Join(directory, runID)
+
+		meta, err := readRunMeta(runDirectory)
+		if err != nil {
+			return err
+		}
+
+		meta.LifecycleStage = lifecycleStage
+		meta.DeletedTime = deletedTime
+
+		if err := writeRunMeta(runDirectory, meta); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *TrackingFileStore) DeleteExperiment(_ context.Context, id string) *contract.Error {
+	if id == DefaultExperimentID {
+		return contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("Cannot delete the default experiment '%s'.", id),
+		)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	experiment, directory, contractError := s.getExperiment(id)
+	if contractError != nil {
+		return contractError
+	}
+
+	if experiment.LifecycleStage != lifecycleStageActive {
+		return contract.NewError(
+			protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+			fmt.Sprintf("No Experiment with id=%s exists", id),
+		)
+	}
+
+	now := time.Now().UnixMilli()
+	experiment.LifecycleStage = lifecycleStageDeleted
+	experiment.LastUpdateTime = now
+
+	if err := setExperimentRunsLifecycleStage(directory, lifecycleStageDeleted, &now); err != nil {
+		return newInternalError("failed to delete experiment", err)
+	}
+
+	if err := writeExperimentMeta(directory, experiment); err != nil {
+		return newInternalError("failed to delete experiment", err)
+	}
+
+	if err := os.Rename(directory, filepath.Join(s.root, TrashFolderName, id)); err != nil {
+		return newInternalError("failed to delete experiment", err)
+	}
+
+	return nil
+}
+
+func (s *TrackingFileStore) RestoreExperiment(_ context.Context, id string) *contract.Error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	experiment, directory, contractError := s.getExperiment(id)
+	if contractError != nil {
+		return contractError
+	}
+
+	if experiment.LifecycleStage != lifecycleStageDeleted {
+		return contract.NewError(
+			protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+			fmt.Sprintf("No Experiment with id=%s exists", id),
+		)
+	}
+
+	experiment.LifecycleStage = lifecycleStageActive
+	experiment.LastUpdateTime = time.Now().UnixMilli()
+
+	if err := setExperimentRunsLifecycleStage(directory, lifecycleStageActive, nil); err != nil {
+		return newInternalError("failed to restore experiment", err)
+	}
+
+	if err := writeExperimentMeta(directory, experiment); err != nil {
+		return newInternalError("failed to restore experiment", err)
+	}
+
+	if err := os.Rename(directory, filepath.Join(s.root, id)); err != nil {
+		return newInternalError("failed to restore experiment", err)
+	}
+
+	return nil
+}
+
+func (s *TrackingFileStore) SetExperimentTag(_ context.Context, experimentID, key, value string) *contract.Error {
+	if err := checkName("tag key", key); err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	experiment, directory, contractError := s.getExperiment(experimentID)
+	if contractError != nil {
+		return contractError
+	}
+
+	if err := checkExperimentIsActive(experiment); err != nil {
+		return err
+	}
+
+	if err := writeValue(filepath.Join(directory, TagsFolderName), key, value); err != nil {
+		return newInternalError("failed to set experiment tag", err)
+	}
+
+	return nil
+}
+
+func (s *TrackingFileStore) SearchExperiments(
+	ctx context.Context,
+	experimentViewType protos.ViewType,
+	maxResults int64,
+	filter string,
+	orderBy []string,
+	pageToken string,
+) ([]*entities.Experiment, string, *contract.Error) {
+	offset, contractError := search.ParsePageToken(pageToken)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	filterExpr, err := query.ParseExperimentFilter(filter)
+	if err != nil {
+		return nil, "", query.NewFilterError(err)
+	}
+
+	utils.GetLoggerFromContext(ctx).Debugf("Filter conditions: %v", filterExpr)
+
+	orderKeys, contractError := search.ParseExperimentsOrderBy(orderBy)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	experiments, err := s.readExperiments()
+	if err != nil {
+		return nil, "", newInternalError("failed to search experiments", err)
+	}
+
+	matches := make([]*entities.Experiment, 0, len(experiments))
+
+	for _, experiment := range experiments {
+		if search.MatchesViewType(experimentViewType, experiment.LifecycleStage) &&
+			search.Matches(filterExpr, search.ExperimentValues(experiment)) {
+			matches = append(matches, experiment)
+		}
+	}
+
+	search.Sort(matches, orderKeys, search.ExperimentValues)
+
+	return search.Paginate(matches, offset, int(maxResults))
+}
@@ -0,0 +1,176 @@
+package file
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/entities"
+)
+
+// The vertex types of the inputs, InputVertexType in MLflow.
+const (
+	inputVertexTypeRun     = 1
+	inputVertexTypeDataset = 2
+)
+
+// datasetMeta is the meta.yaml of a dataset, in the datasets folder of its experiment.
+type datasetMeta struct {
+	Digest     string `yaml:"digest"`
+	Name       string `yaml:"name"`
+	Profile    string `yaml:"profile"`
+	Schema     string `yaml:"schema"`
+	Source     string `yaml:"source"`
+	SourceType string `yaml:"source_type"`
+}
+
+// inputMeta is the meta.yaml of a dataset input, in the inputs folder of its run.
+type inputMeta struct {
+	DestinationID   string            `yaml:"destination_id"`
+	DestinationType int               `yaml:"destination_type"`
+	SourceID        string            `yaml:"source_id"`
+	SourceType      int               `yaml:"source_type"`
+	Tags            map[string]string `yaml:"tags"`
+}
+
+// md5ID returns the ID of a dataset or input, which MLflow derives from the MD5 hash of their key.
+func md5ID(values ...string) string {
+	hash := md5.New() //nolint:gosec
+	for _, value := range values {
+		hash.Write([]byte(value))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func readRunInputs(runDirectory string) ([]*entities.DatasetInput, error) {
+	inputsDirectory := filepath.Join(runDirectory, InputsFolderName)
+	datasetsDirectory := filepath.Join(filepath.Dir(runDirectory), DatasetsFolderName)
+
+	inputIDs, err := listDirectories(inputsDirectory)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs := make([]*entities.DatasetInput, 0, len(inputIDs))
+
+	for _, inputID := range inputIDs {
+		var input inputMeta
+		if err := readYAML(filepath.Join(inputsDirectory, inputID, MetaDataFileName), &input); err != nil {
+			return nil, err
+		}
+
+		var dataset datasetMeta
+		if err := readYAML(filepath.Join(datasetsDirectory, input.SourceID, MetaDataFileName), &dataset); err != nil {
+			return nil, err
+		}
+
+		tags := make([]*entities.InputTag, 0, len(input.Tags))
+		for key, value := range input.Tags {
+			tags = append(tags, &entities.InputTag{Key: key, Value: value})
+		}
+
+		slices.SortFunc(tags, func(a, b *entities.InputTag) int { return strings.Compare(a.Key, b.Key) })
+
+		inputs = append(inputs, &entities.DatasetInput{
+			Tags: tags,
+			Dataset: &entities.Dataset{
+				Name:       dataset.Name,
+				Digest:     dataset.Digest,
+				SourceType: dataset.SourceType,
+				Source:     dataset.Source,
+				Schema:     dataset.Schema,
+				Profile:    dataset.Profile,
+			},
+		})
+	}
+
+	slices.SortFunc(inputs, func(a, b *entities.DatasetInput) int {
+		return strings.Compare(a.Dataset.Name+"\x00"+a.Dataset.Digest, b.Dataset.Name+"\x00"+b.Dataset.Digest)
+	})
+
+	return inputs, nil
+}
+
+func logInputs(runDirectory, runID string, datasets []*entities.DatasetInput) error {
+	for _, datasetInput := range datasets {
+		dataset := datasetInput.Dataset
+		datasetID := md5ID(dataset.Name, dataset.Digest)
+		datasetDirectory := filepath.Join(filepath.Dir(runDirectory), DatasetsFolderName, datasetID)
+
+		ok, err := exists(datasetDirectory)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			if err := writeYAML(filepath.Join(datasetDirectory, MetaDataFileName), datasetMeta{
+				Digest:     dataset.Digest,
+				Name:       dataset.Name,
+				Profile:    dataset.Profile,
+				Schema:     dataset.Schema,
+				Source:     dataset.Source,
+				SourceType: dataset.SourceType,
+			}); err != nil {
+				return err
+			}
+		}
+
+		inputDirectory := filepath.Join(runDirectory, InputsFolderName, md5ID(datasetID, runID))
+
+		// Like in the SQL store, logging a dataset input again doesn't update its tags.
+		ok, err = exists(inputDirectory)
+		if err != nil {
+			return err
+		}
+
+		if ok {
+			continue
+		}
+
+		tags := make(map[string]string, len(datasetInput.Tags))
+		for _, tag := range datasetInput.Tags {
+			tags[tag.Key] = tag.Value
+		}
+
+		if err := os.MkdirAll(inputDirectory, directoryPermissions); err != nil {
+			return fmt.Errorf("failed to create input directory: %w", err)
+		}
+
+		if err := writeYAML(filepath.Join(inputDirectory, MetaDataFileName), inputMeta{
+			DestinationID:   runID,
+			DestinationType: inputVertexTypeRun,
+			SourceID:        datasetID,
+			SourceType:      inputVertexTypeDataset,
+			Tags:            tags,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *TrackingFileStore) LogInputs(
+	_ context.Context, runID string, datasets []*entities.DatasetInput,
+) *contract.Error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	directory, _, contractError := s.getActiveRun(runID)
+	if contractError != nil {
+		return contractError
+	}
+
+	if err := logInputs(directory, runID, datasets); err != nil {
+		return newInternalError(fmt.Sprintf("log inputs failed for %q", runID), err)
+	}
+
+	return nil
+}
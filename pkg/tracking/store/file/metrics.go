@@ -0,0 +1,312 @@
+package file
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/entities"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store/search"
+)
+
+// Upper bound of metrics returned per run by GetMetricHistoryBulkInterval.
+const maxResultsGetMetricHistoryBulkInterval = 25000
+
+// formatMetricValue formats a value like Python does for the special values, which it writes as nan, inf and -inf.
+func formatMetricValue(value float64) string {
+	switch {
+	case math.IsNaN(value):
+		return "nan"
+	case math.IsInf(value, 1):
+		return "inf"
+	case math.IsInf(value, -1):
+		return "-inf"
+	default:
+		return strconv.FormatFloat(value, 'g', -1, 64)
+	}
+}
+
+// parseMetricLine parses a line of a metric file, "timestamp value step", the step missing in old MLflow versions.
+func parseMetricLine(key, line string) (*entities.Metric, error) {
+	fields := strings.Fields(line)
+	if len(fields) != 2 && len(fields) != 3 {
+		return nil, fmt.Errorf("invalid metric line %q", line) //nolint:err113
+	}
+
+	timestamp, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid metric timestamp in %q: %w", line, err)
+	}
+
+	value, err := strconv.ParseFloat(fields[1], 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid metric value in %q: %w", line, err)
+	}
+
+	var step int64
+	if len(fields) == 3 {
+		if step, err = strconv.ParseInt(fields[2], 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid metric step in %q: %w", line, err)
+		}
+	}
+
+	return &entities.Metric{
+		Key:       key,
+		Value:     value,
+		Timestamp: timestamp,
+		Step:      step,
+		IsNaN:     math.IsNaN(value),
+	}, nil
+}
+
+// readMetricHistory reads the metric file of key, which may not exist.
+func readMetricHistory(folder, key string) ([]*entities.Metric, error) {
+	file, err := os.Open(filepath.Join(folder, filepath.FromSlash(key)))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return make([]*entities.Metric, 0), nil
+		}
+
+		return nil, fmt.Errorf("failed to read metric %q: %w", key, err)
+	}
+	defer file.Close()
+
+	metrics := make([]*entities.Metric, 0)
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+
+		metric, err := parseMetricLine(key, scanner.Text())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read metric %q: %w", key, err)
+		}
+
+		metrics = append(metrics, metric)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read metric %q: %w", key, err)
+	}
+
+	return metrics, nil
+}
+
+func compareMetrics(a, b *entities.Metric) int {
+	return cmp.Or(cmp.Compare(a.Step, b.Step), cmp.Compare(a.Timestamp, b.Timestamp), cmp.Compare(a.Value, b.Value))
+}
+
+// readLatestMetrics reads the latest value of every metric of a run, by step, timestamp and value.
+func readLatestMetrics(folder string) ([]*entities.Metric, error) {
+	keys, err := listKeys(folder)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := make([]*entities.Metric, 0, len(keys))
+
+	for _, key := range keys {
+		history, err := readMetricHistory(folder, key)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(history) > 0 {
+			metrics = append(metrics, slices.MaxFunc(history, compareMetrics))
+		}
+	}
+
+	slices.SortFunc(metrics, func(a, b *entities.Metric) int { return strings.Compare(a.Key, b.Key) })
+
+	return metrics, nil
+}
+
+// logMetrics appends metrics to their files, the duplicates of the batch being logged once.
+func logMetrics(directory string, metrics []*entities.Metric) error {
+	lines := make(map[string][]string)
+	keys := make([]string, 0)
+	seen := make(map[string]struct{}, len(metrics))
+
+	for _, metric := range metrics {
+		value := metric.Value
+		if metric.IsNaN {
+			value = math.NaN()
+		}
+
+		line := fmt.Sprintf("%d %s %d\n", metric.Timestamp, formatMetricValue(value), metric.Step)
+		if _, ok := seen[metric.Key+"\x00"+line]; ok {
+			continue
+		}
+
+		seen[metric.Key+"\x00"+line] = struct{}{}
+
+		if _, ok := lines[metric.Key]; !ok {
+			keys = append(keys, metric.Key)
+		}
+
+		lines[metric.Key] = append(lines[metric.Key], line)
+	}
+
+	for _, key := range keys {
+		path := filepath.Join(directory, MetricsFolderName, filepath.FromSlash(key))
+		if err := os.MkdirAll(filepath.Dir(path), directoryPermissions); err != nil {
+			return fmt.Errorf("failed to create directory of metric %q: %w", key, err)
+		}
+
+		file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, filePermissions)
+		if err != nil {
+			return fmt.Errorf("failed to open metric %q: %w", key, err)
+		}
+
+		_, err = file.WriteString(strings.Join(lines[key], ""))
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to write metric %q: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *TrackingFileStore) LogMetric(_ context.Context, runID string, metric *entities.Metric) *contract.Error {
+	if err := checkName("metric key", metric.Key); err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	directory, _, contractError := s.getActiveRun(runID)
+	if contractError != nil {
+		return contractError
+	}
+
+	if err := logMetrics(directory, []*entities.Metric{metric}); err != nil {
+		return newInternalError(fmt.Sprintf("log metric failed for %q", runID), err)
+	}
+
+	return nil
+}
+
+func (s *TrackingFileStore) GetMetricHistory(
+	_ context.Context, runID, metricKey, pageToken string, maxResults int,
+) ([]*entities.Metric, string, *contract.Error) {
+	offset, contractError := search.ParsePageToken(pageToken)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	if err := checkName("metric key", metricKey); err != nil {
+		return nil, "", err
+	}
+
+	directory, contractError := s.runDirectory(runID)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	metrics, err := readMetricHistory(filepath.Join(directory, MetricsFolderName), metricKey)
+	if err != nil {
+		return nil, "", newInternalError("error getting metric history", err)
+	}
+
+	slices.SortStableFunc(metrics, compareMetrics)
+
+	return search.Paginate(metrics, offset, maxResults)
+}
+
+func (s *TrackingFileStore) GetMetricHistoryBulkInterval(
+	_ context.Context,
+	runIDs []string,
+	metricKey string,
+	startStep, endStep *int64,
+	maxResults int,
+) ([]*entities.MetricWithRunID, *contract.Error) {
+	if err := checkName("metric key", metricKey); err != nil {
+		return nil, err
+	}
+
+	histories := make([][]*entities.Metric, 0, len(runIDs))
+	allSteps := make([]int64, 0)
+
+	for _, runID := range runIDs {
+		directory, contractError := s.runDirectory(runID)
+		if contractError != nil {
+			return nil, contractError
+		}
+
+		history, err := readMetricHistory(filepath.Join(directory, MetricsFolderName), metricKey)
+		if err != nil {
+			return nil, newInternalError(fmt.Sprintf("error getting metric history for run %q", runID), err)
+		}
+
+		slices.SortStableFunc(history, compareMetrics)
+		histories = append(histories, history)
+
+		for _, metric := range history {
+			allSteps = append(allSteps, metric.Step)
+		}
+	}
+
+	slices.Sort(allSteps)
+	allSteps = slices.Compact(allSteps)
+
+	var start, end int64
+	if startStep != nil && endStep != nil {
+		start, end = *startStep, *endStep
+	} else if len(allSteps) > 0 {
+		end = allSteps[len(allSteps)-1]
+	}
+
+	// The min and max step of every run are always part of the result.
+	sampled := store.SampleSteps(start, end, maxResults, allSteps)
+
+	for _, history := range histories {
+		if len(history) == 0 {
+			continue
+		}
+
+		for _, step := range []int64{history[0].Step, history[len(history)-1].Step} {
+			if start <= step && step <= end {
+				sampled[step] = struct{}{}
+			}
+		}
+	}
+
+	metricsWithRunID := make([]*entities.MetricWithRunID, 0)
+
+	for index, history := range histories {
+		count := 0
+
+		for _, metric := range history {
+			if _, ok := sampled[metric.Step]; !ok || count == maxResultsGetMetricHistoryBulkInterval {
+				continue
+			}
+
+			count++
+
+			metricsWithRunID = append(metricsWithRunID, &entities.MetricWithRunID{
+				Metric: metric,
+				RunID:  runIDs[index],
+			})
+		}
+	}
+
+	return metricsWithRunID, nil
+}
@@ -0,0 +1,146 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/entities"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+)
+
+func changingParamError(key, oldValue, runID, newValue string) *contract.Error {
+	return contract.NewError(
+		protos.ErrorCode_INVALID_PARAMETER_VALUE,
+		fmt.Sprintf(
+			"Changing param values is not allowed. "+
+				"Params with key=%q was already logged "+
+				"with value=%q for run ID=%q. "+
+				"Attempted logging new value %q",
+			key,
+			oldValue,
+			runID,
+			newValue,
+		),
+	)
+}
+
+// checkParams returns the params to write, as params can be logged again with the same value but not changed.
+func checkParams(directory, runID string, params []*entities.Param) ([]*entities.Param, *contract.Error) {
+	values := make(map[string]string, len(params))
+	newParams := make([]*entities.Param, 0, len(params))
+
+	for _, param := range params {
+		if err := checkName("param key", param.Key); err != nil {
+			return nil, err
+		}
+
+		var value string
+		if param.Value != nil {
+			value = *param.Value
+		}
+
+		if oldValue, ok := values[param.Key]; ok {
+			if oldValue != value {
+				return nil, changingParamError(param.Key, oldValue, runID, value)
+			}
+
+			continue
+		}
+
+		oldValue, ok, err := readValue(filepath.Join(directory, ParamsFolderName), param.Key)
+		if err != nil {
+			return nil, newInternalError(
+				fmt.Sprintf("failed to get existing params to check if duplicates for run_id %q", runID), err,
+			)
+		}
+
+		if ok && oldValue != value {
+			return nil, changingParamError(param.Key, oldValue, runID, value)
+		}
+
+		values[param.Key] = value
+
+		if !ok {
+			newParams = append(newParams, &entities.Param{Key: param.Key, Value: &value})
+		}
+	}
+
+	return newParams, nil
+}
+
+func logParams(directory string, params []*entities.Param) error {
+	for _, param := range params {
+		if err := writeValue(filepath.Join(directory, ParamsFolderName), param.Key, *param.Value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *TrackingFileStore) LogParam(_ context.Context, runID string, param *entities.Param) *contract.Error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	directory, _, contractError := s.getActiveRun(runID)
+	if contractError != nil {
+		return contractError
+	}
+
+	params, contractError := checkParams(directory, runID, []*entities.Param{param})
+	if contractError != nil {
+		return contractError
+	}
+
+	if err := logParams(directory, params); err != nil {
+		return newInternalError(fmt.Sprintf("log param failed for %q", runID), err)
+	}
+
+	return nil
+}
+
+// LogBatch checks the whole batch before writing it, as files can't be written in a transaction.
+func (s *TrackingFileStore) LogBatch(
+	_ context.Context, runID string, metrics []*entities.Metric, params []*entities.Param, tags []*entities.RunTag,
+) *contract.Error {
+	for _, metric := range metrics {
+		if err := checkName("metric key", metric.Key); err != nil {
+			return err
+		}
+	}
+
+	for _, tag := range tags {
+		if err := checkName("tag key", tag.Key); err != nil {
+			return err
+		}
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	directory, meta, contractError := s.getActiveRun(runID)
+	if contractError != nil {
+		return contractError
+	}
+
+	params, contractError = checkParams(directory, runID, params)
+	if contractError != nil {
+		return contractError
+	}
+
+	if err := setTags(directory, meta, tags); err != nil {
+		return newInternalError(fmt.Sprintf("error setting tags for run_id %q", runID), err)
+	}
+
+	if err := logParams(directory, params); err != nil {
+		return newInternalError(fmt.Sprintf("error creating params for run_uuid %q", runID), err)
+	}
+
+	if err := logMetrics(directory, metrics); err != nil {
+		return newInternalError(fmt.Sprintf("error creating metrics for run_uuid %q", runID), err)
+	}
+
+	return nil
+}
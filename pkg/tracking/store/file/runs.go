@@ -0,0 +1,503 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/entities"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/tracking/service/query"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store/search"
+	"github.com/mlflow/mlflow-go/pkg/utils"
+)
+
+// MLflow stores the LOCAL source type in the meta.yaml of the runs it creates.
+const sourceTypeLocal = 4
+
+// runMeta is the meta.yaml of a run. Like in MLflow, the fields are sorted and status is the number
+// of the RunStatus enum. The tags are in the tags folder, the tags field being kept for old MLflow versions.
+type runMeta struct {
+	ArtifactURI    string   `yaml:"artifact_uri"`
+	DeletedTime    *int64   `yaml:"deleted_time,omitempty"`
+	EndTime        *int64   `yaml:"end_time"`
+	EntryPointName string   `yaml:"entry_point_name"`
+	ExperimentID   string   `yaml:"experiment_id"`
+	LifecycleStage string   `yaml:"lifecycle_stage"`
+	RunID          string   `yaml:"run_id"`
+	RunName        string   `yaml:"run_name"`
+	RunUUID        string   `yaml:"run_uuid"`
+	SourceName     string   `yaml:"source_name"`
+	SourceType     int      `yaml:"source_type"`
+	SourceVersion  string   `yaml:"source_version"`
+	StartTime      int64    `yaml:"start_time"`
+	Status         int32    `yaml:"status"`
+	Tags           []string `yaml:"tags"`
+	UserID         string   `yaml:"user_id"`
+}
+
+func (m *runMeta) toEntity() *entities.RunInfo {
+	runID := m.RunID
+	if runID == "" {
+		runID = m.RunUUID
+	}
+
+	lifecycleStage := m.LifecycleStage
+	if lifecycleStage == "" {
+		lifecycleStage = lifecycleStageActive
+	}
+
+	return &entities.RunInfo{
+		RunID:          runID,
+		RunUUID:        runID,
+		RunName:        m.RunName,
+		ExperimentID:   m.ExperimentID,
+		UserID:         m.UserID,
+		Status:         protos.RunStatus_name[m.Status],
+		StartTime:      m.StartTime,
+		EndTime:        m.EndTime,
+		ArtifactURI:    m.ArtifactURI,
+		LifecycleStage: lifecycleStage,
+	}
+}
+
+func readRunMeta(directory string) (*runMeta, error) {
+	var meta runMeta
+	if err := readYAML(filepath.Join(directory, MetaDataFileName), &meta); err != nil {
+		return nil, err
+	}
+
+	return &meta, nil
+}
+
+func writeRunMeta(directory string, meta *runMeta) error {
+	meta.Tags = make([]string, 0)
+
+	return writeYAML(filepath.Join(directory, MetaDataFileName), meta)
+}
+
+// listRunIDs returns the IDs of the runs of an experiment, which are the folders having a meta.yaml.
+func listRunIDs(experimentDirectory string) ([]string, error) {
+	names, err := listDirectories(experimentDirectory)
+	if err != nil {
+		return nil, err
+	}
+
+	runIDs := make([]string, 0, len(names))
+
+	for _, name := range names {
+		if name == TagsFolderName || name == DatasetsFolderName || name == TracesFolderName {
+			continue
+		}
+
+		ok, err := exists(filepath.Join(experimentDirectory, name, MetaDataFileName))
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			runIDs = append(runIDs, name)
+		}
+	}
+
+	return runIDs, nil
+}
+
+// runDirectory returns the directory of a run, which can be in any experiment.
+func (s *TrackingFileStore) runDirectory(runID string) (string, *contract.Error) {
+	if err := checkName("run id", runID); err != nil {
+		return "", err
+	}
+
+	directories, err := s.experimentDirectories()
+	if err != nil {
+		return "", newInternalError("failed to get run", err)
+	}
+
+	for _, directory := range directories {
+		ok, err := exists(filepath.Join(directory, runID, MetaDataFileName))
+		if err != nil {
+			return "", newInternalError("failed to get run", err)
+		}
+
+		if ok {
+			return filepath.Join(directory, runID), nil
+		}
+	}
+
+	return "", contract.NewError(
+		protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+		fmt.Sprintf("Run with id=%s not found", runID),
+	)
+}
+
+// getActiveRun returns the directory and meta of a run which must be active to be updated.
+func (s *TrackingFileStore) getActiveRun(runID string) (string, *runMeta, *contract.Error) {
+	directory, contractError := s.runDirectory(runID)
+	if contractError != nil {
+		return "", nil, contractError
+	}
+
+	meta, err := readRunMeta(directory)
+	if err != nil {
+		return "", nil, newInternalError(fmt.Sprintf("failed to get lifecycle stage for run %q", runID), err)
+	}
+
+	if lifecycleStage := meta.toEntity().LifecycleStage; lifecycleStage != lifecycleStageActive {
+		return "", nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf(
+				"The run %s must be in the 'active' state.\n"+
+					"Current state is %v.",
+				runID,
+				lifecycleStage,
+			),
+		)
+	}
+
+	return directory, meta, nil
+}
+
+func readRun(directory string) (*entities.Run, error) {
+	meta, err := readRunMeta(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics, err := readLatestMetrics(filepath.Join(directory, MetricsFolderName))
+	if err != nil {
+		return nil, err
+	}
+
+	paramValues, err := readValues(filepath.Join(directory, ParamsFolderName))
+	if err != nil {
+		return nil, err
+	}
+
+	params := make([]*entities.Param, 0, len(paramValues))
+	for key, value := range paramValues {
+		params = append(params, &entities.Param{Key: key, Value: utils.PtrTo(value)})
+	}
+
+	slices.SortFunc(params, func(a, b *entities.Param) int { return strings.Compare(a.Key, b.Key) })
+
+	tagValues, err := readValues(filepath.Join(directory, TagsFolderName))
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]*entities.RunTag, 0, len(tagValues))
+	for key, value := range tagValues {
+		tags = append(tags, &entities.RunTag{Key: key, Value: value})
+	}
+
+	slices.SortFunc(tags, func(a, b *entities.RunTag) int { return strings.Compare(a.Key, b.Key) })
+
+	inputs, err := readRunInputs(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.Run{
+		Info: meta.toEntity(),
+		Data: &entities.RunData{
+			Tags:    tags,
+			Params:  params,
+			Metrics: metrics,
+		},
+		Inputs: &entities.RunInputs{DatasetInputs: inputs},
+	}, nil
+}
+
+func (s *TrackingFileStore) GetRun(_ context.Context, runID string) (*entities.Run, *contract.Error) {
+	directory, contractError := s.runDirectory(runID)
+	if contractError != nil {
+		return nil, contractError
+	}
+
+	run, err := readRun(directory)
+	if err != nil {
+		return nil, newInternalError("failed to get run", err)
+	}
+
+	return run, nil
+}
+
+// runName returns the name of a new run, which may be given as argument, as mlflow.runName tag or both.
+func runName(name string, tags []*entities.RunTag) (string, *contract.Error) {
+	var nameFromTags string
+
+	for _, tag := range tags {
+		if tag.Key == utils.TagRunName {
+			nameFromTags = tag.Value
+		}
+	}
+
+	switch {
+	case name != "" && nameFromTags != "" && name != nameFromTags:
+		return "", contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf(
+				"Both 'run_name' argument and 'mlflow.runName' tag are specified, but with "+
+					"different values (run_name='%s', mlflow.runName='%s').",
+				name,
+				nameFromTags,
+			),
+		)
+	case name != "":
+		return name, nil
+	case nameFromTags != "":
+		return nameFromTags, nil
+	}
+
+	randomName, err := utils.GenerateRandomName()
+	if err != nil {
+		return "", newInternalError("failed to generate random run name", err)
+	}
+
+	return randomName, nil
+}
+
+func (s *TrackingFileStore) CreateRun(
+	_ context.Context,
+	experimentID, userID string,
+	startTime int64,
+	tags []*entities.RunTag,
+	name string,
+) (*entities.Run, *contract.Error) {
+	for _, tag := range tags {
+		if err := checkName("tag key", tag.Key); err != nil {
+			return nil, err
+		}
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	experiment, experimentDirectory, contractError := s.getExperiment(experimentID)
+	if contractError != nil {
+		return nil, contractError
+	}
+
+	if err := checkExperimentIsActive(experiment); err != nil {
+		return nil, err
+	}
+
+	name, contractError = runName(name, tags)
+	if contractError != nil {
+		return nil, contractError
+	}
+
+	runID := utils.NewUUID()
+
+	artifactURI, err := utils.AppendToURIPath(experiment.ArtifactLocation, runID, ArtifactsFolderName)
+	if err != nil {
+		return nil, contract.NewError(
+			protos.ErrorCode_INTERNAL_ERROR,
+			"failed to append run ID to experiment artifact location",
+		)
+	}
+
+	meta := &runMeta{
+		ArtifactURI:    artifactURI,
+		ExperimentID:   experiment.ExperimentID,
+		LifecycleStage: lifecycleStageActive,
+		RunID:          runID,
+		RunName:        name,
+		RunUUID:        runID,
+		SourceType:     sourceTypeLocal,
+		StartTime:      startTime,
+		Status:         int32(protos.RunStatus_RUNNING),
+		UserID:         userID,
+	}
+
+	directory := filepath.Join(experimentDirectory, runID)
+	if err := createRunDirectory(directory, meta, tags); err != nil {
+		return nil, newInternalError(fmt.Sprintf("failed to create run for experiment_id %q", experimentID), err)
+	}
+
+	run, err := readRun(directory)
+	if err != nil {
+		return nil, newInternalError(fmt.Sprintf("failed to create run for experiment_id %q", experimentID), err)
+	}
+
+	return run, nil
+}
+
+func createRunDirectory(directory string, meta *runMeta, tags []*entities.RunTag) error {
+	for _, folder := range []string{MetricsFolderName, ParamsFolderName, TagsFolderName, ArtifactsFolderName} {
+		if err := os.MkdirAll(filepath.Join(directory, folder), directoryPermissions); err != nil {
+			return fmt.Errorf("failed to create run directory: %w", err)
+		}
+	}
+
+	if err := writeRunMeta(directory, meta); err != nil {
+		return err
+	}
+
+	for _, tag := range tags {
+		if err := writeValue(filepath.Join(directory, TagsFolderName), tag.Key, tag.Value); err != nil {
+			return err
+		}
+	}
+
+	return writeValue(filepath.Join(directory, TagsFolderName), utils.TagRunName, meta.RunName)
+}
+
+func (s *TrackingFileStore) UpdateRun(
+	_ context.Context,
+	runID string,
+	runStatus string,
+	endTime *int64,
+	runName string,
+) *contract.Error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	directory, meta, contractError := s.getActiveRun(runID)
+	if contractError != nil {
+		return contractError
+	}
+
+	if status, ok := protos.RunStatus_value[strings.ToUpper(runStatus)]; ok {
+		meta.Status = status
+	}
+
+	if endTime != nil {
+		meta.EndTime = endTime
+	}
+
+	if runName != "" {
+		meta.RunName = runName
+
+		if err := writeValue(filepath.Join(directory, TagsFolderName), utils.TagRunName, runName); err != nil {
+			return newInternalError("failed to update run", err)
+		}
+	}
+
+	if err := writeRunMeta(directory, meta); err != nil {
+		return newInternalError("failed to update run", err)
+	}
+
+	return nil
+}
+
+func (s *TrackingFileStore) setRunLifecycleStage(runID, lifecycleStage string, deletedTime *int64) *contract.Error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	directory, contractError := s.runDirectory(runID)
+	if contractError != nil {
+		return contractError
+	}
+
+	meta, err := readRunMeta(directory)
+	if err != nil {
+		return newInternalError(fmt.Sprintf("failed to update run %q", runID), err)
+	}
+
+	meta.LifecycleStage = lifecycleStage
+	meta.DeletedTime = deletedTime
+
+	if err := writeRunMeta(directory, meta); err != nil {
+		return newInternalError(fmt.Sprintf("failed to update run %q", runID), err)
+	}
+
+	return nil
+}
+
+func (s *TrackingFileStore) DeleteRun(_ context.Context, runID string) *contract.Error {
+	return s.setRunLifecycleStage(runID, lifecycleStageDeleted, utils.PtrTo(time.Now().UnixMilli()))
+}
+
+func (s *TrackingFileStore) RestoreRun(_ context.Context, runID string) *contract.Error {
+	return s.setRunLifecycleStage(runID, lifecycleStageActive, nil)
+}
+
+func (s *TrackingFileStore) SearchRuns(
+	ctx context.Context,
+	experimentIDs []string, filter string,
+	runViewType protos.ViewType, maxResults int, orderBy []string, pageToken string,
+) ([]*entities.Run, string, *contract.Error) {
+	offset, contractError := search.ParsePageToken(pageToken)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	filterExpr, err := query.ParseFilter(filter)
+	if err != nil {
+		return nil, "", query.NewFilterError(err)
+	}
+
+	utils.GetLoggerFromContext(ctx).Debugf("Filter conditions: %v", filterExpr)
+
+	orderKeys, contractError := search.ParseRunsOrderBy(orderBy)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	runs := make([]*entities.Run, 0)
+
+	for _, experimentID := range experimentIDs {
+		experimentRuns, err := s.readExperimentRuns(experimentID)
+		if err != nil {
+			return nil, "", newInternalError("Failed to query search runs", err)
+		}
+
+		for _, run := range experimentRuns {
+			if search.MatchesViewType(runViewType, run.Info.LifecycleStage) && search.Matches(filterExpr, search.RunValues(run)) {
+				runs = append(runs, run)
+			}
+		}
+	}
+
+	search.Sort(runs, orderKeys, search.RunValues)
+
+	runs, nextPageToken, contractError := search.Paginate(runs, offset, maxResults)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	sections := store.GetRunSectionsFromContext(ctx)
+	for index, run := range runs {
+		runs[index] = sections.Select(run)
+	}
+
+	return runs, nextPageToken, nil
+}
+
+// readExperimentRuns returns the runs of an experiment, none if it doesn't exist.
+func (s *TrackingFileStore) readExperimentRuns(experimentID string) ([]*entities.Run, error) {
+	if checkName("experiment id", experimentID) != nil {
+		return nil, nil
+	}
+
+	directory, err := s.experimentDirectory(experimentID)
+	if err != nil || directory == "" {
+		return nil, err
+	}
+
+	runIDs, err := listRunIDs(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make([]*entities.Run, 0, len(runIDs))
+
+	for _, runID := range runIDs {
+		run, err := readRun(filepath.Join(directory, runID))
+		if err != nil {
+			return nil, err
+		}
+
+		runs = append(runs, run)
+	}
+
+	return runs, nil
+}
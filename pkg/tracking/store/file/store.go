@@ -0,0 +1,321 @@
+package file
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/mlflow/mlflow-go/pkg/config"
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store"
+	"github.com/mlflow/mlflow-go/pkg/utils"
+)
+
+// The layout of the FileStore of MLflow, see mlflow/store/tracking/file_store.py.
+const (
+	DefaultExperimentID = "0"
+
+	TrashFolderName                = ".trash"
+	MetaDataFileName               = "meta.yaml"
+	MetricsFolderName              = "metrics"
+	ParamsFolderName               = "params"
+	TagsFolderName                 = "tags"
+	ArtifactsFolderName            = "artifacts"
+	DatasetsFolderName             = "datasets"
+	InputsFolderName               = "inputs"
+	TracesFolderName               = "traces"
+	TraceInfoFileName              = "trace_info.yaml"
+	TraceRequestMetadataFolderName = "request_metadata"
+)
+
+const (
+	defaultExperimentName = "Default"
+	directoryPermissions  = 0o755
+	filePermissions       = 0o644
+	yamlIndent            = 2
+	// temporaryFileSuffix ends the names of the temporary files of writeFile, which aren't keys.
+	temporaryFileSuffix = ".mlflow-go-tmp"
+)
+
+// TrackingFileStore stores experiments, runs and traces in a directory laid out like the FileStore of MLflow,
+// so that it can serve the mlruns directories written by MLflow and the other way around.
+type TrackingFileStore struct {
+	config *config.Config
+	root   string
+	// mutex serializes the updates, which read and rewrite files.
+	mutex sync.Mutex
+}
+
+// Like database/sql drivers, the file store registers itself when its package is imported.
+//
+//nolint:gochecknoinits
+func init() {
+	store.RegisterTrackingStore("file", func(ctx context.Context, config *config.Config) (store.TrackingStore, error) {
+		return NewTrackingFileStore(ctx, config)
+	})
+}
+
+// rootDirectory returns the directory of a file store URI, either file:///path/to/mlruns or a plain path.
+func rootDirectory(storeURI string) (string, error) {
+	path := storeURI
+
+	if strings.HasPrefix(storeURI, "file:") {
+		uri, err := url.Parse(storeURI)
+		if err != nil {
+			return "", fmt.Errorf("failed to parse store URI %q: %w", storeURI, err)
+		}
+
+		path = uri.Path
+		// file:///C:/mlruns has /C:/mlruns as path.
+		if runtime.GOOS == "windows" && strings.HasPrefix(path, "/") {
+			path = path[1:]
+		}
+	}
+
+	root, err := filepath.Abs(filepath.FromSlash(path))
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve store directory %q: %w", path, err)
+	}
+
+	return root, nil
+}
+
+func NewTrackingFileStore(ctx context.Context, config *config.Config) (*TrackingFileStore, error) {
+	root, err := rootDirectory(config.TrackingStoreURI)
+	if err != nil {
+		return nil, err
+	}
+
+	fileStore := &TrackingFileStore{
+		config: config,
+		root:   root,
+		mutex:  sync.Mutex{},
+	}
+
+	// Like in MLflow, the default experiment is only created along with the store directory.
+	_, err = os.Stat(root)
+
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		if err := os.MkdirAll(filepath.Join(root, TrashFolderName), directoryPermissions); err != nil {
+			return nil, fmt.Errorf("failed to create store directory %q: %w", root, err)
+		}
+
+		if _, err := fileStore.createExperiment(DefaultExperimentID, defaultExperimentName, "", nil); err != nil {
+			return nil, fmt.Errorf("failed to create the default experiment: %w", err)
+		}
+	case err != nil:
+		return nil, fmt.Errorf("failed to open store directory %q: %w", root, err)
+	default:
+		if err := os.MkdirAll(filepath.Join(root, TrashFolderName), directoryPermissions); err != nil {
+			return nil, fmt.Errorf("failed to create trash directory in %q: %w", root, err)
+		}
+	}
+
+	utils.GetLoggerFromContext(ctx).Debugf("Using file store in %q", root)
+
+	return fileStore, nil
+}
+
+func (s *TrackingFileStore) Destroy() error {
+	return nil
+}
+
+func newInternalError(message string, err error) *contract.Error {
+	return contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, message, err)
+}
+
+// checkName rejects the IDs and keys which aren't a local path, as they are used as file names.
+func checkName(kind, name string) *contract.Error {
+	if name == "" || !filepath.IsLocal(filepath.FromSlash(name)) || strings.Contains(name, "\\") {
+		return contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("Invalid %s %q, it must be a relative path without '..'", kind, name),
+		)
+	}
+
+	return nil
+}
+
+func exists(path string) (bool, error) {
+	_, err := os.Stat(path)
+
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, fs.ErrNotExist):
+		return false, nil
+	default:
+		return false, fmt.Errorf("failed to stat %q: %w", path, err)
+	}
+}
+
+// listDirectories returns the names of the directories in path, none if path doesn't exist.
+func listDirectories(path string) ([]string, error) {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to list %q: %w", path, err)
+	}
+
+	names := make([]string, 0, len(entries))
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			names = append(names, entry.Name())
+		}
+	}
+
+	return names, nil
+}
+
+func readYAML(path string, out any) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %q: %w", path, err)
+	}
+
+	if err := yaml.Unmarshal(content, out); err != nil {
+		return fmt.Errorf("failed to parse %q: %w", path, err)
+	}
+
+	return nil
+}
+
+func writeYAML(path string, value any) error {
+	var content bytes.Buffer
+
+	encoder := yaml.NewEncoder(&content)
+	encoder.SetIndent(yamlIndent)
+
+	if err := encoder.Encode(value); err != nil {
+		return fmt.Errorf("failed to encode %q: %w", path, err)
+	}
+
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to encode %q: %w", path, err)
+	}
+
+	return writeFile(path, content.Bytes())
+}
+
+// writeFile replaces the content of path, through a temporary file so that readers never see a partial file.
+func writeFile(path string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), directoryPermissions); err != nil {
+		return fmt.Errorf("failed to create directory of %q: %w", path, err)
+	}
+
+	temporary, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*"+temporaryFileSuffix)
+	if err != nil {
+		return fmt.Errorf("failed to write %q: %w", path, err)
+	}
+
+	_, err = temporary.Write(content)
+	if closeErr := temporary.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err == nil {
+		err = os.Chmod(temporary.Name(), filePermissions)
+	}
+
+	if err == nil {
+		err = os.Rename(temporary.Name(), path)
+	}
+
+	if err != nil {
+		os.Remove(temporary.Name())
+
+		return fmt.Errorf("failed to write %q: %w", path, err)
+	}
+
+	return nil
+}
+
+// listKeys returns the keys of a metrics, params or tags folder, which are the paths of its files.
+// Keys with slashes are nested folders, like in MLflow.
+func listKeys(folder string) ([]string, error) {
+	keys := make([]string, 0)
+
+	err := filepath.WalkDir(folder, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) && path == folder {
+				return fs.SkipDir
+			}
+
+			return err
+		}
+
+		// Skips the temporary files of writeFile, the other hidden files being keys like .hidden or a/.b.
+		if entry.IsDir() || strings.HasSuffix(entry.Name(), temporaryFileSuffix) {
+			return nil
+		}
+
+		key, err := filepath.Rel(folder, path)
+		if err != nil {
+			return fmt.Errorf("failed to read key of %q: %w", path, err)
+		}
+
+		keys = append(keys, filepath.ToSlash(key))
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %q: %w", folder, err)
+	}
+
+	return keys, nil
+}
+
+// readValues reads the values of a params or tags folder by key.
+func readValues(folder string) (map[string]string, error) {
+	keys, err := listKeys(folder)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string, len(keys))
+
+	for _, key := range keys {
+		value, _, err := readValue(folder, key)
+		if err != nil {
+			return nil, err
+		}
+
+		values[key] = value
+	}
+
+	return values, nil
+}
+
+// readValue reads the value of key in a params or tags folder, ok being false if there is none.
+func readValue(folder, key string) (string, bool, error) {
+	content, err := os.ReadFile(filepath.Join(folder, filepath.FromSlash(key)))
+
+	switch {
+	case err == nil:
+		return string(content), true, nil
+	case errors.Is(err, fs.ErrNotExist):
+		return "", false, nil
+	default:
+		return "", false, fmt.Errorf("failed to read %q in %q: %w", key, folder, err)
+	}
+}
+
+func writeValue(folder, key, value string) error {
+	return writeFile(filepath.Join(folder, filepath.FromSlash(key)), []byte(value))
+}
@@ -0,0 +1,202 @@
+package file_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go/pkg/config"
+	"github.com/mlflow/mlflow-go/pkg/entities"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store/file"
+)
+
+func newTestStore(t *testing.T) (*file.TrackingFileStore, string) {
+	t.Helper()
+
+	root := filepath.Join(t.TempDir(), "mlruns")
+
+	store, err := file.NewTrackingFileStore(context.Background(), &config.Config{
+		TrackingStoreURI:    "file://" + root,
+		DefaultArtifactRoot: root,
+	})
+	require.NoError(t, err)
+
+	return store, root
+}
+
+func TestNewTrackingFileStoreCreatesDefaultExperiment(t *testing.T) {
+	t.Parallel()
+
+	store, root := newTestStore(t)
+
+	experiment, err := store.GetExperiment(context.Background(), file.DefaultExperimentID)
+	require.Nil(t, err)
+	assert.Equal(t, "Default", experiment.Name)
+	assert.FileExists(t, filepath.Join(root, file.DefaultExperimentID, file.MetaDataFileName))
+	assert.DirExists(t, filepath.Join(root, file.TrashFolderName))
+}
+
+//nolint:funlen
+func TestRunsRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store, root := newTestStore(t)
+
+	experimentID, err := store.CreateExperiment(ctx, "round-trip", "", nil)
+	require.Nil(t, err)
+
+	runIDs := make([]string, 0, 3)
+
+	for i := range 3 {
+		run, err := store.CreateRun(ctx, experimentID, "user", int64(1000+i), nil, "")
+		require.Nil(t, err)
+
+		value := "adam"
+		if i == 0 {
+			value = "sgd"
+		}
+
+		require.Nil(t, store.LogBatch(
+			ctx,
+			run.Info.RunID,
+			[]*entities.Metric{
+				{Key: "loss", Value: float64(i), Timestamp: 1, Step: 0},
+				{Key: "loss", Value: float64(i) / 2, Timestamp: 2, Step: 1},
+			},
+			[]*entities.Param{{Key: "optimizer", Value: &value}},
+			[]*entities.RunTag{{Key: "team", Value: "a"}},
+		))
+
+		runIDs = append(runIDs, run.Info.RunID)
+	}
+
+	// The layout is the one of the MLflow FileStore.
+	assert.FileExists(t, filepath.Join(root, experimentID, runIDs[0], file.MetricsFolderName, "loss"))
+	assert.FileExists(t, filepath.Join(root, experimentID, runIDs[0], file.ParamsFolderName, "optimizer"))
+
+	runs, _, err := store.SearchRuns(
+		ctx, []string{experimentID}, "params.optimizer = 'adam' and metrics.loss > 0",
+		protos.ViewType_ACTIVE_ONLY, 10, []string{"metrics.loss DESC"}, "",
+	)
+	require.Nil(t, err)
+	require.Len(t, runs, 2)
+	assert.Equal(t, runIDs[2], runs[0].Info.RunID)
+	assert.Equal(t, runIDs[1], runs[1].Info.RunID)
+
+	runs, token, err := store.SearchRuns(
+		ctx, []string{experimentID}, "", protos.ViewType_ACTIVE_ONLY, 2, nil, "",
+	)
+	require.Nil(t, err)
+	require.Len(t, runs, 2)
+	assert.Equal(t, runIDs[2], runs[0].Info.RunID)
+	assert.NotEmpty(t, token)
+
+	runs, token, err = store.SearchRuns(
+		ctx, []string{experimentID}, "", protos.ViewType_ACTIVE_ONLY, 2, nil, token,
+	)
+	require.Nil(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, runIDs[0], runs[0].Info.RunID)
+	assert.Empty(t, token)
+
+	history, _, err := store.GetMetricHistory(ctx, runIDs[1], "loss", "", 0)
+	require.Nil(t, err)
+	assert.Equal(t, []*entities.Metric{
+		{Key: "loss", Value: 1, Timestamp: 1, Step: 0},
+		{Key: "loss", Value: 0.5, Timestamp: 2, Step: 1},
+	}, history)
+
+	changed := "sgd"
+	err = store.LogParam(ctx, runIDs[1], &entities.Param{Key: "optimizer", Value: &changed})
+	require.NotNil(t, err)
+	assert.Equal(t, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode(err.Code))
+
+	require.Nil(t, store.DeleteRun(ctx, runIDs[0]))
+
+	runs, _, err = store.SearchRuns(
+		ctx, []string{experimentID}, "", protos.ViewType_DELETED_ONLY, 10, nil, "",
+	)
+	require.Nil(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, runIDs[0], runs[0].Info.RunID)
+
+	require.Nil(t, store.RestoreRun(ctx, runIDs[0]))
+
+	run, err := store.GetRun(ctx, runIDs[0])
+	require.Nil(t, err)
+	assert.Equal(t, "active", run.Info.LifecycleStage)
+	assert.Equal(t, experimentID, run.Info.ExperimentID)
+}
+
+func TestDeleteAndRestoreExperiment(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store, root := newTestStore(t)
+
+	experimentID, err := store.CreateExperiment(ctx, "to-delete", "", nil)
+	require.Nil(t, err)
+
+	require.Nil(t, store.DeleteExperiment(ctx, experimentID))
+	assert.DirExists(t, filepath.Join(root, file.TrashFolderName, experimentID))
+
+	_, err = store.CreateRun(ctx, experimentID, "user", 0, nil, "")
+	require.NotNil(t, err)
+
+	experiments, _, err := store.SearchExperiments(ctx, protos.ViewType_DELETED_ONLY, 10, "", nil, "")
+	require.Nil(t, err)
+	require.Len(t, experiments, 1)
+	assert.Equal(t, experimentID, experiments[0].ExperimentID)
+
+	require.Nil(t, store.RestoreExperiment(ctx, experimentID))
+
+	_, statErr := os.Stat(filepath.Join(root, file.TrashFolderName, experimentID))
+	assert.True(t, os.IsNotExist(statErr))
+
+	experiment, err := store.GetExperimentByName(ctx, "to-delete")
+	require.Nil(t, err)
+	assert.Equal(t, "active", experiment.LifecycleStage)
+
+	err = store.DeleteExperiment(ctx, file.DefaultExperimentID)
+	require.NotNil(t, err)
+
+	_, err = store.CreateExperiment(ctx, "to-delete", "", nil)
+	require.NotNil(t, err)
+	assert.Equal(t, protos.ErrorCode_RESOURCE_ALREADY_EXISTS, protos.ErrorCode(err.Code))
+}
+
+func TestHiddenKeys(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store, root := newTestStore(t)
+
+	run, err := store.CreateRun(ctx, file.DefaultExperimentID, "user", 0, nil, "")
+	require.Nil(t, err)
+
+	value := "value"
+	require.Nil(t, store.LogBatch(
+		ctx,
+		run.Info.RunID,
+		nil,
+		[]*entities.Param{{Key: ".hidden", Value: &value}, {Key: "a/.b", Value: &value}},
+		nil,
+	))
+
+	// A temporary file left by an interrupted write isn't a key.
+	paramsFolder := filepath.Join(root, file.DefaultExperimentID, run.Info.RunID, file.ParamsFolderName)
+	require.NoError(t, os.WriteFile(filepath.Join(paramsFolder, ".c.123.mlflow-go-tmp"), []byte(value), 0o600))
+
+	run, err = store.GetRun(ctx, run.Info.RunID)
+	require.Nil(t, err)
+	assert.ElementsMatch(t, []*entities.Param{
+		{Key: ".hidden", Value: &value},
+		{Key: "a/.b", Value: &value},
+	}, run.Data.Params)
+}
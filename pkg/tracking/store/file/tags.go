@@ -0,0 +1,112 @@
+package file
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/entities"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/utils"
+)
+
+func (s *TrackingFileStore) GetRunTag(
+	_ context.Context, runID, tagKey string,
+) (*entities.RunTag, *contract.Error) {
+	if err := checkName("tag key", tagKey); err != nil {
+		return nil, err
+	}
+
+	directory, contractError := s.runDirectory(runID)
+	if contractError != nil {
+		return nil, contractError
+	}
+
+	value, ok, err := readValue(filepath.Join(directory, TagsFolderName), tagKey)
+	if err != nil {
+		return nil, newInternalError(fmt.Sprintf("failed to get run tag for run id %q", runID), err)
+	}
+
+	if !ok {
+		return nil, nil
+	}
+
+	return &entities.RunTag{Key: tagKey, Value: value}, nil
+}
+
+// setTags writes the tags of a run, the name and user of the run being updated along with their tags.
+func setTags(directory string, meta *runMeta, tags []*entities.RunTag) error {
+	updateMeta := false
+
+	for _, tag := range tags {
+		switch tag.Key {
+		case utils.TagRunName:
+			meta.RunName = tag.Value
+			updateMeta = true
+		case utils.TagUser:
+			meta.UserID = tag.Value
+			updateMeta = true
+		}
+
+		if err := writeValue(filepath.Join(directory, TagsFolderName), tag.Key, tag.Value); err != nil {
+			return err
+		}
+	}
+
+	if updateMeta {
+		return writeRunMeta(directory, meta)
+	}
+
+	return nil
+}
+
+func (s *TrackingFileStore) SetTag(_ context.Context, runID, key, value string) *contract.Error {
+	if err := checkName("tag key", key); err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	directory, meta, contractError := s.getActiveRun(runID)
+	if contractError != nil {
+		return contractError
+	}
+
+	if err := setTags(directory, meta, []*entities.RunTag{{Key: key, Value: value}}); err != nil {
+		return newInternalError(fmt.Sprintf("Set tag failed for run_id %q", runID), err)
+	}
+
+	return nil
+}
+
+func (s *TrackingFileStore) DeleteTag(_ context.Context, runID, key string) *contract.Error {
+	if err := checkName("tag key", key); err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	directory, _, contractError := s.getActiveRun(runID)
+	if contractError != nil {
+		return contractError
+	}
+
+	if err := os.Remove(filepath.Join(directory, TagsFolderName, filepath.FromSlash(key))); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return contract.NewError(
+				protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+				fmt.Sprintf("No tag with name: %s in run with id %s", key, runID),
+			)
+		}
+
+		return newInternalError(fmt.Sprintf("delete tag failed for %q", runID), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,430 @@
+package file
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/entities"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/tracking/service/query"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store/search"
+	"github.com/mlflow/mlflow-go/pkg/utils"
+)
+
+const artifactLocationTraceTagKey = "mlflow.artifactLocation"
+
+// traceInfoMeta is the trace_info.yaml of a trace, its tags and request metadata being in folders.
+type traceInfoMeta struct {
+	ExecutionTimeMS *int64 `yaml:"execution_time_ms"`
+	ExperimentID    string `yaml:"experiment_id"`
+	RequestID       string `yaml:"request_id"`
+	Status          string `yaml:"status"`
+	TimestampMS     int64  `yaml:"timestamp_ms"`
+}
+
+func readTraceInfo(directory string) (*entities.TraceInfo, error) {
+	var meta traceInfoMeta
+	if err := readYAML(filepath.Join(directory, TraceInfoFileName), &meta); err != nil {
+		return nil, err
+	}
+
+	tagValues, err := readValues(filepath.Join(directory, TagsFolderName))
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]*entities.TraceTag, 0, len(tagValues))
+	for key, value := range tagValues {
+		tags = append(tags, &entities.TraceTag{Key: key, Value: value, RequestID: meta.RequestID})
+	}
+
+	slices.SortFunc(tags, func(a, b *entities.TraceTag) int { return strings.Compare(a.Key, b.Key) })
+
+	metadataValues, err := readValues(filepath.Join(directory, TraceRequestMetadataFolderName))
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := make([]*entities.TraceRequestMetadata, 0, len(metadataValues))
+	for key, value := range metadataValues {
+		metadata = append(metadata, &entities.TraceRequestMetadata{Key: key, Value: value, RequestID: meta.RequestID})
+	}
+
+	slices.SortFunc(metadata, func(a, b *entities.TraceRequestMetadata) int { return strings.Compare(a.Key, b.Key) })
+
+	return &entities.TraceInfo{
+		RequestID:            meta.RequestID,
+		Status:               meta.Status,
+		ExperimentID:         meta.ExperimentID,
+		TimestampMS:          meta.TimestampMS,
+		ExecutionTimeMS:      meta.ExecutionTimeMS,
+		Tags:                 tags,
+		TraceRequestMetadata: metadata,
+	}, nil
+}
+
+func writeTraceInfo(
+	directory string,
+	traceInfo *entities.TraceInfo,
+	metadata []*entities.TraceRequestMetadata,
+	tags []*entities.TraceTag,
+) error {
+	if err := writeYAML(filepath.Join(directory, TraceInfoFileName), traceInfoMeta{
+		ExecutionTimeMS: traceInfo.ExecutionTimeMS,
+		ExperimentID:    traceInfo.ExperimentID,
+		RequestID:       traceInfo.RequestID,
+		Status:          traceInfo.Status,
+		TimestampMS:     traceInfo.TimestampMS,
+	}); err != nil {
+		return err
+	}
+
+	for _, tag := range tags {
+		if err := writeValue(filepath.Join(directory, TagsFolderName), tag.Key, tag.Value); err != nil {
+			return err
+		}
+	}
+
+	for _, m := range metadata {
+		if err := writeValue(filepath.Join(directory, TraceRequestMetadataFolderName), m.Key, m.Value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// traceDirectory returns the directory of a trace, which can be in any experiment.
+func (s *TrackingFileStore) traceDirectory(requestID string) (string, *contract.Error) {
+	if err := checkName("request id", requestID); err != nil {
+		return "", err
+	}
+
+	directories, err := s.experimentDirectories()
+	if err != nil {
+		return "", newInternalError("error getting trace info", err)
+	}
+
+	for _, directory := range directories {
+		traceDirectory := filepath.Join(directory, TracesFolderName, requestID)
+
+		ok, err := exists(filepath.Join(traceDirectory, TraceInfoFileName))
+		if err != nil {
+			return "", newInternalError("error getting trace info", err)
+		}
+
+		if ok {
+			return traceDirectory, nil
+		}
+	}
+
+	return "", contract.NewError(
+		protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+		fmt.Sprintf("Trace with request_id '%s' not found.", requestID),
+	)
+}
+
+func (s *TrackingFileStore) SetTrace(
+	_ context.Context,
+	experimentID string,
+	timestampMS int64,
+	metadata []*entities.TraceRequestMetadata,
+	tags []*entities.TraceTag,
+) (*entities.TraceInfo, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	experiment, experimentDirectory, contractError := s.getExperiment(experimentID)
+	if contractError != nil {
+		return nil, contractError
+	}
+
+	traceInfo := &entities.TraceInfo{
+		RequestID:    utils.NewUUID(),
+		Status:       protos.TraceStatus_IN_PROGRESS.String(),
+		ExperimentID: experimentID,
+		TimestampMS:  timestampMS,
+	}
+
+	traceTags := make([]*entities.TraceTag, 0, len(tags)+1)
+
+	for _, tag := range tags {
+		// Like in the SQL store, the request ID generated by Python tests is passed as a tag.
+		if tag.Key == "request_id" {
+			traceInfo.RequestID = tag.Value
+		} else {
+			traceTags = append(traceTags, tag)
+		}
+	}
+
+	if err := checkName("request id", traceInfo.RequestID); err != nil {
+		return nil, err
+	}
+
+	artifactLocation, err := utils.AppendToURIPath(
+		experiment.ArtifactLocation, TracesFolderName, traceInfo.RequestID, ArtifactsFolderName,
+	)
+	if err != nil {
+		return nil, newInternalError(fmt.Sprintf("failed to create trace for experiment_id %q", experimentID), err)
+	}
+
+	traceTags = append(traceTags, &entities.TraceTag{Key: artifactLocationTraceTagKey, Value: artifactLocation})
+
+	directory := filepath.Join(experimentDirectory, TracesFolderName, traceInfo.RequestID)
+	if err := writeTraceInfo(directory, traceInfo, metadata, traceTags); err != nil {
+		return nil, newInternalError(fmt.Sprintf("failed to create trace for experiment_id %q", experimentID), err)
+	}
+
+	traceInfo, err = readTraceInfo(directory)
+	if err != nil {
+		return nil, newInternalError(fmt.Sprintf("failed to create trace for experiment_id %q", experimentID), err)
+	}
+
+	return traceInfo, nil
+}
+
+func (s *TrackingFileStore) EndTrace(
+	_ context.Context,
+	requestID string,
+	timestampMS int64,
+	status string,
+	metadata []*entities.TraceRequestMetadata,
+	tags []*entities.TraceTag,
+) (*entities.TraceInfo, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	directory, contractError := s.traceDirectory(requestID)
+	if contractError != nil {
+		return nil, contractError
+	}
+
+	traceInfo, err := readTraceInfo(directory)
+	if err != nil {
+		return nil, newInternalError(fmt.Sprintf("failed to update trace with request_id '%s'", requestID), err)
+	}
+
+	traceInfo.Status = status
+	traceInfo.ExecutionTimeMS = utils.PtrTo(timestampMS - traceInfo.TimestampMS)
+
+	if err := writeTraceInfo(directory, traceInfo, metadata, tags); err != nil {
+		return nil, newInternalError(fmt.Sprintf("failed to update trace with request_id '%s'", requestID), err)
+	}
+
+	traceInfo, err = readTraceInfo(directory)
+	if err != nil {
+		return nil, newInternalError(fmt.Sprintf("failed to update trace with request_id '%s'", requestID), err)
+	}
+
+	return traceInfo, nil
+}
+
+func (s *TrackingFileStore) GetTraceInfo(_ context.Context, requestID string) (*entities.TraceInfo, *contract.Error) {
+	directory, contractError := s.traceDirectory(requestID)
+	if contractError != nil {
+		return nil, contractError
+	}
+
+	traceInfo, err := readTraceInfo(directory)
+	if err != nil {
+		return nil, newInternalError("error getting trace info", err)
+	}
+
+	return traceInfo, nil
+}
+
+func (s *TrackingFileStore) SetTraceTag(_ context.Context, requestID, key, value string) error {
+	if err := checkName("tag key", key); err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	directory, contractError := s.traceDirectory(requestID)
+	if contractError != nil {
+		return contractError
+	}
+
+	return writeValue(filepath.Join(directory, TagsFolderName), key, value)
+}
+
+func (s *TrackingFileStore) GetTraceTag(
+	_ context.Context, requestID, key string,
+) (*entities.TraceTag, *contract.Error) {
+	notFoundError := contract.NewError(
+		protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+		fmt.Sprintf("No trace tag with key '%s' for trace with request_id '%s'", key, requestID),
+	)
+
+	if checkName("tag key", key) != nil {
+		return nil, notFoundError
+	}
+
+	directory, contractError := s.traceDirectory(requestID)
+	if contractError != nil {
+		if protos.ErrorCode(contractError.Code) == protos.ErrorCode_RESOURCE_DOES_NOT_EXIST {
+			return nil, notFoundError
+		}
+
+		return nil, contractError
+	}
+
+	value, ok, err := readValue(filepath.Join(directory, TagsFolderName), key)
+	if err != nil {
+		return nil, newInternalError("error getting trace tag", err)
+	}
+
+	if !ok {
+		return nil, notFoundError
+	}
+
+	return &entities.TraceTag{Key: key, Value: value, RequestID: requestID}, nil
+}
+
+func (s *TrackingFileStore) DeleteTraceTag(_ context.Context, tag *entities.TraceTag) *contract.Error {
+	if err := checkName("tag key", tag.Key); err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	directory, contractError := s.traceDirectory(tag.RequestID)
+	if contractError != nil {
+		return contractError
+	}
+
+	err := os.Remove(filepath.Join(directory, TagsFolderName, filepath.FromSlash(tag.Key)))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return newInternalError("error deleting trace tag", err)
+	}
+
+	return nil
+}
+
+// readExperimentTraces returns the traces of an experiment, none if it doesn't exist.
+func (s *TrackingFileStore) readExperimentTraces(experimentID string) ([]*entities.TraceInfo, error) {
+	if checkName("experiment id", experimentID) != nil {
+		return nil, nil
+	}
+
+	directory, err := s.experimentDirectory(experimentID)
+	if err != nil || directory == "" {
+		return nil, err
+	}
+
+	requestIDs, err := listDirectories(filepath.Join(directory, TracesFolderName))
+	if err != nil {
+		return nil, err
+	}
+
+	traces := make([]*entities.TraceInfo, 0, len(requestIDs))
+
+	for _, requestID := range requestIDs {
+		traceInfo, err := readTraceInfo(filepath.Join(directory, TracesFolderName, requestID))
+		if err != nil {
+			return nil, err
+		}
+
+		traces = append(traces, traceInfo)
+	}
+
+	return traces, nil
+}
+
+func (s *TrackingFileStore) SearchTraces(
+	ctx context.Context,
+	experimentIDs []string,
+	filter string,
+	maxResults int,
+	orderBy []string,
+	pageToken string,
+) ([]*entities.TraceInfo, string, *contract.Error) {
+	offset, contractError := search.ParsePageToken(pageToken)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	filterConditions, err := query.ParseTraceFilter(filter)
+	if err != nil {
+		return nil, "", query.NewFilterError(err)
+	}
+
+	utils.GetLoggerFromContext(ctx).Debugf("Filter conditions: %v", filterConditions)
+
+	orderKeys, contractError := search.ParseTracesOrderBy(orderBy)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	traces := make([]*entities.TraceInfo, 0)
+
+	for _, experimentID := range experimentIDs {
+		experimentTraces, err := s.readExperimentTraces(experimentID)
+		if err != nil {
+			return nil, "", newInternalError("Failed to query search traces", err)
+		}
+
+		for _, traceInfo := range experimentTraces {
+			if search.MatchesAll(filterConditions, search.TraceValues(traceInfo)) {
+				traces = append(traces, traceInfo)
+			}
+		}
+	}
+
+	search.Sort(traces, orderKeys, search.TraceValues)
+
+	return search.Paginate(traces, offset, maxResults)
+}
+
+func (s *TrackingFileStore) DeleteTraces(
+	_ context.Context,
+	experimentID string,
+	maxTimestampMillis int64,
+	maxTraces int32,
+	requestIDs []string,
+) (int32, *contract.Error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	traces, err := s.readExperimentTraces(experimentID)
+	if err != nil {
+		return 0, newInternalError("failed to delete traces", err)
+	}
+
+	traces = slices.DeleteFunc(traces, func(traceInfo *entities.TraceInfo) bool {
+		return (maxTimestampMillis != 0 && traceInfo.TimestampMS > maxTimestampMillis) ||
+			(len(requestIDs) > 0 && !slices.Contains(requestIDs, traceInfo.RequestID))
+	})
+
+	if maxTraces != 0 {
+		slices.SortStableFunc(traces, func(a, b *entities.TraceInfo) int {
+			return cmp.Compare(a.TimestampMS, b.TimestampMS)
+		})
+
+		traces = traces[:min(len(traces), int(maxTraces))]
+	}
+
+	directory, err := s.experimentDirectory(experimentID)
+	if err != nil {
+		return 0, newInternalError("failed to delete traces", err)
+	}
+
+	for _, traceInfo := range traces {
+		if err := os.RemoveAll(filepath.Join(directory, TracesFolderName, traceInfo.RequestID)); err != nil {
+			return 0, newInternalError("failed to delete traces", err)
+		}
+	}
+
+	//nolint:gosec
+	return int32(len(traces)), nil
+}
@@ -0,0 +1,313 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/entities"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/tracking/service/query"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store/search"
+	"github.com/mlflow/mlflow-go/pkg/utils"
+)
+
+type experiment struct {
+	id               string
+	name             string
+	artifactLocation string
+	lifecycleStage   string
+	creationTime     int64
+	lastUpdateTime   int64
+	tags             map[string]string
+	// datasets are the datasets logged to the runs of the experiment, by name and digest.
+	datasets map[datasetKey]*entities.Dataset
+}
+
+func (e *experiment) toEntity() *entities.Experiment {
+	tags := make([]*entities.ExperimentTag, 0, len(e.tags))
+	for _, key := range slices.Sorted(maps.Keys(e.tags)) {
+		tags = append(tags, &entities.ExperimentTag{Key: key, Value: e.tags[key]})
+	}
+
+	return &entities.Experiment{
+		Name:             e.name,
+		ExperimentID:     e.id,
+		ArtifactLocation: e.artifactLocation,
+		LifecycleStage:   e.lifecycleStage,
+		LastUpdateTime:   e.lastUpdateTime,
+		CreationTime:     e.creationTime,
+		Tags:             tags,
+	}
+}
+
+func checkExperimentIsActive(experiment *experiment) *contract.Error {
+	if experiment.lifecycleStage != lifecycleStageActive {
+		return contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf(
+				"The experiment %q must be in the 'active' state.\n"+
+					"Current state is %q.",
+				experiment.id,
+				experiment.lifecycleStage,
+			),
+		)
+	}
+
+	return nil
+}
+
+// getExperiment returns an experiment, its ID being an integer like in the experiments table.
+func (s *TrackingMemoryStore) getExperiment(id string) (*experiment, *contract.Error) {
+	experimentID, err := strconv.ParseInt(id, 10, 32)
+	if err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("failed to convert experiment id %q to int", id),
+			err,
+		)
+	}
+
+	experiment, ok := s.experiments[strconv.FormatInt(experimentID, 10)]
+	if !ok {
+		return nil, contract.NewError(
+			protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+			fmt.Sprintf("No Experiment with id=%d exists", experimentID),
+		)
+	}
+
+	return experiment, nil
+}
+
+func (s *TrackingMemoryStore) getExperimentByName(name string) *experiment {
+	for _, experiment := range s.experiments {
+		if experiment.name == name {
+			return experiment
+		}
+	}
+
+	return nil
+}
+
+// createExperiment adds a new experiment, its artifact location defaulting to one in the default artifact root.
+func (s *TrackingMemoryStore) createExperiment(
+	id, name, artifactLocation string, tags []*entities.ExperimentTag,
+) (*experiment, error) {
+	if artifactLocation == "" {
+		location, err := utils.AppendToURIPath(s.config.DefaultArtifactRoot, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to join artifact location: %w", err)
+		}
+
+		artifactLocation = location
+	}
+
+	creationTime := now()
+	experiment := &experiment{
+		id:               id,
+		name:             name,
+		artifactLocation: artifactLocation,
+		lifecycleStage:   lifecycleStageActive,
+		creationTime:     creationTime,
+		lastUpdateTime:   creationTime,
+		tags:             make(map[string]string, len(tags)),
+		datasets:         make(map[datasetKey]*entities.Dataset),
+	}
+
+	for _, tag := range tags {
+		experiment.tags[tag.Key] = tag.Value
+	}
+
+	s.experiments[id] = experiment
+
+	return experiment, nil
+}
+
+func (s *TrackingMemoryStore) GetExperiment(_ context.Context, id string) (*entities.Experiment, *contract.Error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	experiment, err := s.getExperiment(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return experiment.toEntity(), nil
+}
+
+func (s *TrackingMemoryStore) GetExperimentByName(
+	_ context.Context, name string,
+) (*entities.Experiment, *contract.Error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	experiment := s.getExperimentByName(name)
+	if experiment == nil {
+		return nil, contract.NewError(
+			protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+			"Could not find experiment with name "+name,
+		)
+	}
+
+	return experiment.toEntity(), nil
+}
+
+func (s *TrackingMemoryStore) CreateExperiment(
+	_ context.Context,
+	name string,
+	artifactLocation string,
+	tags []*entities.ExperimentTag,
+) (string, *contract.Error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	// Like the unique constraint of the experiments table, deleted experiments keep their name.
+	if s.getExperimentByName(name) != nil {
+		return "", contract.NewError(
+			protos.ErrorCode_RESOURCE_ALREADY_EXISTS,
+			fmt.Sprintf("Experiment(name=%s) already exists.", name),
+		)
+	}
+
+	experimentID := strconv.Itoa(s.nextExperimentID)
+
+	if _, err := s.createExperiment(experimentID, name, artifactLocation, tags); err != nil {
+		return "", contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to create experiment", err)
+	}
+
+	s.nextExperimentID++
+
+	return experimentID, nil
+}
+
+func (s *TrackingMemoryStore) RenameExperiment(_ context.Context, experimentID, name string) *contract.Error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	experiment, contractError := s.getExperiment(experimentID)
+	if contractError != nil {
+		return contractError
+	}
+
+	if existing := s.getExperimentByName(name); existing != nil && existing != experiment {
+		return contract.NewError(
+			protos.ErrorCode_RESOURCE_ALREADY_EXISTS,
+			fmt.Sprintf("Experiment(name=%s) already exists.", name),
+		)
+	}
+
+	experiment.name = name
+	experiment.lastUpdateTime = now()
+
+	return nil
+}
+
+// setExperimentLifecycleStage deletes or restores an experiment along with its runs,
+// like DeleteExperiment and RestoreExperiment of the SQL store, from being the required stage if any.
+func (s *TrackingMemoryStore) setExperimentLifecycleStage(id, from, to string) *contract.Error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	experiment, contractError := s.getExperiment(id)
+	if contractError != nil {
+		return contractError
+	}
+
+	if from != "" && experiment.lifecycleStage != from {
+		return contract.NewError(
+			protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+			"No Experiment with id="+experiment.id+" exists",
+		)
+	}
+
+	updateTime := now()
+	experiment.lifecycleStage = to
+	experiment.lastUpdateTime = updateTime
+
+	var deletedTime *int64
+	if to == lifecycleStageDeleted {
+		deletedTime = &updateTime
+	}
+
+	for _, run := range s.runs {
+		if run.experimentID == experiment.id {
+			run.lifecycleStage = to
+			run.deletedTime = deletedTime
+		}
+	}
+
+	return nil
+}
+
+func (s *TrackingMemoryStore) DeleteExperiment(_ context.Context, id string) *contract.Error {
+	return s.setExperimentLifecycleStage(id, "", lifecycleStageDeleted)
+}
+
+func (s *TrackingMemoryStore) RestoreExperiment(_ context.Context, id string) *contract.Error {
+	return s.setExperimentLifecycleStage(id, lifecycleStageDeleted, lifecycleStageActive)
+}
+
+func (s *TrackingMemoryStore) SetExperimentTag(_ context.Context, experimentID, key, value string) *contract.Error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	experiment, contractError := s.getExperiment(experimentID)
+	if contractError != nil {
+		return contractError
+	}
+
+	if err := checkExperimentIsActive(experiment); err != nil {
+		return err
+	}
+
+	experiment.tags[key] = value
+
+	return nil
+}
+
+func (s *TrackingMemoryStore) SearchExperiments(
+	ctx context.Context,
+	experimentViewType protos.ViewType,
+	maxResults int64,
+	filter string,
+	orderBy []string,
+	pageToken string,
+) ([]*entities.Experiment, string, *contract.Error) {
+	offset, contractError := search.ParsePageToken(pageToken)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	filterExpr, err := query.ParseExperimentFilter(filter)
+	if err != nil {
+		return nil, "", query.NewFilterError(err)
+	}
+
+	utils.GetLoggerFromContext(ctx).Debugf("Filter conditions: %v", filterExpr)
+
+	orderKeys, contractError := search.ParseExperimentsOrderBy(orderBy)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	experiments := make([]*entities.Experiment, 0, len(s.experiments))
+
+	for _, experiment := range s.experiments {
+		entity := experiment.toEntity()
+
+		if search.MatchesViewType(experimentViewType, entity.LifecycleStage) &&
+			search.Matches(filterExpr, search.ExperimentValues(entity)) {
+			experiments = append(experiments, entity)
+		}
+	}
+
+	search.Sort(experiments, orderKeys, search.ExperimentValues)
+
+	return search.Paginate(experiments, offset, int(maxResults))
+}
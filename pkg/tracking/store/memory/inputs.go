@@ -0,0 +1,77 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/entities"
+)
+
+// datasetKey identifies a dataset in its experiment, like the unique constraint of the datasets table.
+type datasetKey struct {
+	name   string
+	digest string
+}
+
+// datasetInputs returns the dataset inputs of a run, ordered by dataset name and digest.
+func (s *TrackingMemoryStore) datasetInputs(run *run) []*entities.DatasetInput {
+	experiment := s.experiments[run.experimentID]
+	keys := slices.SortedFunc(maps.Keys(run.inputs), func(a, b datasetKey) int {
+		return cmp.Or(cmp.Compare(a.name, b.name), cmp.Compare(a.digest, b.digest))
+	})
+
+	inputs := make([]*entities.DatasetInput, 0, len(keys))
+
+	for _, key := range keys {
+		tags := make([]*entities.InputTag, 0, len(run.inputs[key]))
+		for _, tagKey := range slices.Sorted(maps.Keys(run.inputs[key])) {
+			tags = append(tags, &entities.InputTag{Key: tagKey, Value: run.inputs[key][tagKey]})
+		}
+
+		inputs = append(inputs, &entities.DatasetInput{
+			Tags:    tags,
+			Dataset: clonePtr(experiment.datasets[key]),
+		})
+	}
+
+	return inputs
+}
+
+func (s *TrackingMemoryStore) LogInputs(
+	_ context.Context, runID string, datasets []*entities.DatasetInput,
+) *contract.Error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	run, contractError := s.getActiveRun(runID)
+	if contractError != nil {
+		return contractError
+	}
+
+	experiment := s.experiments[run.experimentID]
+
+	for _, datasetInput := range datasets {
+		key := datasetKey{name: datasetInput.Dataset.Name, digest: datasetInput.Dataset.Digest}
+
+		if _, ok := experiment.datasets[key]; !ok {
+			experiment.datasets[key] = clonePtr(datasetInput.Dataset)
+		}
+
+		// Like in the SQL store, logging a dataset input again doesn't update its tags.
+		if _, ok := run.inputs[key]; ok {
+			continue
+		}
+
+		tags := make(map[string]string, len(datasetInput.Tags))
+		for _, tag := range datasetInput.Tags {
+			tags[tag.Key] = tag.Value
+		}
+
+		run.inputs[key] = tags
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"slices"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/entities"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store/search"
+)
+
+// Upper bound of metrics returned per run by GetMetricHistoryBulkInterval.
+const maxResultsGetMetricHistoryBulkInterval = 25000
+
+func compareMetrics(a, b *entities.Metric) int {
+	return cmp.Or(cmp.Compare(a.Step, b.Step), cmp.Compare(a.Timestamp, b.Timestamp), cmp.Compare(a.Value, b.Value))
+}
+
+// normalizeMetric stores the special values like the metrics table does, NaN as 0 and infinities as the max floats.
+func normalizeMetric(metric *entities.Metric) *entities.Metric {
+	normalized := *metric
+
+	switch {
+	case metric.IsNaN || math.IsNaN(metric.Value):
+		normalized.Value = 0
+		normalized.IsNaN = true
+	case math.IsInf(metric.Value, 1):
+		normalized.Value = math.MaxFloat64
+	case math.IsInf(metric.Value, -1):
+		normalized.Value = -math.MaxFloat64
+	}
+
+	return &normalized
+}
+
+// logMetrics adds metrics to the histories of a run, the values already logged being ignored.
+func logMetrics(run *run, metrics []*entities.Metric) {
+	for _, metric := range metrics {
+		metric = normalizeMetric(metric)
+
+		if slices.ContainsFunc(run.metrics[metric.Key], func(logged *entities.Metric) bool {
+			return *logged == *metric
+		}) {
+			continue
+		}
+
+		run.metrics[metric.Key] = append(run.metrics[metric.Key], metric)
+	}
+}
+
+func (s *TrackingMemoryStore) LogMetric(_ context.Context, runID string, metric *entities.Metric) *contract.Error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	run, contractError := s.getActiveRun(runID)
+	if contractError != nil {
+		return contractError
+	}
+
+	logMetrics(run, []*entities.Metric{metric})
+
+	return nil
+}
+
+// metricHistory returns a copy of the history of a metric, ordered by step, timestamp and value.
+func metricHistory(run *run, metricKey string) []*entities.Metric {
+	history := make([]*entities.Metric, 0, len(run.metrics[metricKey]))
+	for _, metric := range run.metrics[metricKey] {
+		history = append(history, clonePtr(metric))
+	}
+
+	slices.SortStableFunc(history, compareMetrics)
+
+	return history
+}
+
+func (s *TrackingMemoryStore) GetMetricHistory(
+	_ context.Context, runID, metricKey, pageToken string, maxResults int,
+) ([]*entities.Metric, string, *contract.Error) {
+	offset, contractError := search.ParsePageToken(pageToken)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	run, contractError := s.getRun(runID)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	return search.Paginate(metricHistory(run, metricKey), offset, maxResults)
+}
+
+func (s *TrackingMemoryStore) GetMetricHistoryBulkInterval(
+	_ context.Context,
+	runIDs []string,
+	metricKey string,
+	startStep, endStep *int64,
+	maxResults int,
+) ([]*entities.MetricWithRunID, *contract.Error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	histories := make([][]*entities.Metric, 0, len(runIDs))
+	allSteps := make([]int64, 0)
+
+	for _, runID := range runIDs {
+		run, contractError := s.getRun(runID)
+		if contractError != nil {
+			return nil, contractError
+		}
+
+		history := metricHistory(run, metricKey)
+		histories = append(histories, history)
+
+		for _, metric := range history {
+			allSteps = append(allSteps, metric.Step)
+		}
+	}
+
+	slices.Sort(allSteps)
+	allSteps = slices.Compact(allSteps)
+
+	var start, end int64
+	if startStep != nil && endStep != nil {
+		start, end = *startStep, *endStep
+	} else if len(allSteps) > 0 {
+		end = allSteps[len(allSteps)-1]
+	}
+
+	// The min and max step of every run are always part of the result.
+	sampled := store.SampleSteps(start, end, maxResults, allSteps)
+
+	for _, history := range histories {
+		if len(history) == 0 {
+			continue
+		}
+
+		for _, step := range []int64{history[0].Step, history[len(history)-1].Step} {
+			if start <= step && step <= end {
+				sampled[step] = struct{}{}
+			}
+		}
+	}
+
+	metricsWithRunID := make([]*entities.MetricWithRunID, 0)
+
+	for index, history := range histories {
+		count := 0
+
+		for _, metric := range history {
+			if _, ok := sampled[metric.Step]; !ok || count == maxResultsGetMetricHistoryBulkInterval {
+				continue
+			}
+
+			count++
+
+			metricsWithRunID = append(metricsWithRunID, &entities.MetricWithRunID{
+				Metric: metric,
+				RunID:  runIDs[index],
+			})
+		}
+	}
+
+	return metricsWithRunID, nil
+}
@@ -0,0 +1,96 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/entities"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+)
+
+// checkParams returns the values of params, as params can be logged again with the same value but not changed.
+func checkParams(run *run, params []*entities.Param) (map[string]string, *contract.Error) {
+	values := make(map[string]string, len(params))
+
+	for _, param := range params {
+		var value string
+		if param.Value != nil {
+			value = *param.Value
+		}
+
+		oldValue, ok := values[param.Key]
+		if !ok {
+			oldValue, ok = run.params[param.Key]
+		}
+
+		if ok && oldValue != value {
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf(
+					"Changing param values is not allowed. "+
+						"Params with key=%q was already logged "+
+						"with value=%q for run ID=%q. "+
+						"Attempted logging new value %q",
+					param.Key,
+					oldValue,
+					run.id,
+					value,
+				),
+			)
+		}
+
+		values[param.Key] = value
+	}
+
+	return values, nil
+}
+
+func (s *TrackingMemoryStore) LogParam(_ context.Context, runID string, param *entities.Param) *contract.Error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	run, contractError := s.getActiveRun(runID)
+	if contractError != nil {
+		return contractError
+	}
+
+	params, contractError := checkParams(run, []*entities.Param{param})
+	if contractError != nil {
+		return contractError
+	}
+
+	for key, value := range params {
+		run.params[key] = value
+	}
+
+	return nil
+}
+
+// LogBatch checks the params of the batch before logging anything, like the transaction of the SQL store.
+func (s *TrackingMemoryStore) LogBatch(
+	_ context.Context, runID string, metrics []*entities.Metric, params []*entities.Param, tags []*entities.RunTag,
+) *contract.Error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	run, contractError := s.getActiveRun(runID)
+	if contractError != nil {
+		return contractError
+	}
+
+	paramValues, contractError := checkParams(run, params)
+	if contractError != nil {
+		return contractError
+	}
+
+	setTags(run, tags)
+
+	for key, value := range paramValues {
+		run.params[key] = value
+	}
+
+	logMetrics(run, metrics)
+
+	return nil
+}
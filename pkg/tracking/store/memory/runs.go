@@ -0,0 +1,339 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/entities"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/tracking/service/query"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store/search"
+	"github.com/mlflow/mlflow-go/pkg/utils"
+)
+
+const artifactsFolderName = "artifacts"
+
+type run struct {
+	id             string
+	name           string
+	experimentID   string
+	userID         string
+	status         string
+	startTime      int64
+	endTime        *int64
+	deletedTime    *int64
+	artifactURI    string
+	lifecycleStage string
+	// metrics are the histories of the metrics of the run, by key.
+	metrics map[string][]*entities.Metric
+	params  map[string]string
+	tags    map[string]string
+	// inputs are the tags of the dataset inputs of the run, by dataset.
+	inputs map[datasetKey]map[string]string
+}
+
+func (r *run) info() *entities.RunInfo {
+	return &entities.RunInfo{
+		RunID:          r.id,
+		RunUUID:        r.id,
+		RunName:        r.name,
+		ExperimentID:   r.experimentID,
+		UserID:         r.userID,
+		Status:         r.status,
+		StartTime:      r.startTime,
+		EndTime:        clonePtr(r.endTime),
+		ArtifactURI:    r.artifactURI,
+		LifecycleStage: r.lifecycleStage,
+	}
+}
+
+func clonePtr[T any](value *T) *T {
+	if value == nil {
+		return nil
+	}
+
+	return utils.PtrTo(*value)
+}
+
+// toEntity returns a copy of run, with its latest metrics and the datasets of its experiment.
+func (s *TrackingMemoryStore) toEntity(run *run) *entities.Run {
+	metrics := make([]*entities.Metric, 0, len(run.metrics))
+	for _, key := range slices.Sorted(maps.Keys(run.metrics)) {
+		latest := *slices.MaxFunc(run.metrics[key], compareMetrics)
+		metrics = append(metrics, &latest)
+	}
+
+	params := make([]*entities.Param, 0, len(run.params))
+	for _, key := range slices.Sorted(maps.Keys(run.params)) {
+		params = append(params, &entities.Param{Key: key, Value: utils.PtrTo(run.params[key])})
+	}
+
+	tags := make([]*entities.RunTag, 0, len(run.tags))
+	for _, key := range slices.Sorted(maps.Keys(run.tags)) {
+		tags = append(tags, &entities.RunTag{Key: key, Value: run.tags[key]})
+	}
+
+	return &entities.Run{
+		Info: run.info(),
+		Data: &entities.RunData{
+			Tags:    tags,
+			Params:  params,
+			Metrics: metrics,
+		},
+		Inputs: &entities.RunInputs{DatasetInputs: s.datasetInputs(run)},
+	}
+}
+
+func (s *TrackingMemoryStore) getRun(runID string) (*run, *contract.Error) {
+	run, ok := s.runs[runID]
+	if !ok {
+		return nil, contract.NewError(
+			protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+			fmt.Sprintf("Run with id=%s not found", runID),
+		)
+	}
+
+	return run, nil
+}
+
+// getActiveRun returns a run which must be active to be updated.
+func (s *TrackingMemoryStore) getActiveRun(runID string) (*run, *contract.Error) {
+	run, contractError := s.getRun(runID)
+	if contractError != nil {
+		return nil, contractError
+	}
+
+	if run.lifecycleStage != lifecycleStageActive {
+		return nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf(
+				"The run %s must be in the 'active' state.\n"+
+					"Current state is %v.",
+				runID,
+				run.lifecycleStage,
+			),
+		)
+	}
+
+	return run, nil
+}
+
+func (s *TrackingMemoryStore) GetRun(_ context.Context, runID string) (*entities.Run, *contract.Error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	run, contractError := s.getRun(runID)
+	if contractError != nil {
+		return nil, contractError
+	}
+
+	return s.toEntity(run), nil
+}
+
+// runName returns the name of a new run, which may be given as argument, as mlflow.runName tag or both.
+func runName(name string, tags []*entities.RunTag) (string, *contract.Error) {
+	var nameFromTags string
+
+	for _, tag := range tags {
+		if tag.Key == utils.TagRunName {
+			nameFromTags = tag.Value
+		}
+	}
+
+	switch {
+	case name != "" && nameFromTags != "" && name != nameFromTags:
+		return "", contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf(
+				"Both 'run_name' argument and 'mlflow.runName' tag are specified, but with "+
+					"different values (run_name='%s', mlflow.runName='%s').",
+				name,
+				nameFromTags,
+			),
+		)
+	case name != "":
+		return name, nil
+	case nameFromTags != "":
+		return nameFromTags, nil
+	}
+
+	randomName, err := utils.GenerateRandomName()
+	if err != nil {
+		return "", contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to generate random run name", err)
+	}
+
+	return randomName, nil
+}
+
+func (s *TrackingMemoryStore) CreateRun(
+	_ context.Context,
+	experimentID, userID string,
+	startTime int64,
+	tags []*entities.RunTag,
+	name string,
+) (*entities.Run, *contract.Error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	experiment, contractError := s.getExperiment(experimentID)
+	if contractError != nil {
+		return nil, contractError
+	}
+
+	if err := checkExperimentIsActive(experiment); err != nil {
+		return nil, err
+	}
+
+	name, contractError = runName(name, tags)
+	if contractError != nil {
+		return nil, contractError
+	}
+
+	runID := utils.NewUUID()
+
+	artifactURI, err := utils.AppendToURIPath(experiment.artifactLocation, runID, artifactsFolderName)
+	if err != nil {
+		return nil, contract.NewError(
+			protos.ErrorCode_INTERNAL_ERROR,
+			"failed to append run ID to experiment artifact location",
+		)
+	}
+
+	run := &run{
+		id:             runID,
+		name:           name,
+		experimentID:   experiment.id,
+		userID:         userID,
+		status:         protos.RunStatus_RUNNING.String(),
+		startTime:      startTime,
+		artifactURI:    artifactURI,
+		lifecycleStage: lifecycleStageActive,
+		metrics:        make(map[string][]*entities.Metric),
+		params:         make(map[string]string),
+		tags:           make(map[string]string, len(tags)+1),
+		inputs:         make(map[datasetKey]map[string]string),
+	}
+
+	for _, tag := range tags {
+		run.tags[tag.Key] = tag.Value
+	}
+
+	run.tags[utils.TagRunName] = name
+
+	s.runs[runID] = run
+
+	return s.toEntity(run), nil
+}
+
+// UpdateRun updates the status, end time and name of a run, the empty ones being left unchanged.
+func (s *TrackingMemoryStore) UpdateRun(
+	_ context.Context,
+	runID string,
+	runStatus string,
+	endTime *int64,
+	runName string,
+) *contract.Error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	run, contractError := s.getRun(runID)
+	if contractError != nil {
+		return contractError
+	}
+
+	if runStatus != "" {
+		run.status = runStatus
+	}
+
+	if endTime != nil {
+		run.endTime = utils.PtrTo(*endTime)
+	}
+
+	if runName != "" {
+		run.name = runName
+		run.tags[utils.TagRunName] = runName
+	}
+
+	return nil
+}
+
+func (s *TrackingMemoryStore) setRunLifecycleStage(runID, lifecycleStage string, deletedTime *int64) *contract.Error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	run, contractError := s.getRun(runID)
+	if contractError != nil {
+		return contractError
+	}
+
+	run.lifecycleStage = lifecycleStage
+	run.deletedTime = deletedTime
+
+	return nil
+}
+
+func (s *TrackingMemoryStore) DeleteRun(_ context.Context, runID string) *contract.Error {
+	return s.setRunLifecycleStage(runID, lifecycleStageDeleted, utils.PtrTo(now()))
+}
+
+func (s *TrackingMemoryStore) RestoreRun(_ context.Context, runID string) *contract.Error {
+	return s.setRunLifecycleStage(runID, lifecycleStageActive, nil)
+}
+
+func (s *TrackingMemoryStore) SearchRuns(
+	ctx context.Context,
+	experimentIDs []string, filter string,
+	runViewType protos.ViewType, maxResults int, orderBy []string, pageToken string,
+) ([]*entities.Run, string, *contract.Error) {
+	offset, contractError := search.ParsePageToken(pageToken)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	filterExpr, err := query.ParseFilter(filter)
+	if err != nil {
+		return nil, "", query.NewFilterError(err)
+	}
+
+	utils.GetLoggerFromContext(ctx).Debugf("Filter conditions: %v", filterExpr)
+
+	orderKeys, contractError := search.ParseRunsOrderBy(orderBy)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	runs := make([]*entities.Run, 0)
+
+	for _, run := range s.runs {
+		if !slices.Contains(experimentIDs, run.experimentID) {
+			continue
+		}
+
+		entity := s.toEntity(run)
+		if search.MatchesViewType(runViewType, entity.Info.LifecycleStage) &&
+			search.Matches(filterExpr, search.RunValues(entity)) {
+			runs = append(runs, entity)
+		}
+	}
+
+	search.Sort(runs, orderKeys, search.RunValues)
+
+	runs, nextPageToken, contractError := search.PaginateFull(runs, offset, maxResults)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	sections := store.GetRunSectionsFromContext(ctx)
+	for index, run := range runs {
+		runs[index] = sections.Select(run)
+	}
+
+	return runs, nextPageToken, nil
+}
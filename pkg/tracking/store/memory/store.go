@@ -0,0 +1,79 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/mlflow/mlflow-go/pkg/config"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store"
+)
+
+// Scheme is the scheme of the URIs of the memory stores, such as memory://.
+const Scheme = "memory"
+
+const (
+	DefaultExperimentID   = "0"
+	defaultExperimentName = "Default"
+)
+
+const (
+	lifecycleStageActive  = "active"
+	lifecycleStageDeleted = "deleted"
+)
+
+// Like the SQL stores, the memory store is registered when the package is imported.
+//
+//nolint:gochecknoinits
+func init() {
+	store.RegisterTrackingStore(Scheme, func(ctx context.Context, config *config.Config) (store.TrackingStore, error) {
+		return NewTrackingMemoryStore(ctx, config)
+	})
+}
+
+// TrackingMemoryStore keeps the experiments, runs and traces in memory, like a fresh SQL database would,
+// for the tests and the servers which don't need to persist them. Its data is lost when it's destroyed.
+type TrackingMemoryStore struct {
+	config *config.Config
+	mutex  sync.RWMutex
+
+	experiments map[string]*experiment
+	// nextExperimentID is the ID of the next experiment, like the auto increment of the experiments table.
+	nextExperimentID int
+	runs             map[string]*run
+	traces           map[string]*trace
+}
+
+func NewTrackingMemoryStore(_ context.Context, config *config.Config) (*TrackingMemoryStore, error) {
+	memoryStore := &TrackingMemoryStore{
+		config:           config,
+		mutex:            sync.RWMutex{},
+		experiments:      make(map[string]*experiment),
+		nextExperimentID: 1,
+		runs:             make(map[string]*run),
+		traces:           make(map[string]*trace),
+	}
+
+	// Like the migrations of the SQL stores, the default experiment is created with the store.
+	if _, err := memoryStore.createExperiment(DefaultExperimentID, defaultExperimentName, "", nil); err != nil {
+		return nil, fmt.Errorf("failed to create default experiment: %w", err)
+	}
+
+	return memoryStore, nil
+}
+
+func (s *TrackingMemoryStore) Destroy() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.experiments = make(map[string]*experiment)
+	s.runs = make(map[string]*run)
+	s.traces = make(map[string]*trace)
+
+	return nil
+}
+
+func now() int64 {
+	return time.Now().UnixMilli()
+}
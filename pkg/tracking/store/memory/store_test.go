@@ -0,0 +1,33 @@
+package memory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go/pkg/config"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store/memory"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store/storetest"
+)
+
+func TestTrackingMemoryStore(t *testing.T) {
+	t.Parallel()
+
+	storetest.Run(t, func(t *testing.T) store.TrackingStore {
+		t.Helper()
+
+		memoryStore, err := memory.NewTrackingMemoryStore(context.Background(), &config.Config{
+			TrackingStoreURI:    memory.Scheme + "://",
+			DefaultArtifactRoot: t.TempDir(),
+		})
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			require.NoError(t, memoryStore.Destroy())
+		})
+
+		return memoryStore
+	})
+}
@@ -0,0 +1,79 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/entities"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/utils"
+)
+
+func (s *TrackingMemoryStore) GetRunTag(
+	_ context.Context, runID, tagKey string,
+) (*entities.RunTag, *contract.Error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	run, contractError := s.getRun(runID)
+	if contractError != nil {
+		return nil, contractError
+	}
+
+	value, ok := run.tags[tagKey]
+	if !ok {
+		return nil, nil
+	}
+
+	return &entities.RunTag{Key: tagKey, Value: value}, nil
+}
+
+// setTags sets the tags of a run, the name and user of the run being updated along with their tags.
+func setTags(run *run, tags []*entities.RunTag) {
+	for _, tag := range tags {
+		switch tag.Key {
+		case utils.TagRunName:
+			run.name = tag.Value
+		case utils.TagUser:
+			run.userID = tag.Value
+		}
+
+		run.tags[tag.Key] = tag.Value
+	}
+}
+
+func (s *TrackingMemoryStore) SetTag(_ context.Context, runID, key, value string) *contract.Error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	run, contractError := s.getActiveRun(runID)
+	if contractError != nil {
+		return contractError
+	}
+
+	setTags(run, []*entities.RunTag{{Key: key, Value: value}})
+
+	return nil
+}
+
+func (s *TrackingMemoryStore) DeleteTag(_ context.Context, runID, key string) *contract.Error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	run, contractError := s.getActiveRun(runID)
+	if contractError != nil {
+		return contractError
+	}
+
+	if _, ok := run.tags[key]; !ok {
+		return contract.NewError(
+			protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+			fmt.Sprintf("No tag with name: %s in run with id %s", key, runID),
+		)
+	}
+
+	delete(run.tags, key)
+
+	return nil
+}
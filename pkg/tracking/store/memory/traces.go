@@ -0,0 +1,301 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/entities"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/tracking/service/query"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store/search"
+	"github.com/mlflow/mlflow-go/pkg/utils"
+)
+
+const (
+	artifactLocationTraceTagKey = "mlflow.artifactLocation"
+	tracesFolderName            = "traces"
+)
+
+type trace struct {
+	requestID       string
+	experimentID    string
+	status          string
+	timestampMS     int64
+	executionTimeMS *int64
+	tags            map[string]string
+	metadata        map[string]string
+}
+
+func (t *trace) toEntity() *entities.TraceInfo {
+	tags := make([]*entities.TraceTag, 0, len(t.tags))
+	for _, key := range slices.Sorted(maps.Keys(t.tags)) {
+		tags = append(tags, &entities.TraceTag{Key: key, Value: t.tags[key], RequestID: t.requestID})
+	}
+
+	metadata := make([]*entities.TraceRequestMetadata, 0, len(t.metadata))
+	for _, key := range slices.Sorted(maps.Keys(t.metadata)) {
+		metadata = append(metadata, &entities.TraceRequestMetadata{
+			Key: key, Value: t.metadata[key], RequestID: t.requestID,
+		})
+	}
+
+	return &entities.TraceInfo{
+		RequestID:            t.requestID,
+		Status:               t.status,
+		ExperimentID:         t.experimentID,
+		TimestampMS:          t.timestampMS,
+		ExecutionTimeMS:      clonePtr(t.executionTimeMS),
+		Tags:                 tags,
+		TraceRequestMetadata: metadata,
+	}
+}
+
+func (t *trace) update(metadata []*entities.TraceRequestMetadata, tags []*entities.TraceTag) {
+	for _, tag := range tags {
+		t.tags[tag.Key] = tag.Value
+	}
+
+	for _, m := range metadata {
+		t.metadata[m.Key] = m.Value
+	}
+}
+
+func (s *TrackingMemoryStore) getTrace(requestID string) (*trace, *contract.Error) {
+	trace, ok := s.traces[requestID]
+	if !ok {
+		return nil, contract.NewError(
+			protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+			fmt.Sprintf("Trace with request_id '%s' not found.", requestID),
+		)
+	}
+
+	return trace, nil
+}
+
+func (s *TrackingMemoryStore) SetTrace(
+	_ context.Context,
+	experimentID string,
+	timestampMS int64,
+	metadata []*entities.TraceRequestMetadata,
+	tags []*entities.TraceTag,
+) (*entities.TraceInfo, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	experiment, contractError := s.getExperiment(experimentID)
+	if contractError != nil {
+		return nil, contractError
+	}
+
+	trace := &trace{
+		requestID:    utils.NewUUID(),
+		experimentID: experiment.id,
+		status:       protos.TraceStatus_IN_PROGRESS.String(),
+		timestampMS:  timestampMS,
+		tags:         make(map[string]string, len(tags)+1),
+		metadata:     make(map[string]string, len(metadata)),
+	}
+
+	traceTags := make([]*entities.TraceTag, 0, len(tags))
+
+	for _, tag := range tags {
+		// Like in the SQL store, the request ID generated by Python tests is passed as a tag.
+		if tag.Key == "request_id" {
+			trace.requestID = tag.Value
+		} else {
+			traceTags = append(traceTags, tag)
+		}
+	}
+
+	artifactLocation, err := utils.AppendToURIPath(
+		experiment.artifactLocation, tracesFolderName, trace.requestID, artifactsFolderName,
+	)
+	if err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to create trace for experiment_id %q", experimentID),
+			err,
+		)
+	}
+
+	trace.update(metadata, traceTags)
+	trace.tags[artifactLocationTraceTagKey] = artifactLocation
+
+	s.traces[trace.requestID] = trace
+
+	return trace.toEntity(), nil
+}
+
+func (s *TrackingMemoryStore) EndTrace(
+	_ context.Context,
+	requestID string,
+	timestampMS int64,
+	status string,
+	metadata []*entities.TraceRequestMetadata,
+	tags []*entities.TraceTag,
+) (*entities.TraceInfo, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	trace, contractError := s.getTrace(requestID)
+	if contractError != nil {
+		return nil, contractError
+	}
+
+	trace.status = status
+	trace.executionTimeMS = utils.PtrTo(timestampMS - trace.timestampMS)
+	trace.update(metadata, tags)
+
+	return trace.toEntity(), nil
+}
+
+func (s *TrackingMemoryStore) GetTraceInfo(_ context.Context, requestID string) (*entities.TraceInfo, *contract.Error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	trace, contractError := s.getTrace(requestID)
+	if contractError != nil {
+		return nil, contractError
+	}
+
+	return trace.toEntity(), nil
+}
+
+func (s *TrackingMemoryStore) SetTraceTag(_ context.Context, requestID, key, value string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	trace, contractError := s.getTrace(requestID)
+	if contractError != nil {
+		return contractError
+	}
+
+	trace.tags[key] = value
+
+	return nil
+}
+
+func (s *TrackingMemoryStore) GetTraceTag(
+	_ context.Context, requestID, key string,
+) (*entities.TraceTag, *contract.Error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	var (
+		value string
+		ok    bool
+	)
+
+	if trace, found := s.traces[requestID]; found {
+		value, ok = trace.tags[key]
+	}
+
+	if !ok {
+		return nil, contract.NewError(
+			protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+			fmt.Sprintf("No trace tag with key '%s' for trace with request_id '%s'", key, requestID),
+		)
+	}
+
+	return &entities.TraceTag{Key: key, Value: value, RequestID: requestID}, nil
+}
+
+// DeleteTraceTag doesn't fail when the trace or the tag doesn't exist, like the SQL store.
+func (s *TrackingMemoryStore) DeleteTraceTag(_ context.Context, tag *entities.TraceTag) *contract.Error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if trace, ok := s.traces[tag.RequestID]; ok {
+		delete(trace.tags, tag.Key)
+	}
+
+	return nil
+}
+
+func (s *TrackingMemoryStore) SearchTraces(
+	ctx context.Context,
+	experimentIDs []string,
+	filter string,
+	maxResults int,
+	orderBy []string,
+	pageToken string,
+) ([]*entities.TraceInfo, string, *contract.Error) {
+	offset, contractError := search.ParsePageToken(pageToken)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	filterConditions, err := query.ParseTraceFilter(filter)
+	if err != nil {
+		return nil, "", query.NewFilterError(err)
+	}
+
+	utils.GetLoggerFromContext(ctx).Debugf("Filter conditions: %v", filterConditions)
+
+	orderKeys, contractError := search.ParseTracesOrderBy(orderBy)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	traces := make([]*entities.TraceInfo, 0)
+
+	for _, trace := range s.traces {
+		if !slices.Contains(experimentIDs, trace.experimentID) {
+			continue
+		}
+
+		traceInfo := trace.toEntity()
+		if search.MatchesAll(filterConditions, search.TraceValues(traceInfo)) {
+			traces = append(traces, traceInfo)
+		}
+	}
+
+	search.Sort(traces, orderKeys, search.TraceValues)
+
+	return search.PaginateFull(traces, offset, maxResults)
+}
+
+func (s *TrackingMemoryStore) DeleteTraces(
+	_ context.Context,
+	experimentID string,
+	maxTimestampMillis int64,
+	maxTraces int32,
+	requestIDs []string,
+) (int32, *contract.Error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	traces := make([]*trace, 0)
+
+	for _, trace := range s.traces {
+		if trace.experimentID != experimentID ||
+			(maxTimestampMillis != 0 && trace.timestampMS > maxTimestampMillis) ||
+			(len(requestIDs) > 0 && !slices.Contains(requestIDs, trace.requestID)) {
+			continue
+		}
+
+		traces = append(traces, trace)
+	}
+
+	if maxTraces != 0 {
+		slices.SortStableFunc(traces, func(a, b *trace) int {
+			return cmp.Or(cmp.Compare(a.timestampMS, b.timestampMS), cmp.Compare(a.requestID, b.requestID))
+		})
+
+		traces = traces[:min(len(traces), int(maxTraces))]
+	}
+
+	for _, trace := range traces {
+		delete(s.traces, trace.requestID)
+	}
+
+	//nolint:gosec
+	return int32(len(traces)), nil
+}
@@ -0,0 +1,33 @@
+package store
+
+import "sort"
+
+// SampleSteps samples the steps of GetMetricHistoryBulkInterval.
+// Port of _get_sampled_steps_from_steps in mlflow/server/handlers.py.
+// allSteps must be sorted in ascending order.
+func SampleSteps(startStep, endStep int64, maxResults int, allSteps []int64) map[int64]struct{} {
+	startIdx := sort.Search(len(allSteps), func(i int) bool { return allSteps[i] >= startStep })
+	endIdx := sort.Search(len(allSteps), func(i int) bool { return allSteps[i] > endStep })
+
+	sampled := make(map[int64]struct{})
+
+	if endIdx-startIdx <= maxResults {
+		for _, step := range allSteps[startIdx:endIdx] {
+			sampled[step] = struct{}{}
+		}
+
+		return sampled
+	}
+
+	numSteps := endIdx - startIdx
+	interval := float64(numSteps) / float64(maxResults)
+
+	// i*interval is below numSteps, the sampled indexes staying in the range of the requested steps.
+	for i := range maxResults {
+		sampled[allSteps[startIdx+int(float64(i)*interval)]] = struct{}{}
+	}
+
+	sampled[allSteps[endIdx-1]] = struct{}{}
+
+	return sampled
+}
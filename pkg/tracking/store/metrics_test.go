@@ -0,0 +1,80 @@
+package store_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/mlflow/mlflow-go/pkg/tracking/store"
+)
+
+func TestSampleSteps(t *testing.T) {
+	t.Parallel()
+
+	allSteps := make([]int64, 0, 100)
+	for step := range int64(100) {
+		allSteps = append(allSteps, step)
+	}
+
+	testCases := []struct {
+		name       string
+		startStep  int64
+		endStep    int64
+		maxResults int
+		expected   []int64
+	}{
+		{
+			name:       "AllStepsFit",
+			startStep:  10,
+			endStep:    14,
+			maxResults: 10,
+			expected:   []int64{10, 11, 12, 13, 14},
+		},
+		{
+			name:       "EvenlySpacedWithLastStep",
+			startStep:  0,
+			endStep:    99,
+			maxResults: 4,
+			expected:   []int64{0, 25, 50, 75, 99},
+		},
+		{
+			name:       "NonZeroStartStep",
+			startStep:  50,
+			endStep:    99,
+			maxResults: 5,
+			expected:   []int64{50, 60, 70, 80, 90, 99},
+		},
+		{
+			name:       "RangeInTheMiddle",
+			startStep:  20,
+			endStep:    59,
+			maxResults: 4,
+			expected:   []int64{20, 30, 40, 50, 59},
+		},
+		{
+			name:       "OutOfRange",
+			startStep:  200,
+			endStep:    300,
+			maxResults: 4,
+			expected:   []int64{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			sampled := store.SampleSteps(testCase.startStep, testCase.endStep, testCase.maxResults, allSteps)
+
+			actual := make([]int64, 0, len(sampled))
+			for step := range sampled {
+				actual = append(actual, step)
+			}
+
+			slices.Sort(actual)
+
+			if !slices.Equal(actual, testCase.expected) {
+				t.Errorf("expected %v, got %v", testCase.expected, actual)
+			}
+		})
+	}
+}
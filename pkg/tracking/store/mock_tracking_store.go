@@ -641,36 +641,43 @@ func (_c *MockTrackingStore_GetExperimentByName_Call) RunAndReturn(run func(cont
 	return _c
 }
 
-// GetMetricHistory provides a mock function with given fields: ctx, runID, metricKey
-func (_m *MockTrackingStore) GetMetricHistory(ctx context.Context, runID string, metricKey string) ([]*entities.Metric, *contract.Error) {
-	ret := _m.Called(ctx, runID, metricKey)
+// GetMetricHistory provides a mock function with given fields: ctx, runID, metricKey, pageToken, maxResults
+func (_m *MockTrackingStore) GetMetricHistory(ctx context.Context, runID string, metricKey string, pageToken string, maxResults int) ([]*entities.Metric, string, *contract.Error) {
+	ret := _m.Called(ctx, runID, metricKey, pageToken, maxResults)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetMetricHistory")
 	}
 
 	var r0 []*entities.Metric
-	var r1 *contract.Error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]*entities.Metric, *contract.Error)); ok {
-		return rf(ctx, runID, metricKey)
+	var r1 string
+	var r2 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, int) ([]*entities.Metric, string, *contract.Error)); ok {
+		return rf(ctx, runID, metricKey, pageToken, maxResults)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*entities.Metric); ok {
-		r0 = rf(ctx, runID, metricKey)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, int) []*entities.Metric); ok {
+		r0 = rf(ctx, runID, metricKey, pageToken, maxResults)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*entities.Metric)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) *contract.Error); ok {
-		r1 = rf(ctx, runID, metricKey)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, int) string); ok {
+		r1 = rf(ctx, runID, metricKey, pageToken, maxResults)
 	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*contract.Error)
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, string, int) *contract.Error); ok {
+		r2 = rf(ctx, runID, metricKey, pageToken, maxResults)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(*contract.Error)
 		}
 	}
 
-	return r0, r1
+	return r0, r1, r2
 }
 
 // MockTrackingStore_GetMetricHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMetricHistory'
@@ -682,23 +689,90 @@ type MockTrackingStore_GetMetricHistory_Call struct {
 //   - ctx context.Context
 //   - runID string
 //   - metricKey string
-func (_e *MockTrackingStore_Expecter) GetMetricHistory(ctx interface{}, runID interface{}, metricKey interface{}) *MockTrackingStore_GetMetricHistory_Call {
-	return &MockTrackingStore_GetMetricHistory_Call{Call: _e.mock.On("GetMetricHistory", ctx, runID, metricKey)}
+//   - pageToken string
+//   - maxResults int
+func (_e *MockTrackingStore_Expecter) GetMetricHistory(ctx interface{}, runID interface{}, metricKey interface{}, pageToken interface{}, maxResults interface{}) *MockTrackingStore_GetMetricHistory_Call {
+	return &MockTrackingStore_GetMetricHistory_Call{Call: _e.mock.On("GetMetricHistory", ctx, runID, metricKey, pageToken, maxResults)}
 }
 
-func (_c *MockTrackingStore_GetMetricHistory_Call) Run(run func(ctx context.Context, runID string, metricKey string)) *MockTrackingStore_GetMetricHistory_Call {
+func (_c *MockTrackingStore_GetMetricHistory_Call) Run(run func(ctx context.Context, runID string, metricKey string, pageToken string, maxResults int)) *MockTrackingStore_GetMetricHistory_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(int))
 	})
 	return _c
 }
 
-func (_c *MockTrackingStore_GetMetricHistory_Call) Return(_a0 []*entities.Metric, _a1 *contract.Error) *MockTrackingStore_GetMetricHistory_Call {
+func (_c *MockTrackingStore_GetMetricHistory_Call) Return(_a0 []*entities.Metric, _a1 string, _a2 *contract.Error) *MockTrackingStore_GetMetricHistory_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockTrackingStore_GetMetricHistory_Call) RunAndReturn(run func(context.Context, string, string, string, int) ([]*entities.Metric, string, *contract.Error)) *MockTrackingStore_GetMetricHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMetricHistoryBulkInterval provides a mock function with given fields: ctx, runIDs, metricKey, startStep, endStep, maxResults
+func (_m *MockTrackingStore) GetMetricHistoryBulkInterval(ctx context.Context, runIDs []string, metricKey string, startStep *int64, endStep *int64, maxResults int) ([]*entities.MetricWithRunID, *contract.Error) {
+	ret := _m.Called(ctx, runIDs, metricKey, startStep, endStep, maxResults)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMetricHistoryBulkInterval")
+	}
+
+	var r0 []*entities.MetricWithRunID
+	var r1 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, *int64, *int64, int) ([]*entities.MetricWithRunID, *contract.Error)); ok {
+		return rf(ctx, runIDs, metricKey, startStep, endStep, maxResults)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, *int64, *int64, int) []*entities.MetricWithRunID); ok {
+		r0 = rf(ctx, runIDs, metricKey, startStep, endStep, maxResults)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.MetricWithRunID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, string, *int64, *int64, int) *contract.Error); ok {
+		r1 = rf(ctx, runIDs, metricKey, startStep, endStep, maxResults)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*contract.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// MockTrackingStore_GetMetricHistoryBulkInterval_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMetricHistoryBulkInterval'
+type MockTrackingStore_GetMetricHistoryBulkInterval_Call struct {
+	*mock.Call
+}
+
+// GetMetricHistoryBulkInterval is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runIDs []string
+//   - metricKey string
+//   - startStep *int64
+//   - endStep *int64
+//   - maxResults int
+func (_e *MockTrackingStore_Expecter) GetMetricHistoryBulkInterval(ctx interface{}, runIDs interface{}, metricKey interface{}, startStep interface{}, endStep interface{}, maxResults interface{}) *MockTrackingStore_GetMetricHistoryBulkInterval_Call {
+	return &MockTrackingStore_GetMetricHistoryBulkInterval_Call{Call: _e.mock.On("GetMetricHistoryBulkInterval", ctx, runIDs, metricKey, startStep, endStep, maxResults)}
+}
+
+func (_c *MockTrackingStore_GetMetricHistoryBulkInterval_Call) Run(run func(ctx context.Context, runIDs []string, metricKey string, startStep *int64, endStep *int64, maxResults int)) *MockTrackingStore_GetMetricHistoryBulkInterval_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(string), args[3].(*int64), args[4].(*int64), args[5].(int))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_GetMetricHistoryBulkInterval_Call) Return(_a0 []*entities.MetricWithRunID, _a1 *contract.Error) *MockTrackingStore_GetMetricHistoryBulkInterval_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockTrackingStore_GetMetricHistory_Call) RunAndReturn(run func(context.Context, string, string) ([]*entities.Metric, *contract.Error)) *MockTrackingStore_GetMetricHistory_Call {
+func (_c *MockTrackingStore_GetMetricHistoryBulkInterval_Call) RunAndReturn(run func(context.Context, []string, string, *int64, *int64, int) ([]*entities.MetricWithRunID, *contract.Error)) *MockTrackingStore_GetMetricHistoryBulkInterval_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1444,6 +1518,78 @@ func (_c *MockTrackingStore_SearchRuns_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// SearchTraces provides a mock function with given fields: ctx, experimentIDs, filter, maxResults, orderBy, pageToken
+func (_m *MockTrackingStore) SearchTraces(ctx context.Context, experimentIDs []string, filter string, maxResults int, orderBy []string, pageToken string) ([]*entities.TraceInfo, string, *contract.Error) {
+	ret := _m.Called(ctx, experimentIDs, filter, maxResults, orderBy, pageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchTraces")
+	}
+
+	var r0 []*entities.TraceInfo
+	var r1 string
+	var r2 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, int, []string, string) ([]*entities.TraceInfo, string, *contract.Error)); ok {
+		return rf(ctx, experimentIDs, filter, maxResults, orderBy, pageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, int, []string, string) []*entities.TraceInfo); ok {
+		r0 = rf(ctx, experimentIDs, filter, maxResults, orderBy, pageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.TraceInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, string, int, []string, string) string); ok {
+		r1 = rf(ctx, experimentIDs, filter, maxResults, orderBy, pageToken)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []string, string, int, []string, string) *contract.Error); ok {
+		r2 = rf(ctx, experimentIDs, filter, maxResults, orderBy, pageToken)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(*contract.Error)
+		}
+	}
+
+	return r0, r1, r2
+}
+
+// MockTrackingStore_SearchTraces_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchTraces'
+type MockTrackingStore_SearchTraces_Call struct {
+	*mock.Call
+}
+
+// SearchTraces is a helper method to define mock.On call
+//   - ctx context.Context
+//   - experimentIDs []string
+//   - filter string
+//   - maxResults int
+//   - orderBy []string
+//   - pageToken string
+func (_e *MockTrackingStore_Expecter) SearchTraces(ctx interface{}, experimentIDs interface{}, filter interface{}, maxResults interface{}, orderBy interface{}, pageToken interface{}) *MockTrackingStore_SearchTraces_Call {
+	return &MockTrackingStore_SearchTraces_Call{Call: _e.mock.On("SearchTraces", ctx, experimentIDs, filter, maxResults, orderBy, pageToken)}
+}
+
+func (_c *MockTrackingStore_SearchTraces_Call) Run(run func(ctx context.Context, experimentIDs []string, filter string, maxResults int, orderBy []string, pageToken string)) *MockTrackingStore_SearchTraces_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(string), args[3].(int), args[4].([]string), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_SearchTraces_Call) Return(_a0 []*entities.TraceInfo, _a1 string, _a2 *contract.Error) *MockTrackingStore_SearchTraces_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockTrackingStore_SearchTraces_Call) RunAndReturn(run func(context.Context, []string, string, int, []string, string) ([]*entities.TraceInfo, string, *contract.Error)) *MockTrackingStore_SearchTraces_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetExperimentTag provides a mock function with given fields: ctx, experimentID, key, value
 func (_m *MockTrackingStore) SetExperimentTag(ctx context.Context, experimentID string, key string, value string) *contract.Error {
 	ret := _m.Called(ctx, experimentID, key, value)
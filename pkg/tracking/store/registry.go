@@ -0,0 +1,43 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mlflow/mlflow-go/pkg/config"
+	"github.com/mlflow/mlflow-go/pkg/utils"
+)
+
+// TrackingStoreFactory creates the tracking store of config.TrackingStoreURI.
+type TrackingStoreFactory func(ctx context.Context, config *config.Config) (TrackingStore, error)
+
+var trackingStores = utils.NewSchemeRegistry[TrackingStoreFactory]("tracking store")
+
+// RegisterTrackingStore makes factory create the tracking stores of the URIs with scheme.
+// The SQL stores register themselves when package sql is imported, other stores can be plugged in
+// by registering them before the server is launched.
+func RegisterTrackingStore(scheme string, factory TrackingStoreFactory) {
+	trackingStores.Register(scheme, factory)
+}
+
+// TrackingStoreSchemes returns the schemes of the registered tracking stores.
+func TrackingStoreSchemes() []string {
+	return trackingStores.Schemes()
+}
+
+// NewTrackingStore creates the tracking store of config.TrackingStoreURI with the factory of its scheme.
+//
+//nolint:ireturn
+func NewTrackingStore(ctx context.Context, config *config.Config) (TrackingStore, error) {
+	factory, err := trackingStores.Lookup(config.TrackingStoreURI)
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := factory(ctx, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tracking store: %w", err)
+	}
+
+	return store, nil
+}
@@ -0,0 +1,40 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go/pkg/config"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store"
+)
+
+func TestRegisterTrackingStore(t *testing.T) {
+	t.Parallel()
+
+	mockStore := store.NewMockTrackingStore(t)
+
+	store.RegisterTrackingStore("registry-test", func(context.Context, *config.Config) (store.TrackingStore, error) {
+		return mockStore, nil
+	})
+
+	assert.Contains(t, store.TrackingStoreSchemes(), "registry-test")
+
+	assert.Panics(t, func() {
+		store.RegisterTrackingStore("Registry-Test", func(context.Context, *config.Config) (store.TrackingStore, error) {
+			return mockStore, nil
+		})
+	})
+
+	// The driver of the scheme is ignored, like in mysql+pymysql://.
+	trackingStore, err := store.NewTrackingStore(
+		context.Background(), &config.Config{TrackingStoreURI: "registry-test+driver://host/path"},
+	)
+	require.NoError(t, err)
+	assert.Same(t, mockStore, trackingStore)
+
+	_, err = store.NewTrackingStore(context.Background(), &config.Config{TrackingStoreURI: "unknown://host/path"})
+	require.ErrorContains(t, err, `unsupported tracking store URI scheme "unknown"`)
+}
@@ -0,0 +1,99 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mlflow/mlflow-go/pkg/entities"
+)
+
+// RunSections is the set of sections of the runs returned by SearchRuns, the run info always being returned.
+type RunSections uint8
+
+const (
+	RunSectionMetrics RunSections = 1 << iota
+	RunSectionParams
+	RunSectionTags
+	RunSectionInputs
+
+	AllRunSections = RunSectionMetrics | RunSectionParams | RunSectionTags | RunSectionInputs
+)
+
+//nolint:gochecknoglobals
+var runSectionNames = map[string]RunSections{
+	"metrics": RunSectionMetrics,
+	"params":  RunSectionParams,
+	"tags":    RunSectionTags,
+	"inputs":  RunSectionInputs,
+}
+
+func (s RunSections) Has(section RunSections) bool {
+	return s&section == section
+}
+
+// Select returns run without the sections which aren't in s, for the stores loading whole runs.
+func (s RunSections) Select(run *entities.Run) *entities.Run {
+	data := &entities.RunData{
+		Tags:    make([]*entities.RunTag, 0),
+		Params:  make([]*entities.Param, 0),
+		Metrics: make([]*entities.Metric, 0),
+	}
+
+	if s.Has(RunSectionMetrics) {
+		data.Metrics = run.Data.Metrics
+	}
+
+	if s.Has(RunSectionParams) {
+		data.Params = run.Data.Params
+	}
+
+	if s.Has(RunSectionTags) {
+		data.Tags = run.Data.Tags
+	}
+
+	inputs := &entities.RunInputs{DatasetInputs: make([]*entities.DatasetInput, 0)}
+	if s.Has(RunSectionInputs) {
+		inputs = run.Inputs
+	}
+
+	return &entities.Run{Info: run.Info, Data: data, Inputs: inputs}
+}
+
+// ParseRunSections parses a comma separated list of sections, such as "params,tags".
+func ParseRunSections(value string) (RunSections, error) {
+	var sections RunSections
+
+	for _, name := range strings.Split(value, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+
+		section, ok := runSectionNames[name]
+		if !ok {
+			return 0, fmt.Errorf("invalid run section %q, expected one of metrics, params, tags, inputs", name)
+		}
+
+		sections |= section
+	}
+
+	return sections, nil
+}
+
+// RunSectionsContextKey is the context key of the sections requested from SearchRuns.
+// It is exported so that the sections can also be set on the request context of Fiber, with Locals.
+type RunSectionsContextKey struct{}
+
+func NewContextWithRunSections(ctx context.Context, sections RunSections) context.Context {
+	return context.WithValue(ctx, RunSectionsContextKey{}, sections)
+}
+
+// GetRunSectionsFromContext returns the sections requested from SearchRuns, all of them by default.
+func GetRunSectionsFromContext(ctx context.Context) RunSections {
+	if sections, ok := ctx.Value(RunSectionsContextKey{}).(RunSections); ok {
+		return sections
+	}
+
+	return AllRunSections
+}
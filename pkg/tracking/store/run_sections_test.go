@@ -0,0 +1,30 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go/pkg/tracking/store"
+)
+
+func TestParseRunSections(t *testing.T) {
+	t.Parallel()
+
+	sections, err := store.ParseRunSections("params, Tags,")
+	require.NoError(t, err)
+	assert.Equal(t, store.RunSectionParams|store.RunSectionTags, sections)
+	assert.False(t, sections.Has(store.RunSectionMetrics))
+
+	_, err = store.ParseRunSections("params,datasets")
+	require.Error(t, err)
+
+	assert.Equal(t, store.AllRunSections, store.GetRunSectionsFromContext(context.Background()))
+	assert.Equal(
+		t,
+		store.RunSectionInputs,
+		store.GetRunSectionsFromContext(store.NewContextWithRunSections(context.Background(), store.RunSectionInputs)),
+	)
+}
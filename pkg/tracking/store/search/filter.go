@@ -0,0 +1,190 @@
+// Package search evaluates the filters, order_by clauses and page tokens of the search endpoints
+// in memory, for the stores which can't translate them to a query.
+package search
+
+import (
+	"cmp"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/mlflow/mlflow-go/pkg/tracking/service/query/parser"
+)
+
+// Lookup returns the values of identifier.key. Values are strings, int64 or float64,
+// and a key has no value when it's missing, or several values for the datasets of a run.
+type Lookup func(identifier parser.ValidIdentifier, key string) []any
+
+// Matches tells whether the values match the tree of a filter parsed by the query package.
+func Matches(expr parser.Expr, values Lookup) bool {
+	switch expr := expr.(type) {
+	case *parser.AndExpr:
+		for _, expr := range expr.Exprs {
+			if !Matches(expr, values) {
+				return false
+			}
+		}
+
+		return true
+	case *parser.OrExpr:
+		for _, expr := range expr.Exprs {
+			if Matches(expr, values) {
+				return true
+			}
+		}
+
+		return false
+	case *parser.NotExpr:
+		return !Matches(expr.Expr, values)
+	case *parser.ValidCompareExpr:
+		return matchesComparison(expr, values(expr.Identifier, expr.Key))
+	default:
+		// Validated trees only hold the nodes above.
+		return true
+	}
+}
+
+// MatchesAll tells whether the values match all the clauses of a filter, like the ones of traces.
+func MatchesAll(clauses []*parser.ValidCompareExpr, values Lookup) bool {
+	for _, clause := range clauses {
+		if !matchesComparison(clause, values(clause.Identifier, clause.Key)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchesComparison tells whether any of the values matches, like a join would.
+func matchesComparison(clause *parser.ValidCompareExpr, values []any) bool {
+	//nolint:exhaustive
+	switch clause.Operator {
+	case parser.IsNull:
+		return len(values) == 0
+	case parser.IsNotNull:
+		return len(values) != 0
+	}
+
+	for _, value := range values {
+		if compareValue(clause.Operator, value, clause.Value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+//nolint:cyclop
+func compareValue(operator parser.OperatorKind, actual, expected any) bool {
+	if list, ok := expected.([]string); ok {
+		str, ok := actual.(string)
+		if !ok {
+			return false
+		}
+
+		//nolint:exhaustive
+		switch operator {
+		case parser.In:
+			return slices.Contains(list, str)
+		case parser.NotIn:
+			return !slices.Contains(list, str)
+		default:
+			return false
+		}
+	}
+
+	//nolint:exhaustive
+	switch operator {
+	case parser.Like, parser.ILike:
+		actualString, ok := actual.(string)
+		expectedString, isString := expected.(string)
+
+		return ok && isString && likeRegexp(expectedString, operator == parser.ILike).MatchString(actualString)
+	}
+
+	result, ok := compareValues(actual, expected)
+	if !ok {
+		return false
+	}
+
+	//nolint:exhaustive
+	switch operator {
+	case parser.Equals:
+		return result == 0
+	case parser.NotEquals:
+		return result != 0
+	case parser.Less:
+		return result < 0
+	case parser.LessEquals:
+		return result <= 0
+	case parser.Greater:
+		return result > 0
+	case parser.GreaterEquals:
+		return result >= 0
+	default:
+		return false
+	}
+}
+
+// compareValues compares two strings or two numbers, ok being false for other values and NaN.
+func compareValues(a, b any) (int, bool) {
+	if aString, ok := a.(string); ok {
+		bString, ok := b.(string)
+
+		return strings.Compare(aString, bString), ok
+	}
+
+	aInt, aIsInt := a.(int64)
+	bInt, bIsInt := b.(int64)
+
+	if aIsInt && bIsInt {
+		return cmp.Compare(aInt, bInt), true
+	}
+
+	aFloat, aIsNumber := toFloat(a)
+	bFloat, bIsNumber := toFloat(b)
+
+	if !aIsNumber || !bIsNumber || math.IsNaN(aFloat) || math.IsNaN(bFloat) {
+		return 0, false
+	}
+
+	return cmp.Compare(aFloat, bFloat), true
+}
+
+func toFloat(value any) (float64, bool) {
+	switch value := value.(type) {
+	case float64:
+		return value, true
+	case int64:
+		return float64(value), true
+	default:
+		return 0, false
+	}
+}
+
+// likeRegexp translates a LIKE pattern, where % matches any string and _ any character.
+func likeRegexp(pattern string, caseInsensitive bool) *regexp.Regexp {
+	var expression strings.Builder
+
+	if caseInsensitive {
+		expression.WriteString("(?i)")
+	}
+
+	expression.WriteString("^")
+
+	for _, char := range pattern {
+		switch char {
+		case '%':
+			expression.WriteString(".*")
+		case '_':
+			expression.WriteString(".")
+		default:
+			expression.WriteString(regexp.QuoteMeta(string(char)))
+		}
+	}
+
+	expression.WriteString("$")
+
+	return regexp.MustCompile("(?s)" + expression.String())
+}
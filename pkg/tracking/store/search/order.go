@@ -0,0 +1,260 @@
+package search
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/tracking/service/query/parser"
+)
+
+// OrderKey is a key of an order_by clause.
+type OrderKey struct {
+	Identifier parser.ValidIdentifier
+	Key        string
+	Desc       bool
+}
+
+// nullRank orders the values before NaN, and NaN before missing values, like MLflow does.
+func nullRank(values []any) int {
+	switch {
+	case len(values) == 0:
+		return 2 //nolint:mnd
+	case isNaN(values[0]):
+		return 1
+	default:
+		return 0
+	}
+}
+
+func isNaN(value any) bool {
+	float, ok := value.(float64)
+
+	return ok && math.IsNaN(float)
+}
+
+func compareByKeys(a, b Lookup, keys []OrderKey) int {
+	for _, key := range keys {
+		aValues := a(key.Identifier, key.Key)
+		bValues := b(key.Identifier, key.Key)
+
+		if result := cmp.Compare(nullRank(aValues), nullRank(bValues)); result != 0 {
+			return result
+		}
+
+		if nullRank(aValues) != 0 {
+			continue
+		}
+
+		result, _ := compareValues(aValues[0], bValues[0])
+		if key.Desc {
+			result = -result
+		}
+
+		if result != 0 {
+			return result
+		}
+	}
+
+	return 0
+}
+
+// Sort sorts items by the keys, with the values of values.
+// Like in MLflow, NaN comes after the other values and missing values last, in both directions.
+func Sort[T any](items []T, keys []OrderKey, values func(T) Lookup) {
+	type sortedItem struct {
+		item   T
+		values Lookup
+	}
+
+	sortedItems := make([]sortedItem, len(items))
+	for index, item := range items {
+		sortedItems[index] = sortedItem{item: item, values: values(item)}
+	}
+
+	slices.SortStableFunc(sortedItems, func(a, b sortedItem) int {
+		return compareByKeys(a.values, b.values, keys)
+	})
+
+	for index, sortedItem := range sortedItems {
+		items[index] = sortedItem.item
+	}
+}
+
+// Matches `[identifier.]key [ASC|DESC]` where the key may be wrapped in quotes or backticks.
+var orderByRegexp = regexp.MustCompile(
+	"^(?:([a-zA-Z_]+)\\.)?(\"[^\"]+\"|`[^`]+`|'[^']+'|[\\w.]+)(?:\\s+(?i:(ASC|DESC)))?$",
+)
+
+// splitOrderBy returns the identifier, key and direction of an order_by clause.
+func splitOrderBy(orderByClause string) (string, string, bool, *contract.Error) {
+	matches := orderByRegexp.FindStringSubmatch(strings.TrimSpace(orderByClause))
+	if matches == nil {
+		return "", "", false, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("invalid order_by clause %q.", orderByClause),
+		)
+	}
+
+	return strings.ToLower(matches[1]), strings.Trim(matches[2], "\"'`"), strings.EqualFold(matches[3], "DESC"), nil
+}
+
+// ParseRunsOrderBy parses the order_by clauses of runs, adding the tiebreakers of the SQL store.
+//
+//nolint:cyclop
+func ParseRunsOrderBy(orderBy []string) ([]OrderKey, *contract.Error) {
+	orderKeys := make([]OrderKey, 0, len(orderBy)+2) //nolint:mnd
+	startTimeOrder := false
+
+	for _, orderByClause := range orderBy {
+		identifier, key, desc, err := splitOrderBy(orderByClause)
+		if err != nil {
+			return nil, err
+		}
+
+		var validIdentifier parser.ValidIdentifier
+
+		switch identifier {
+		case "metric", "metrics":
+			validIdentifier = parser.Metric
+		case "parameter", "parameters", "param", "params":
+			validIdentifier = parser.Parameter
+		case "tag", "tags":
+			validIdentifier = parser.Tag
+		case "", "attribute", "attributes", "attr", "run":
+			validIdentifier = parser.Attribute
+
+			switch strings.ToLower(key) {
+			case "created", parser.StartTime:
+				key = parser.StartTime
+				startTimeOrder = true
+			case parser.RunName, "run name", "name":
+				key = parser.RunName
+			case parser.RunID, "run_uuid":
+				key = "run_uuid"
+			case "end_time", "status", "user_id", "artifact_uri", "experiment_id", "lifecycle_stage":
+			default:
+				return nil, contract.NewError(
+					protos.ErrorCode_INVALID_PARAMETER_VALUE,
+					fmt.Sprintf("invalid order_by attribute %q.", key),
+				)
+			}
+		default:
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("invalid order_by identifier %q.", identifier),
+			)
+		}
+
+		orderKeys = append(orderKeys, OrderKey{Identifier: validIdentifier, Key: key, Desc: desc})
+	}
+
+	if !startTimeOrder {
+		orderKeys = append(orderKeys, OrderKey{Identifier: parser.Attribute, Key: parser.StartTime, Desc: true})
+	}
+
+	return append(orderKeys, OrderKey{Identifier: parser.Attribute, Key: "run_uuid", Desc: false}), nil
+}
+
+var experimentOrderByRegexp = regexp.MustCompile(`^(?:attr(?:ibutes?)?\.)?(\w+)(?i:\s+(ASC|DESC))?$`)
+
+// ParseExperimentsOrderBy parses the order_by clauses of experiments, adding the tiebreakers of the SQL store.
+func ParseExperimentsOrderBy(orderBy []string) ([]OrderKey, *contract.Error) {
+	orderKeys := make([]OrderKey, 0, len(orderBy)+2) //nolint:mnd
+	experimentIDOrder := false
+
+	for _, orderByClause := range orderBy {
+		parts := experimentOrderByRegexp.FindStringSubmatch(orderByClause)
+		if len(parts) == 0 {
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("invalid order_by clause '%s'", orderByClause),
+			)
+		}
+
+		switch parts[1] {
+		case "experiment_id":
+			experimentIDOrder = true
+		case parser.ExperimentName, parser.ExperimentCreationTime, parser.ExperimentLastUpdateTime:
+		default:
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf(
+					"invalid attribute '%s'. Valid values are ['name', 'experiment_id', 'creation_time', 'last_update_time']",
+					parts[1],
+				),
+			)
+		}
+
+		orderKeys = append(orderKeys, OrderKey{
+			Identifier: parser.Attribute,
+			Key:        parts[1],
+			Desc:       strings.EqualFold(parts[2], "DESC"),
+		})
+	}
+
+	if len(orderBy) == 0 {
+		orderKeys = append(orderKeys, OrderKey{
+			Identifier: parser.Attribute, Key: parser.ExperimentCreationTime, Desc: true,
+		})
+	}
+
+	if !experimentIDOrder {
+		orderKeys = append(orderKeys, OrderKey{Identifier: parser.Attribute, Key: "experiment_id", Desc: false})
+	}
+
+	return orderKeys, nil
+}
+
+// ParseTracesOrderBy parses the order_by clauses of traces, adding the tiebreakers of the SQL store.
+func ParseTracesOrderBy(orderBy []string) ([]OrderKey, *contract.Error) {
+	orderKeys := make([]OrderKey, 0, len(orderBy)+2) //nolint:mnd
+	timestampOrder := false
+
+	for _, orderByClause := range orderBy {
+		identifier, key, desc, err := splitOrderBy(orderByClause)
+		if err != nil {
+			return nil, err
+		}
+
+		validIdentifier := parser.Tag
+
+		switch identifier {
+		case "", "attribute", "attr", "attributes", "trace":
+			validIdentifier = parser.Attribute
+
+			switch key {
+			case "timestamp", parser.TraceTimestampMS:
+				key = parser.TraceTimestampMS
+				timestampOrder = true
+			case "execution_time", parser.TraceExecutionTimeMS:
+				key = parser.TraceExecutionTimeMS
+			case parser.TraceStatus, parser.TraceRequestID:
+			default:
+				return nil, contract.NewError(
+					protos.ErrorCode_INVALID_PARAMETER_VALUE,
+					fmt.Sprintf("invalid order_by attribute %q.", key),
+				)
+			}
+		case "tag", "tags":
+		default:
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("invalid order_by identifier %q.", identifier),
+			)
+		}
+
+		orderKeys = append(orderKeys, OrderKey{Identifier: validIdentifier, Key: key, Desc: desc})
+	}
+
+	if !timestampOrder {
+		orderKeys = append(orderKeys, OrderKey{Identifier: parser.Attribute, Key: parser.TraceTimestampMS, Desc: true})
+	}
+
+	return append(orderKeys, OrderKey{Identifier: parser.Attribute, Key: parser.TraceRequestID, Desc: false}), nil
+}
@@ -0,0 +1,98 @@
+package search
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+)
+
+type pageToken struct {
+	Offset int32 `json:"offset"`
+}
+
+// ParsePageToken returns the offset of a page token, 0 for the first page.
+func ParsePageToken(token string) (int, *contract.Error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	var decoded pageToken
+	if err := json.NewDecoder(
+		base64.NewDecoder(base64.StdEncoding, strings.NewReader(token)),
+	).Decode(&decoded); err != nil || decoded.Offset < 0 {
+		return 0, contract.NewErrorWith(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("invalid page_token: %q", token),
+			err,
+		)
+	}
+
+	return int(decoded.Offset), nil
+}
+
+func mkPageToken(offset int) (string, *contract.Error) {
+	var token strings.Builder
+
+	encoder := base64.NewEncoder(base64.StdEncoding, &token)
+
+	//nolint:gosec // disable G115
+	if err := json.NewEncoder(encoder).Encode(pageToken{Offset: int32(offset)}); err != nil {
+		return "", contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "error encoding 'nextPageToken' value", err)
+	}
+
+	// Flush the last partial block of the encoding, which would otherwise be cut from the token.
+	if err := encoder.Close(); err != nil {
+		return "", contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "error encoding 'nextPageToken' value", err)
+	}
+
+	return token.String(), nil
+}
+
+// NextPageToken returns the token of the page after the one at offset, for the stores querying one item more than
+// maxResults to know whether there is a next page. No token is returned when length is at most maxResults.
+func NextPageToken(length, maxResults, offset int) (string, *contract.Error) {
+	if length <= maxResults {
+		return "", nil
+	}
+
+	return mkPageToken(offset + maxResults)
+}
+
+// Paginate returns the page of items starting at offset, and the token of the next page if there is one.
+// Without maxResults, all the items are returned.
+func Paginate[T any](items []T, offset, maxResults int) ([]T, string, *contract.Error) {
+	if offset >= len(items) {
+		return make([]T, 0), "", nil
+	}
+
+	if maxResults <= 0 || offset+maxResults >= len(items) {
+		return items[offset:], "", nil
+	}
+
+	nextPageToken, err := mkPageToken(offset + maxResults)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return items[offset : offset+maxResults], nextPageToken, nil
+}
+
+// PaginateFull returns the page of items starting at offset like Paginate, but with the token of the next page
+// whenever the page is full, like the SQL store does for runs and traces, even if no item is left.
+func PaginateFull[T any](items []T, offset, maxResults int) ([]T, string, *contract.Error) {
+	page, nextPageToken, err := Paginate(items, offset, maxResults)
+	if err != nil || nextPageToken != "" || maxResults <= 0 || len(page) != maxResults {
+		return page, nextPageToken, err
+	}
+
+	nextPageToken, err = mkPageToken(offset + maxResults)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return page, nextPageToken, nil
+}
@@ -0,0 +1,58 @@
+package search_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go/pkg/tracking/store/search"
+)
+
+func TestNextPageTokenRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	// The offsets cover the lengths of JSON whose base64 encoding ends with a partial block.
+	for _, offset := range []int{0, 10, 200, 10000} {
+		token, contractError := search.NextPageToken(6, 5, offset)
+		require.Nil(t, contractError)
+
+		decoded, contractError := search.ParsePageToken(token)
+		require.Nil(t, contractError)
+		assert.Equal(t, offset+5, decoded)
+	}
+
+	token, contractError := search.NextPageToken(5, 5, 0)
+	require.Nil(t, contractError)
+	assert.Empty(t, token)
+}
+
+func TestPaginate(t *testing.T) {
+	t.Parallel()
+
+	items := make([]int, 212)
+	for index := range items {
+		items[index] = index
+	}
+
+	offset := 0
+	token := ""
+
+	for _, expected := range []int{100, 100, 12} {
+		page, nextPageToken, contractError := search.Paginate(items, offset, 100)
+		require.Nil(t, contractError)
+		require.Len(t, page, expected)
+		assert.Equal(t, offset, page[0])
+
+		token = nextPageToken
+		if token == "" {
+			break
+		}
+
+		offset, contractError = search.ParsePageToken(token)
+		require.Nil(t, contractError)
+	}
+
+	assert.Equal(t, 200, offset)
+	assert.Empty(t, token)
+}
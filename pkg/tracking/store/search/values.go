@@ -0,0 +1,181 @@
+package search
+
+import (
+	"strconv"
+
+	"github.com/mlflow/mlflow-go/pkg/entities"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/tracking/service/query/parser"
+)
+
+const (
+	lifecycleStageActive  = "active"
+	lifecycleStageDeleted = "deleted"
+
+	datasetContextTagKey = "mlflow.data.context"
+)
+
+// MatchesViewType tells whether an experiment or run in lifecycleStage is one of viewType.
+func MatchesViewType(viewType protos.ViewType, lifecycleStage string) bool {
+	switch viewType {
+	case protos.ViewType_ACTIVE_ONLY:
+		return lifecycleStage == lifecycleStageActive
+	case protos.ViewType_DELETED_ONLY:
+		return lifecycleStage == lifecycleStageDeleted
+	default:
+		return true
+	}
+}
+
+// ExperimentValues is the Lookup of the filters and order_by clauses of experiments.
+func ExperimentValues(experiment *entities.Experiment) Lookup {
+	return func(identifier parser.ValidIdentifier, key string) []any {
+		if identifier == parser.Tag {
+			for _, tag := range experiment.Tags {
+				if tag.Key == key {
+					return []any{tag.Value}
+				}
+			}
+
+			return nil
+		}
+
+		switch key {
+		case parser.ExperimentName:
+			return []any{experiment.Name}
+		case parser.ExperimentCreationTime:
+			return []any{experiment.CreationTime}
+		case parser.ExperimentLastUpdateTime:
+			return []any{experiment.LastUpdateTime}
+		case "experiment_id":
+			// Experiment IDs are ordered as numbers, like in the experiments table.
+			if id, err := strconv.ParseInt(experiment.ExperimentID, 10, 64); err == nil {
+				return []any{id}
+			}
+
+			return []any{experiment.ExperimentID}
+		default:
+			return nil
+		}
+	}
+}
+
+// RunValues is the Lookup of the filters and order_by clauses of runs.
+//
+//nolint:cyclop
+func RunValues(run *entities.Run) Lookup {
+	return func(identifier parser.ValidIdentifier, key string) []any {
+		//nolint:exhaustive
+		switch identifier {
+		case parser.Metric:
+			for _, metric := range run.Data.Metrics {
+				if metric.Key == key {
+					return []any{metric.Value}
+				}
+			}
+		case parser.Parameter:
+			for _, param := range run.Data.Params {
+				if param.Key == key && param.Value != nil {
+					return []any{*param.Value}
+				}
+			}
+		case parser.Tag:
+			for _, tag := range run.Data.Tags {
+				if tag.Key == key {
+					return []any{tag.Value}
+				}
+			}
+		case parser.Dataset:
+			return datasetValues(run.Inputs.DatasetInputs, key)
+		case parser.Attribute:
+			return runAttributeValues(run.Info, key)
+		}
+
+		return nil
+	}
+}
+
+func runAttributeValues(info *entities.RunInfo, key string) []any {
+	switch key {
+	case "run_uuid":
+		return []any{info.RunID}
+	case parser.RunName:
+		return []any{info.RunName}
+	case "user_id":
+		return []any{info.UserID}
+	case "status":
+		return []any{info.Status}
+	case parser.StartTime:
+		return []any{info.StartTime}
+	case "end_time":
+		if info.EndTime != nil {
+			return []any{*info.EndTime}
+		}
+	case "artifact_uri":
+		return []any{info.ArtifactURI}
+	case "experiment_id":
+		return []any{info.ExperimentID}
+	case "lifecycle_stage":
+		return []any{info.LifecycleStage}
+	}
+
+	return nil
+}
+
+// datasetValues returns the values of a dataset key for the filters, one per input of the run.
+func datasetValues(inputs []*entities.DatasetInput, key string) []any {
+	values := make([]any, 0, len(inputs))
+
+	for _, input := range inputs {
+		switch key {
+		case "name":
+			values = append(values, input.Dataset.Name)
+		case "digest":
+			values = append(values, input.Dataset.Digest)
+		case "context":
+			for _, tag := range input.Tags {
+				if tag.Key == datasetContextTagKey {
+					values = append(values, tag.Value)
+				}
+			}
+		}
+	}
+
+	return values
+}
+
+// TraceValues is the Lookup of the filters and order_by clauses of traces.
+func TraceValues(traceInfo *entities.TraceInfo) Lookup {
+	return func(identifier parser.ValidIdentifier, key string) []any {
+		//nolint:exhaustive
+		switch identifier {
+		case parser.Tag:
+			for _, tag := range traceInfo.Tags {
+				if tag.Key == key {
+					return []any{tag.Value}
+				}
+			}
+		case parser.RequestMetadata:
+			for _, metadata := range traceInfo.TraceRequestMetadata {
+				if metadata.Key == key {
+					return []any{metadata.Value}
+				}
+			}
+		case parser.Attribute:
+			switch key {
+			case parser.TraceRequestID:
+				return []any{traceInfo.RequestID}
+			case parser.TraceStatus:
+				return []any{traceInfo.Status}
+			case parser.TraceTimestampMS:
+				return []any{traceInfo.TimestampMS}
+			case parser.TraceExecutionTimeMS:
+				if traceInfo.ExecutionTimeMS != nil {
+					return []any{*traceInfo.ExecutionTimeMS}
+				}
+			}
+		}
+
+		return nil
+	}
+}
@@ -94,7 +94,11 @@ func (s TrackingSQLStore) CreateExperiment(
 		}
 	}
 
-	if err := s.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+	if err := s.transaction(ctx, func(transaction *gorm.DB) error {
+		// The ID and artifact location of a rolled back attempt are reset for the transaction to be retried.
+		experiment.ID = 0
+		experiment.ArtifactLocation = artifactLocation
+
 		if err := transaction.Create(&experiment).Error; err != nil {
 			return fmt.Errorf("failed to insert experiment: %w", err)
 		}
@@ -146,7 +150,7 @@ func (s TrackingSQLStore) DeleteExperiment(ctx context.Context, id string) *cont
 		return err
 	}
 
-	if err := s.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+	if err := s.transaction(ctx, func(transaction *gorm.DB) error {
 		// Update experiment
 		uex := transaction.Model(&models.Experiment{}).
 			Where("experiment_id = ?", experimentID).
@@ -198,7 +202,7 @@ func (s TrackingSQLStore) RestoreExperiment(ctx context.Context, id string) *con
 		return err
 	}
 
-	if err := s.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+	if err := s.transaction(ctx, func(transaction *gorm.DB) error {
 		// Update experiment
 		uex := transaction.Model(&models.Experiment{}).
 			Where("experiment_id = ?", experimentID).
@@ -279,7 +283,8 @@ func (s TrackingSQLStore) SearchExperiments(
 	orderBy []string,
 	pageToken string,
 ) ([]*entities.Experiment, string, *contract.Error) {
-	query := applyExperimentsLifecycleStagesFilter(s.db.WithContext(ctx), experimentViewType)
+	database := s.reader(ctx)
+	query := applyExperimentsLifecycleStagesFilter(database, experimentViewType)
 
 	// apply Limit
 	query, limit := applyExperimentsLimitFilter(query, maxResults)
@@ -291,7 +296,7 @@ func (s TrackingSQLStore) SearchExperiments(
 	}
 
 	// Apply Filter
-	query, err = applyExperimentsFilter(s.db, query, filter)
+	query, err = applyExperimentsFilter(database, query, filter)
 	if err != nil {
 		return nil, "", err
 	}
@@ -347,7 +352,7 @@ func (s TrackingSQLStore) SetExperimentTag(
 		return err
 	}
 
-	if err := s.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+	if err := s.transaction(ctx, func(transaction *gorm.DB) error {
 		experimentTag := models.ExperimentTag{
 			ExperimentID: idInt,
 			Key:          key,
@@ -0,0 +1,127 @@
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go/pkg/tracking/store/sql/models"
+)
+
+//nolint:gochecknoglobals
+var experimentTests = []testData{
+	{
+		name:  "NameAndCreationTimeQuery",
+		query: "name ILIKE '%Test%' AND creation_time > 1000",
+		expectedSQL: map[string]string{
+			"postgres": `
+	SELECT "experiment_id" FROM "experiments"
+	WHERE experiments.name ILIKE $1 AND experiments.creation_time > $2`,
+		},
+		expectedVars: []any{"%Test%", int64(1000)},
+	},
+	{
+		name:  "NameILikeSqliteQuery",
+		query: "name ILIKE '%Test%' AND creation_time > 1000",
+		expectedSQL: map[string]string{
+			"sqlite": `
+	SELECT experiment_id FROM experiments
+	WHERE LOWER(experiments.name) LIKE ? AND experiments.creation_time > ?`,
+		},
+		expectedVars: []any{"%test%", int64(1000)},
+	},
+	{
+		name:  "TagQuery",
+		query: "tags.`mlflow.note` LIKE 'prod%' AND attributes.last_update_time <= 2000",
+		expectedSQL: map[string]string{
+			"postgres": `
+	SELECT "experiment_id" FROM "experiments"
+	JOIN (SELECT "experiment_id","value" FROM "experiment_tags" WHERE key = $1 AND value LIKE $2)
+	AS filter_0 ON experiments.experiment_id = filter_0.experiment_id
+	WHERE experiments.last_update_time <= $3`,
+			"mysql": `
+	SELECT experiment_id FROM experiments
+	JOIN (SELECT experiment_id,value FROM experiment_tags WHERE key = ? AND value LIKE ?)
+	AS filter_0 ON experiments.experiment_id = filter_0.experiment_id
+	WHERE experiments.last_update_time <= ?`,
+		},
+		expectedVars: []any{"mlflow.note", "prod%", int64(2000)},
+	},
+	{
+		name:  "OrGroupQuery",
+		query: "(tags.team = 'ml' OR name = 'Default') AND NOT name LIKE 'tmp%'",
+		expectedSQL: map[string]string{
+			"postgres": `
+	SELECT "experiment_id" FROM "experiments"
+	WHERE ((
+		experiments.experiment_id IN (SELECT "experiment_id" FROM "experiment_tags" WHERE key = $1 AND value = $2)
+		OR experiments.name = $3
+	)) AND NOT experiments.name LIKE $4`,
+			"sqlserver": `
+	SELECT "experiment_id" FROM "experiments"
+	WHERE ((
+		experiments.experiment_id IN (SELECT "experiment_id" FROM "experiment_tags" WHERE key = @p1 AND value = @p2)
+		OR experiments.name = @p3
+	)) AND NOT experiments.name LIKE @p4`,
+		},
+		expectedVars: []any{"team", "ml", "Default", "tmp%"},
+	},
+}
+
+func TestSearchExperimentsFilter(t *testing.T) {
+	t.Parallel()
+
+	// The sqlite mock of dialectors only expects to be opened once, by TestSearchRuns.
+	for _, dialector := range []gorm.Dialector{
+		newPostgresDialector(),
+		newSqliteDialector(),
+		newSQLServerDialector(),
+		newMySQLDialector(),
+	} {
+		database, err := gorm.Open(dialector, &gorm.Config{DryRun: true})
+		require.NoError(t, err)
+
+		dialectorName := database.Dialector.Name()
+
+		for _, testData := range experimentTests {
+			currentTestData := testData
+			if expectedSQL, ok := currentTestData.expectedSQL[dialectorName]; ok {
+				t.Run(currentTestData.name+"_"+dialectorName, func(t *testing.T) {
+					t.Parallel()
+
+					transaction, contractErr := applyExperimentsFilter(
+						database, database.Model(&models.Experiment{}), currentTestData.query,
+					)
+					require.Nil(t, contractErr)
+
+					sqlErr := transaction.Select("experiment_id").Find(&models.Experiment{}).Error
+					require.NoError(t, sqlErr)
+
+					assert.Equal(t, removeWhitespace(expectedSQL), removeWhitespace(transaction.Statement.SQL.String()))
+					assert.Equal(t, currentTestData.expectedVars, transaction.Statement.Vars)
+				})
+			}
+		}
+	}
+}
+
+func TestInvalidSearchExperimentsFilter(t *testing.T) {
+	t.Parallel()
+
+	database, err := gorm.Open(newSqliteDialector(), &gorm.Config{DryRun: true})
+	require.NoError(t, err)
+
+	for _, filter := range []string{
+		"name = unquoted",
+		"creation_time = 'yesterday'",
+		"creation_time LIKE '1%'",
+		"experiment_id = '1'",
+		"metrics.acc > 1",
+		"name = 'a' AND",
+	} {
+		_, contractErr := applyExperimentsFilter(database, database.Model(&models.Experiment{}), filter)
+		assert.NotNil(t, contractErr, filter)
+	}
+}
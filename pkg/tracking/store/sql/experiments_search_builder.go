@@ -5,35 +5,20 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
-	"strconv"
 	"strings"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
+	searchquery "github.com/mlflow/mlflow-go/pkg/tracking/service/query"
+
 	"github.com/mlflow/mlflow-go/pkg/contract"
 	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/tracking/service/query/parser"
 	"github.com/mlflow/mlflow-go/pkg/tracking/store/sql/models"
 )
 
-// supported expression list.
-const (
-	LikeExpression           = "LIKE"
-	ILikeExpression          = "ILIKE"
-	EqualExpression          = "="
-	NotEqualExpression       = "!="
-	LessExpression           = "<"
-	LessOrEqualExpression    = "<="
-	GreaterExpression        = ">"
-	GreaterOrEqualExpression = ">="
-)
-
-//nolint:lll
-var (
-	filterAnd       = regexp.MustCompile(`(?i)\s+AND\s+`)
-	filterCond      = regexp.MustCompile(`^(?:(\w+)\.)?("[^"]+"|` + "`[^`]+`" + `|[\w\.]+)\s+(<|<=|>|>=|=|!=|(?i:I?LIKE)|(?i:(?:NOT )?IN))\s+(\((?:'[^']+'(?:,\s*)?)+\)|"[^"]+"|'[^']+'|[\w\.]+)$`)
-	experimentOrder = regexp.MustCompile(`^(?:attr(?:ibutes?)?\.)?(\w+)(?i:\s+(ASC|DESC))?$`)
-)
+var experimentOrder = regexp.MustCompile(`^(?:attr(?:ibutes?)?\.)?(\w+)(?i:\s+(ASC|DESC))?$`)
 
 // PageToken.
 type PageToken struct {
@@ -139,120 +124,72 @@ func applyExperimentsOrderBy(query *gorm.DB, orderBy []string) (*gorm.DB, *contr
 	return query, nil
 }
 
-//nolint:funlen,gocognit,nestif,cyclop,goconst,mnd,forcetypeassert
+// experimentFilterCondition returns either a condition on the columns of experiments,
+// or the subquery selecting the experiments matching a tag comparison.
+func experimentFilterCondition(database *gorm.DB, clause *parser.ValidCompareExpr) (string, any, *gorm.DB) {
+	comparison := strings.ToUpper(clause.Operator.String())
+	value := clause.Value
+
+	column := "value"
+	if clause.Identifier == parser.Attribute {
+		column = "experiments." + clause.Key
+	}
+
+	where := fmt.Sprintf("%s %s ?", column, comparison)
+	if database.Dialector.Name() == "sqlite" && clause.Operator == parser.ILike {
+		where = fmt.Sprintf("LOWER(%s) LIKE ?", column)
+		value = lowerForSqliteILike(value)
+	}
+
+	if clause.Identifier == parser.Attribute {
+		return where, value, nil
+	}
+
+	// SELECT experiment_id, value FROM experiment_tags WHERE key = ? AND value comparison ?
+	return "", nil, database.Model(&models.ExperimentTag{}).Where("key = ?", clause.Key).Where(where, value)
+}
+
+// experimentCompareSQL returns the conditions on experiments of the comparisons of filterSQL.
+func experimentCompareSQL(database *gorm.DB) func(*parser.ValidCompareExpr) (string, []any) {
+	return func(clause *parser.ValidCompareExpr) (string, []any) {
+		condition, value, subquery := experimentFilterCondition(database, clause)
+		if subquery == nil {
+			return condition, []any{value}
+		}
+
+		return "experiments.experiment_id IN (?)", []any{subquery.Select("experiment_id")}
+	}
+}
+
 func applyExperimentsFilter(database, query *gorm.DB, filter string) (*gorm.DB, *contract.Error) {
-	if filter != "" {
-		for index, f := range filterAnd.Split(filter, -1) {
-			parts := filterCond.FindStringSubmatch(f)
-			if len(parts) != 5 {
-				return nil, contract.NewError(
-					protos.ErrorCode_INVALID_PARAMETER_VALUE,
-					fmt.Sprintf("malformed filter '%s'", f),
-				)
-			}
-
-			var value any = parts[4]
-
-			entity, key, comparison := parts[1], strings.Trim(parts[2], "\"`"), parts[3]
-
-			switch entity {
-			case "", "attribute", "attributes", "attr":
-				switch key {
-				case "creation_time", "last_update_time":
-					switch comparison {
-					case GreaterExpression, GreaterOrEqualExpression, NotEqualExpression,
-						EqualExpression, LessExpression, LessOrEqualExpression:
-						intValue, err := strconv.Atoi(value.(string))
-						if err != nil {
-							return nil, contract.NewError(
-								protos.ErrorCode_INVALID_PARAMETER_VALUE,
-								fmt.Sprintf("invalid numeric value '%s'", value),
-							)
-						}
-
-						value = intValue
-					default:
-						return nil, contract.NewError(
-							protos.ErrorCode_INVALID_PARAMETER_VALUE,
-							fmt.Sprintf(
-								"invalid numeric attribute comparison operator '%s'", comparison,
-							),
-						)
-					}
-				case "name":
-					switch strings.ToUpper(comparison) {
-					case NotEqualExpression, EqualExpression, LikeExpression, ILikeExpression:
-						if strings.HasPrefix(value.(string), "(") {
-							return nil, contract.NewError(
-								protos.ErrorCode_INVALID_PARAMETER_VALUE,
-								fmt.Sprintf("invalid string value '%s'", value),
-							)
-						}
-
-						value = strings.Trim(value.(string), `"'`)
-
-						if database.Dialector.Name() == "sqlite" && strings.ToUpper(comparison) == ILikeExpression {
-							key = fmt.Sprintf("LOWER(%s)", key)
-							comparison = LikeExpression
-							value = strings.ToLower(value.(string))
-						}
-					default:
-						return nil, contract.NewError(
-							protos.ErrorCode_INVALID_PARAMETER_VALUE,
-							fmt.Sprintf(
-								"invalid string attribute comparison operator '%s'", comparison,
-							),
-						)
-					}
-				default:
-					return nil, contract.NewError(
-						protos.ErrorCode_INVALID_PARAMETER_VALUE,
-						fmt.Sprintf(
-							"invalid attribute '%s'. Valid values are ['name', 'creation_time', 'last_update_time']", key,
-						),
-					)
-				}
-
-				query.Where(fmt.Sprintf("%s %s ?", key, comparison), value)
-			case "tag", "tags":
-				switch strings.ToUpper(comparison) {
-				case NotEqualExpression, EqualExpression, LikeExpression, ILikeExpression:
-					if strings.HasPrefix(value.(string), "(") {
-						return nil, contract.NewError(
-							protos.ErrorCode_INVALID_PARAMETER_VALUE,
-							fmt.Sprintf("invalid string value '%s'", value),
-						)
-					}
-
-					value = strings.Trim(value.(string), `"'`)
-				default:
-					return nil, contract.NewError(
-						protos.ErrorCode_INVALID_PARAMETER_VALUE,
-						fmt.Sprintf("invalid tag comparison operator '%s'", comparison),
-					)
-				}
-
-				table := fmt.Sprintf("filter_%d", index)
-				where := fmt.Sprintf("value %s ?", comparison)
-
-				if database.Dialector.Name() == "sqlite" && strings.ToUpper(comparison) == ILikeExpression {
-					where = "LOWER(value) LIKE ?"
-					value = strings.ToLower(value.(string))
-				}
-
-				query.Joins(
-					fmt.Sprintf("JOIN (?) AS %s ON experiments.experiment_id = %s.experiment_id", table, table),
-					database.Select(
-						"experiment_id", "value",
-					).Where("key = ?", key).Where(where, value).Model(&models.ExperimentTag{}),
-				)
-			default:
-				return nil, contract.NewError(
-					protos.ErrorCode_INVALID_PARAMETER_VALUE,
-					fmt.Sprintf("invalid entity type '%s'. Valid values are ['tag', 'tags', 'attribute']", entity),
-				)
-			}
+	filterExpr, err := searchquery.ParseExperimentFilter(filter)
+	if err != nil {
+		return nil, searchquery.NewFilterError(err)
+	}
+
+	// Like runs, tag comparisons of the root conjunction are joined
+	// and only the OR and NOT sub-trees need subqueries.
+	for index, expr := range filterExpr.Exprs {
+		clause, ok := expr.(*parser.ValidCompareExpr)
+		if !ok {
+			condition, vars := filterSQL(expr, experimentCompareSQL(database))
+			query.Where(condition, vars...)
+
+			continue
+		}
+
+		condition, value, subquery := experimentFilterCondition(database, clause)
+		if subquery == nil {
+			query.Where(condition, value)
+
+			continue
 		}
+
+		table := fmt.Sprintf("filter_%d", index)
+		query.Joins(
+			fmt.Sprintf("JOIN (?) AS %s ON experiments.experiment_id = %s.experiment_id", table, table),
+			subquery.Select("experiment_id", "value"),
+		)
 	}
 
 	return query, nil
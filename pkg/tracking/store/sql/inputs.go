@@ -151,7 +151,7 @@ func mkDataset(
 func (s TrackingSQLStore) LogInputs(
 	ctx context.Context, runID string, datasets []*entities.DatasetInput,
 ) *contract.Error {
-	err := s.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+	err := s.transaction(ctx, func(transaction *gorm.DB) error {
 		contractError := checkRunIsActive(transaction, runID)
 		if contractError != nil {
 			return contractError
@@ -239,3 +239,36 @@ func (s TrackingSQLStore) LogInputs(
 
 	return nil
 }
+
+// loadRunInputs loads the dataset inputs of runs, joined with their datasets in a single query,
+// instead of preloading the inputs and then their datasets.
+func loadRunInputs(ctx context.Context, database *gorm.DB, runs []models.Run) error {
+	if len(runs) == 0 {
+		return nil
+	}
+
+	runIDs := make([]string, 0, len(runs))
+	runIndexes := make(map[string]int, len(runs))
+
+	for index, run := range runs {
+		runIDs = append(runIDs, run.ID)
+		runIndexes[run.ID] = index
+	}
+
+	var inputs []models.Input
+	if err := database.WithContext(ctx).
+		Joins("Dataset").
+		Preload("Tags").
+		Where("inputs.destination_type = ?", models.DestinationTypeRun).
+		Where("inputs.destination_id IN ?", runIDs).
+		Find(&inputs).Error; err != nil {
+		return fmt.Errorf("failed to load run inputs: %w", err)
+	}
+
+	for _, input := range inputs {
+		run := &runs[runIndexes[input.DestinationID]]
+		run.Inputs = append(run.Inputs, input)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"slices"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -12,6 +13,7 @@ import (
 	"github.com/mlflow/mlflow-go/pkg/contract"
 	"github.com/mlflow/mlflow-go/pkg/entities"
 	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store"
 	"github.com/mlflow/mlflow-go/pkg/tracking/store/sql/models"
 )
 
@@ -162,7 +164,7 @@ func (s TrackingSQLStore) logMetricsWithTransaction(
 }
 
 func (s TrackingSQLStore) LogMetric(ctx context.Context, runID string, metric *entities.Metric) *contract.Error {
-	err := s.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+	err := s.transaction(ctx, func(transaction *gorm.DB) error {
 		contractError := checkRunIsActive(transaction, runID)
 		if contractError != nil {
 			return contractError
@@ -193,17 +195,35 @@ func (s TrackingSQLStore) LogMetric(ctx context.Context, runID string, metric *e
 }
 
 func (s TrackingSQLStore) GetMetricHistory(
-	ctx context.Context, runID, metricKey string,
-) ([]*entities.Metric, *contract.Error) {
-	var metrics []*models.Metric
-	if err := s.db.WithContext(
-		ctx,
-	).Where(
+	ctx context.Context, runID, metricKey, pageToken string, maxResults int,
+) ([]*entities.Metric, string, *contract.Error) {
+	offset, contractError := getOffset(pageToken)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	transaction := s.reader(ctx).Where(
 		"run_uuid = ?", runID,
 	).Where(
 		"key = ?", metricKey,
-	).Find(&metrics).Error; err != nil {
-		return nil, contract.NewError(
+	).Order(
+		"step",
+	).Order(
+		"timestamp",
+	).Order(
+		"value",
+	).Offset(
+		offset,
+	)
+
+	// A request without max_results is not paginated.
+	if maxResults > 0 {
+		transaction.Limit(maxResults)
+	}
+
+	var metrics []*models.Metric
+	if err := transaction.Find(&metrics).Error; err != nil {
+		return nil, "", contract.NewError(
 			protos.ErrorCode_INTERNAL_ERROR, fmt.Sprintf("error getting metric history: %v", err),
 		)
 	}
@@ -213,5 +233,158 @@ func (s TrackingSQLStore) GetMetricHistory(
 		entityMetrics[i] = metric.ToEntity()
 	}
 
-	return entityMetrics, nil
+	var nextPageToken string
+	if maxResults > 0 {
+		nextPageToken, contractError = mkNextPageToken(len(metrics), maxResults, offset)
+		if contractError != nil {
+			return nil, "", contractError
+		}
+	}
+
+	return entityMetrics, nextPageToken, nil
+}
+
+// Upper bound of metrics returned per run by GetMetricHistoryBulkInterval.
+const maxResultsGetMetricHistoryBulkInterval = 25000
+
+//nolint:funlen
+func getSampledSteps(
+	ctx context.Context,
+	database *gorm.DB,
+	runIDs []string,
+	metricKey string,
+	startStep, endStep *int64,
+	maxResults int,
+) ([]int64, *contract.Error) {
+	// We can't assume that every step was logged,
+	// so sampling needs to be done on the steps that actually exist.
+	var allSteps []int64
+	if err := database.WithContext(
+		ctx,
+	).Model(
+		&models.Metric{},
+	).Distinct(
+		"step",
+	).Where(
+		"run_uuid IN ?", runIDs,
+	).Where(
+		"key = ?", metricKey,
+	).Order(
+		"step",
+	).Pluck(
+		"step", &allSteps,
+	).Error; err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR, "error getting metric steps", err,
+		)
+	}
+
+	// The min and max step of every run are always part of the result.
+	var minMaxSteps []struct {
+		MinStep int64
+		MaxStep int64
+	}
+	if err := database.WithContext(
+		ctx,
+	).Model(
+		&models.Metric{},
+	).Select(
+		"MIN(step) AS min_step", "MAX(step) AS max_step",
+	).Where(
+		"run_uuid IN ?", runIDs,
+	).Where(
+		"key = ?", metricKey,
+	).Group(
+		"run_uuid",
+	).Scan(
+		&minMaxSteps,
+	).Error; err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR, "error getting metric steps", err,
+		)
+	}
+
+	var start, end int64
+	if startStep != nil && endStep != nil {
+		start, end = *startStep, *endStep
+	} else if len(allSteps) > 0 {
+		end = allSteps[len(allSteps)-1]
+	}
+
+	sampled := store.SampleSteps(start, end, maxResults, allSteps)
+
+	for _, minMax := range minMaxSteps {
+		for _, step := range []int64{minMax.MinStep, minMax.MaxStep} {
+			if start <= step && step <= end {
+				sampled[step] = struct{}{}
+			}
+		}
+	}
+
+	steps := make([]int64, 0, len(sampled))
+	for step := range sampled {
+		steps = append(steps, step)
+	}
+
+	slices.Sort(steps)
+
+	return steps, nil
+}
+
+func (s TrackingSQLStore) GetMetricHistoryBulkInterval(
+	ctx context.Context,
+	runIDs []string,
+	metricKey string,
+	startStep, endStep *int64,
+	maxResults int,
+) ([]*entities.MetricWithRunID, *contract.Error) {
+	// The steps and their metrics are read from the same database.
+	database := s.reader(ctx)
+
+	steps, contractError := getSampledSteps(ctx, database, runIDs, metricKey, startStep, endStep, maxResults)
+	if contractError != nil {
+		return nil, contractError
+	}
+
+	metricsWithRunID := make([]*entities.MetricWithRunID, 0)
+
+	if len(steps) == 0 {
+		return metricsWithRunID, nil
+	}
+
+	for _, runID := range runIDs {
+		var metrics []models.Metric
+		if err := database.WithContext(
+			ctx,
+		).Where(
+			"run_uuid = ?", runID,
+		).Where(
+			"key = ?", metricKey,
+		).Where(
+			"step IN ?", steps,
+		).Order(
+			"step",
+		).Order(
+			"timestamp",
+		).Order(
+			"value",
+		).Limit(
+			maxResultsGetMetricHistoryBulkInterval,
+		).Find(&metrics).Error; err != nil {
+			return nil, contract.NewErrorWith(
+				protos.ErrorCode_INTERNAL_ERROR,
+				fmt.Sprintf("error getting metric history for run %q", runID),
+				err,
+			)
+		}
+
+		for _, metric := range metrics {
+			metricsWithRunID = append(metricsWithRunID, &entities.MetricWithRunID{
+				Metric: metric.ToEntity(),
+				RunID:  metric.RunID,
+			})
+		}
+	}
+
+	return metricsWithRunID, nil
 }
@@ -2,6 +2,7 @@ package models
 
 import (
 	"database/sql"
+	"strconv"
 
 	"github.com/mlflow/mlflow-go/pkg/entities"
 	"github.com/mlflow/mlflow-go/pkg/utils"
@@ -86,7 +87,7 @@ func (r Run) ToEntity() *entities.Run {
 			RunID:          r.ID,
 			RunUUID:        r.ID,
 			RunName:        r.Name,
-			ExperimentID:   r.ExperimentID,
+			ExperimentID:   strconv.Itoa(int(r.ExperimentID)),
 			UserID:         r.UserID,
 			Status:         r.Status.String(),
 			StartTime:      r.StartTime,
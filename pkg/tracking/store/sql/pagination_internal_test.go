@@ -0,0 +1,26 @@
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextPageTokenRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	// The offsets cover the lengths of JSON whose base64 encoding ends with a partial block.
+	for _, offset := range []int{0, 10, 200, 10000} {
+		token, contractError := mkNextPageToken(5, 5, offset)
+		require.Nil(t, contractError)
+
+		decoded, contractError := getOffset(token)
+		require.Nil(t, contractError)
+		assert.Equal(t, offset+5, decoded)
+	}
+
+	token, contractError := mkNextPageToken(4, 5, 0)
+	require.Nil(t, contractError)
+	assert.Empty(t, token)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/mlflow/mlflow-go/pkg/contract"
 	"github.com/mlflow/mlflow-go/pkg/entities"
 	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store"
 	"github.com/mlflow/mlflow-go/pkg/tracking/store/sql/models"
 	"github.com/mlflow/mlflow-go/pkg/utils"
 )
@@ -64,8 +65,10 @@ func (s TrackingSQLStore) SearchRuns(
 	experimentIDs []string, filter string,
 	runViewType protos.ViewType, maxResults int, orderBy []string, pageToken string,
 ) ([]*entities.Run, string, *contract.Error) {
+	database := s.reader(ctx)
+
 	// ViewType
-	transaction := s.db.WithContext(ctx).Where(
+	transaction := database.Where(
 		"runs.experiment_id IN ?", experimentIDs,
 	).Where(
 		"runs.lifecycle_stage IN ?", applyLifecycleStagesFilter(runViewType),
@@ -75,31 +78,50 @@ func (s TrackingSQLStore) SearchRuns(
 	transaction.Limit(maxResults)
 
 	// PageToken
-	offset, contractError := getOffset(pageToken)
+	token, contractError := parseRunsPageToken(pageToken)
 	if contractError != nil {
 		return nil, "", contractError
 	}
 
+	offset := int(token.Offset)
 	transaction.Offset(offset)
 
 	// Filter
-	contractError = applyFilter(ctx, s.db, transaction, filter)
+	contractError = applyFilter(ctx, database, transaction, filter)
 	if contractError != nil {
 		return nil, "", contractError
 	}
 
 	// OrderBy
-	contractError = applyOrderBy(ctx, s.db, transaction, orderBy)
+	orderKeys, contractError := applyOrderBy(ctx, database, transaction, orderBy)
 	if contractError != nil {
 		return nil, "", contractError
 	}
 
+	if token.After != nil {
+		if contractError := seekAfter(transaction, orderKeys, token.After); contractError != nil {
+			return nil, "", contractError
+		}
+	}
+
 	// Actual query
 	var runs []models.Run
 
-	transaction.Preload("LatestMetrics").Preload("Params").Preload("Tags").
-		Preload("Inputs", "inputs.destination_type = 'RUN'").
-		Preload("Inputs.Dataset").Preload("Inputs.Tags").Find(&runs)
+	sections := store.GetRunSectionsFromContext(ctx)
+
+	if sections.Has(store.RunSectionMetrics) {
+		transaction.Preload("LatestMetrics")
+	}
+
+	if sections.Has(store.RunSectionParams) {
+		transaction.Preload("Params")
+	}
+
+	if sections.Has(store.RunSectionTags) {
+		transaction.Preload("Tags")
+	}
+
+	transaction.Find(&runs)
 
 	if transaction.Error != nil {
 		return nil, "", contract.NewErrorWith(
@@ -109,11 +131,32 @@ func (s TrackingSQLStore) SearchRuns(
 		)
 	}
 
+	if sections.Has(store.RunSectionInputs) {
+		if err := loadRunInputs(ctx, database, runs); err != nil {
+			return nil, "", contract.NewErrorWith(
+				protos.ErrorCode_INTERNAL_ERROR,
+				"Failed to query search runs",
+				err,
+			)
+		}
+	}
+
 	entityRuns := make([]*entities.Run, len(runs))
 	for i, run := range runs {
 		entityRuns[i] = run.ToEntity()
 	}
 
+	if s.config.SearchRunsKeysetPagination && len(runs) > 0 && len(runs) == maxResults {
+		nextPageToken, ok, contractError := mkKeysetPageToken(orderKeys, &runs[len(runs)-1], sections)
+		if contractError != nil {
+			return nil, "", contractError
+		}
+
+		if ok {
+			return entityRuns, nextPageToken, nil
+		}
+	}
+
 	nextPageToken, contractError := mkNextPageToken(len(runs), maxResults, offset)
 	if contractError != nil {
 		return nil, "", contractError
@@ -184,7 +227,7 @@ func ensureRunName(runModel *models.Run) *contract.Error {
 
 func (s TrackingSQLStore) GetRun(ctx context.Context, runID string) (*entities.Run, *contract.Error) {
 	var run models.Run
-	if err := s.db.WithContext(ctx).Where(
+	if err := s.reader(ctx).Where(
 		"run_uuid = ?", runID,
 	).Preload(
 		"Tags",
@@ -262,7 +305,7 @@ func (s TrackingSQLStore) CreateRun(
 		return nil, errRunName
 	}
 
-	if err := s.db.Create(&runModel).Error; err != nil {
+	if err := s.db.WithContext(ctx).Create(&runModel).Error; err != nil {
 		return nil, contract.NewErrorWith(
 			protos.ErrorCode_INTERNAL_ERROR,
 			fmt.Sprintf(
@@ -304,7 +347,7 @@ func (s TrackingSQLStore) UpdateRun(
 		endTimeValue = sql.NullInt64{Int64: *endTime, Valid: true}
 	}
 
-	if err := s.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+	if err := s.transaction(ctx, func(transaction *gorm.DB) error {
 		if err := transaction.Model(&models.Run{}).
 			Where("run_uuid = ?", runID).
 			Updates(&models.Run{
@@ -332,7 +375,7 @@ func (s TrackingSQLStore) UpdateRun(
 }
 
 func (s TrackingSQLStore) DeleteRun(ctx context.Context, runID string) *contract.Error {
-	run, err := s.GetRun(ctx, runID)
+	run, err := s.GetRun(s.writeContext(ctx), runID)
 	if err != nil {
 		return err
 	}
@@ -350,7 +393,7 @@ func (s TrackingSQLStore) DeleteRun(ctx context.Context, runID string) *contract
 }
 
 func (s TrackingSQLStore) RestoreRun(ctx context.Context, runID string) *contract.Error {
-	run, err := s.GetRun(ctx, runID)
+	run, err := s.GetRun(s.writeContext(ctx), runID)
 	if err != nil {
 		return err
 	}
@@ -372,7 +415,7 @@ func (s TrackingSQLStore) RestoreRun(ctx context.Context, runID string) *contrac
 func (s TrackingSQLStore) LogBatch(
 	ctx context.Context, runID string, metrics []*entities.Metric, params []*entities.Param, tags []*entities.RunTag,
 ) *contract.Error {
-	err := s.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+	err := s.transaction(ctx, func(transaction *gorm.DB) error {
 		contractError := checkRunIsActive(transaction, runID)
 		if contractError != nil {
 			return contractError
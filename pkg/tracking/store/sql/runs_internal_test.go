@@ -283,6 +283,151 @@ var tests = []testData{
 		},
 		expectedVars: []any{"accuracy", 0.72, "batch_size", "%a"},
 	},
+	{
+		name:  "OrGroupAndMetricQuery",
+		query: "(params.optimizer = 'adam' OR params.optimizer = 'sgd') AND metrics.acc > 0.9",
+		expectedSQL: map[string]string{
+			"postgres": `
+	SELECT "run_uuid" FROM "runs"
+	JOIN (SELECT "run_uuid","value" FROM "latest_metrics" WHERE key = $1 AND value > $2)
+	AS filter_1 ON runs.run_uuid = filter_1.run_uuid
+	WHERE (
+		runs.run_uuid IN (SELECT "run_uuid" FROM "params" WHERE key = $3 AND value = $4)
+		OR runs.run_uuid IN (SELECT "run_uuid" FROM "params" WHERE key = $5 AND value = $6)
+	)
+	ORDER BY runs.start_time DESC,runs.run_uuid`,
+			"sqlite": `
+	SELECT run_uuid FROM runs
+	JOIN (SELECT run_uuid,value FROM latest_metrics WHERE key = ? AND value > ?)
+	AS filter_1 ON runs.run_uuid = filter_1.run_uuid
+	WHERE (
+		runs.run_uuid IN (SELECT run_uuid FROM params WHERE key = ? AND value = ?)
+		OR runs.run_uuid IN (SELECT run_uuid FROM params WHERE key = ? AND value = ?)
+	)
+	ORDER BY runs.start_time DESC,runs.run_uuid`,
+		},
+		expectedVars: []any{"acc", 0.9, "optimizer", "adam", "optimizer", "sgd"},
+	},
+	{
+		name:  "NotOrQuery",
+		query: "NOT (tags.task ILIKE 'classif%' OR attributes.status = 'FAILED')",
+		expectedSQL: map[string]string{
+			"postgres": `
+	SELECT "run_uuid" FROM "runs"
+	WHERE NOT (
+		runs.run_uuid IN (SELECT "run_uuid" FROM "tags" WHERE key = $1 AND value ILIKE $2)
+		OR runs.status = $3
+	)
+	ORDER BY runs.start_time DESC,runs.run_uuid`,
+			"sqlite": `
+	SELECT run_uuid FROM runs
+	WHERE NOT (
+		runs.run_uuid IN (SELECT run_uuid FROM tags WHERE key = ? AND LOWER(value) LIKE ?)
+		OR runs.status = ?
+	)
+	ORDER BY runs.start_time DESC,runs.run_uuid`,
+			"sqlserver": `
+	SELECT "run_uuid" FROM "runs"
+	WHERE NOT (
+		runs.run_uuid IN (SELECT "run_uuid" FROM "tags" WHERE key = @p1 AND value ILIKE @p2)
+		OR runs.status = @p3
+	)
+	ORDER BY runs.start_time DESC,runs.run_uuid`,
+			"mysql": `
+	SELECT run_uuid FROM runs
+	WHERE NOT (
+		runs.run_uuid IN (SELECT run_uuid FROM tags WHERE key = ? AND value ILIKE ?)
+		OR runs.status = ?
+	)
+	ORDER BY runs.start_time DESC,runs.run_uuid`,
+		},
+		expectedVars: []any{"task", "classif%", "FAILED"},
+	},
+	{
+		name:  "ParamIsNullTagIsNotNullQuery",
+		query: "params.optimizer IS NULL AND tags.task IS NOT NULL",
+		expectedSQL: map[string]string{
+			"postgres": `
+	SELECT "run_uuid" FROM "runs"
+	LEFT JOIN (SELECT "run_uuid" FROM "params" WHERE key = $1)
+	AS filter_0 ON runs.run_uuid = filter_0.run_uuid
+	JOIN (SELECT "run_uuid" FROM "tags" WHERE key = $2)
+	AS filter_1 ON runs.run_uuid = filter_1.run_uuid
+	WHERE filter_0.run_uuid IS NULL
+	ORDER BY runs.start_time DESC,runs.run_uuid`,
+			"sqlite": `
+	SELECT run_uuid FROM runs
+	LEFT JOIN (SELECT run_uuid FROM params WHERE key = ?)
+	AS filter_0 ON runs.run_uuid = filter_0.run_uuid
+	JOIN (SELECT run_uuid FROM tags WHERE key = ?)
+	AS filter_1 ON runs.run_uuid = filter_1.run_uuid
+	WHERE filter_0.run_uuid IS NULL
+	ORDER BY runs.start_time DESC,runs.run_uuid`,
+		},
+		expectedVars: []any{"optimizer", "task"},
+	},
+	{
+		name:  "MetricIsNullInOrQuery",
+		query: "metrics.acc IS NULL OR params.optimizer IS NOT NULL",
+		expectedSQL: map[string]string{
+			"postgres": `
+	SELECT "run_uuid" FROM "runs"
+	WHERE (
+		NOT EXISTS (SELECT 1 FROM "latest_metrics" WHERE key = $1 AND latest_metrics.run_uuid = runs.run_uuid)
+		OR runs.run_uuid IN (SELECT "run_uuid" FROM "params" WHERE key = $2)
+	)
+	ORDER BY runs.start_time DESC,runs.run_uuid`,
+			"sqlite": `
+	SELECT run_uuid FROM runs
+	WHERE (
+		NOT EXISTS (SELECT 1 FROM latest_metrics WHERE key = ? AND latest_metrics.run_uuid = runs.run_uuid)
+		OR runs.run_uuid IN (SELECT run_uuid FROM params WHERE key = ?)
+	)
+	ORDER BY runs.start_time DESC,runs.run_uuid`,
+		},
+		expectedVars: []any{"acc", "optimizer"},
+	},
+	{
+		name:  "ParamRunNameIsNullQuery",
+		query: "params.run_name IS NULL",
+		expectedSQL: map[string]string{
+			"postgres": `
+	SELECT "run_uuid" FROM "runs"
+	LEFT JOIN (SELECT "run_uuid" FROM "params" WHERE key = $1)
+	AS filter_0 ON runs.run_uuid = filter_0.run_uuid
+	WHERE filter_0.run_uuid IS NULL
+	ORDER BY runs.start_time DESC,runs.run_uuid`,
+		},
+		expectedVars: []any{"run_name"},
+	},
+	{
+		name:  "MetricRunNameIsNullInOrQuery",
+		query: "metrics.run_name IS NULL OR attributes.run_name = 'a'",
+		expectedSQL: map[string]string{
+			"postgres": `
+	SELECT "run_uuid" FROM "runs"
+	WHERE (
+		NOT EXISTS (SELECT 1 FROM "latest_metrics" WHERE key = $1 AND latest_metrics.run_uuid = runs.run_uuid)
+		OR runs.run_uuid IN (SELECT "run_uuid" FROM "tags" WHERE key = $2 AND value = $3)
+	)
+	ORDER BY runs.start_time DESC,runs.run_uuid`,
+		},
+		expectedVars: []any{"run_name", "mlflow.runName", "a"},
+	},
+	{
+		name:  "ParamRunNameIsNullInOrQuery",
+		query: "params.run_name IS NULL OR params.optimizer IS NOT NULL",
+		expectedSQL: map[string]string{
+			"sqlite": `
+	SELECT run_uuid FROM runs
+	WHERE (
+		NOT EXISTS (SELECT 1 FROM params WHERE key = ? AND params.run_uuid = runs.run_uuid)
+		OR runs.run_uuid IN (SELECT run_uuid FROM params WHERE key = ?)
+	)
+	ORDER BY runs.start_time DESC,runs.run_uuid`,
+		},
+		expectedVars: []any{"run_name", "optimizer"},
+	},
 	{
 		name:    "OrderByStartTimeASC",
 		query:   "",
@@ -388,7 +533,7 @@ func assertTestData(
 		t.Fatal("contractErr: ", contractErr)
 	}
 
-	contractErr = applyOrderBy(context.Background(), database, transaction, testData.orderBy)
+	_, contractErr = applyOrderBy(context.Background(), database, transaction, testData.orderBy)
 	if contractErr != nil {
 		t.Fatal("contractErr: ", contractErr)
 	}
@@ -64,139 +64,259 @@ func getOffset(pageToken string) (int, *contract.Error) {
 	return 0, nil
 }
 
-//nolint:funlen,cyclop,gocognit
-func applyFilter(ctx context.Context, database, transaction *gorm.DB, filter string) *contract.Error {
-	filterConditions, err := query.ParseFilter(filter)
-	if err != nil {
-		return contract.NewErrorWith(
-			protos.ErrorCode_INVALID_PARAMETER_VALUE,
-			"error parsing search filter",
-			err,
-		)
+// filterSubquery selects the runs matching a comparison on a table related to runs.
+type filterSubquery struct {
+	query *gorm.DB
+	// runIDColumn is the column of query holding the run ID.
+	runIDColumn string
+	// joinedColumns are selected instead when the subquery is joined,
+	// joinedRunIDColumn being the name of the run ID among them.
+	joinedColumns     []string
+	joinedRunIDColumn string
+	// antiJoin is set for IS NULL, the condition then matches the runs missing from query.
+	// correlation is the condition tying query to the outer runs table for NOT EXISTS.
+	antiJoin    bool
+	correlation string
+}
+
+// lowerForSqliteILike lowers value for "LOWER(column) LIKE ?", sqlite has no ILIKE.
+func lowerForSqliteILike(value any) any {
+	if str, ok := value.(string); ok {
+		return strings.ToLower(str)
 	}
 
-	utils.GetLoggerFromContext(ctx).Debugf("Filter conditions: %v", filterConditions)
+	return value
+}
 
-	for index, clause := range filterConditions {
-		var kind any
+// nullFilterTables are the tables searched by IS NULL and IS NOT NULL.
+var nullFilterTables = map[parser.ValidIdentifier]string{
+	parser.Metric:    "latest_metrics",
+	parser.Parameter: "params",
+	parser.Tag:       "tags",
+}
 
-		key := clause.Key
-		comparison := strings.ToUpper(clause.Operator.String())
-		value := clause.Value
-
-		switch clause.Identifier {
-		case parser.Metric:
-			kind = &models.LatestMetric{}
-		case parser.Parameter:
-			kind = &models.Param{}
-		case parser.Tag:
-			kind = &models.Tag{}
-		case parser.Dataset:
-			kind = &models.Dataset{}
-		case parser.Attribute:
-			kind = nil
+// filterCondition returns either a condition on the columns of runs,
+// or the subquery selecting the runs matching clause.
+//
+//nolint:funlen,cyclop
+func filterCondition(database *gorm.DB, clause *parser.ValidCompareExpr) (string, any, *filterSubquery) {
+	var kind any
+
+	identifier := clause.Identifier
+	key := clause.Key
+	comparison := strings.ToUpper(clause.Operator.String())
+	value := clause.Value
+
+	// Treat "attributes.run_name == <value>" as "tags.`mlflow.runName` == <value>".
+	// The name column in the runs table is empty for runs logged in MLflow <= 1.29.0.
+	if identifier == parser.Attribute && key == "run_name" {
+		identifier = parser.Tag
+		key = utils.TagRunName
+	}
+
+	switch identifier {
+	case parser.Metric:
+		kind = &models.LatestMetric{}
+	case parser.Parameter:
+		kind = &models.Param{}
+	case parser.Tag:
+		kind = &models.Tag{}
+	case parser.Dataset:
+		kind = &models.Dataset{}
+	case parser.Attribute:
+		kind = nil
+	}
+
+	if clause.Operator == parser.IsNull || clause.Operator == parser.IsNotNull {
+		// SELECT run_uuid FROM params WHERE key = ?
+		// Keys are unique per run for metrics, params and tags, so joining it doesn't duplicate runs.
+		query := database.Model(kind).Where("key = ?", key)
+
+		return "", nil, &filterSubquery{
+			query:             query,
+			runIDColumn:       "run_uuid",
+			joinedColumns:     []string{"run_uuid"},
+			joinedRunIDColumn: "run_uuid",
+			antiJoin:          clause.Operator == parser.IsNull,
+			correlation:       nullFilterTables[identifier] + ".run_uuid = runs.run_uuid",
 		}
+	}
+
+	isSqliteAndILike := database.Dialector.Name() == "sqlite" && comparison == "ILIKE"
 
-		// Treat "attributes.run_name == <value>" as "tags.`mlflow.runName` == <value>".
-		// The name column in the runs table is empty for runs logged in MLflow <= 1.29.0.
-		if key == "run_name" {
-			kind = &models.Tag{}
-			key = utils.TagRunName
+	switch {
+	case kind == nil:
+		if isSqliteAndILike {
+			return fmt.Sprintf("LOWER(runs.%s) LIKE ?", key), lowerForSqliteILike(value), nil
 		}
 
-		isSqliteAndILike := database.Dialector.Name() == "sqlite" && comparison == "ILIKE"
-		table := fmt.Sprintf("filter_%d", index)
+		return fmt.Sprintf("runs.%s %s ?", key, comparison), value, nil
+	case identifier == parser.Dataset && key == "context":
+		// SELECT inputs.destination_id AS run_uuid
+		// FROM inputs
+		// JOIN input_tags
+		// ON inputs.input_uuid = input_tags.input_uuid
+		// AND input_tags.name = 'mlflow.data.context'
+		// AND input_tags.value %s ?
+		// WHERE inputs.destination_type = 'RUN'
+		valueColumn := "input_tags.value "
+		if isSqliteAndILike {
+			valueColumn = "LOWER(input_tags.value) "
+			comparison = "LIKE"
+			value = lowerForSqliteILike(value)
+		}
 
-		switch {
-		case kind == nil:
-			if isSqliteAndILike {
-				key = fmt.Sprintf("LOWER(runs.%s)", key)
-				comparison = "LIKE"
+		return "", nil, &filterSubquery{
+			query: database.
+				Joins(
+					"JOIN input_tags ON inputs.input_uuid = input_tags.input_uuid"+
+						" AND input_tags.name = 'mlflow.data.context'"+
+						" AND "+valueColumn+comparison+" ?",
+					value,
+				).
+				Where("inputs.destination_type = 'RUN'").
+				Model(&models.Input{}),
+			runIDColumn:       "inputs.destination_id",
+			joinedColumns:     []string{"inputs.destination_id AS run_uuid"},
+			joinedRunIDColumn: "run_uuid",
+		}
+	case identifier == parser.Dataset:
+		// SELECT "experiment_id", key
+		// FROM datasests d
+		// JOIN inputs ON inputs.source_id = datasets.dataset_uuid
+		// WHERE key comparison value
+		//
+		// columns: name, digest, context
+		where := key + " " + comparison + " ?"
+		if isSqliteAndILike {
+			where = "LOWER(" + key + ") LIKE ?"
+			value = lowerForSqliteILike(value)
+		}
 
-				if str, ok := value.(string); ok {
-					value = strings.ToLower(str)
-				}
+		return "", nil, &filterSubquery{
+			query: database.Model(kind).
+				Joins("JOIN inputs ON inputs.source_id = datasets.dataset_uuid").
+				Where(where, value),
+			runIDColumn:       "destination_id",
+			joinedColumns:     []string{"destination_id", key},
+			joinedRunIDColumn: "destination_id",
+		}
+	default:
+		// SELECT run_uuid, value FROM latest_metrics WHERE key = ? AND value comparison ?
+		where := fmt.Sprintf("value %s ?", comparison)
+		if isSqliteAndILike {
+			where = "LOWER(value) LIKE ?"
+			value = lowerForSqliteILike(value)
+		}
 
-				transaction.Where(fmt.Sprintf("%s %s ?", key, comparison), value)
-			} else {
-				transaction.Where(fmt.Sprintf("runs.%s %s ?", key, comparison), value)
-			}
-		case clause.Identifier == parser.Dataset && key == "context":
-			// SELECT *
-			// FROM runs
-			// JOIN (
-			//   SELECT inputs.destination_id AS run_uuid
-			//   FROM inputs
-			//   JOIN input_tags
-			//   ON inputs.input_uuid = input_tags.input_uuid
-			//   AND input_tags.name = 'mlflow.data.context'
-			//   AND input_tags.value %s ?
-			//   WHERE inputs.destination_type = 'RUN'
-			// ) AS filter_0
-			// ON runs.run_uuid = filter_0.run_uuid
-			valueColumn := "input_tags.value "
-			if isSqliteAndILike {
-				valueColumn = "LOWER(input_tags.value) "
-				comparison = "LIKE"
-
-				if str, ok := value.(string); ok {
-					value = strings.ToLower(str)
-				}
-			}
+		return "", nil, &filterSubquery{
+			query:             database.Where("key = ?", key).Where(where, value).Model(kind),
+			runIDColumn:       "run_uuid",
+			joinedColumns:     []string{"run_uuid", "value"},
+			joinedRunIDColumn: "run_uuid",
+		}
+	}
+}
 
-			transaction.Joins(
-				fmt.Sprintf("JOIN (?) AS %s ON runs.run_uuid = %s.run_uuid", table, table),
-				database.Select("inputs.destination_id AS run_uuid").
-					Joins(
-						"JOIN input_tags ON inputs.input_uuid = input_tags.input_uuid"+
-							" AND input_tags.name = 'mlflow.data.context'"+
-							" AND "+valueColumn+comparison+" ?",
-						value,
-					).
-					Where("inputs.destination_type = 'RUN'").
-					Model(&models.Input{}),
-			)
-		case clause.Identifier == parser.Dataset:
-			// add join with datasets
-			// JOIN (
-			// 		SELECT "experiment_id", key
-			//		FROM datasests d
-			// 		JOIN inputs ON inputs.source_id = datasets.dataset_uuid
-			//		WHERE key comparison value
-			// ) AS filter_0 ON runs.experiment_id = dataset.experiment_id
-			//
-			// columns: name, digest, context
-			where := key + " " + comparison + " ?"
-			if isSqliteAndILike {
-				where = "LOWER(" + key + ") LIKE ?"
-
-				if str, ok := value.(string); ok {
-					value = strings.ToLower(str)
-				}
-			}
+// filterSQL translates a filter tree to a condition, parenthesised to keep the precedence
+// of AND, OR and NOT on every dialect. The conditions of the comparisons are given by compare.
+func filterSQL(expr parser.Expr, compare func(*parser.ValidCompareExpr) (string, []any)) (string, []any) {
+	joinExprs := func(exprs []parser.Expr, separator string) (string, []any) {
+		conditions := make([]string, 0, len(exprs))
+		vars := make([]any, 0, len(exprs))
+
+		for _, expr := range exprs {
+			condition, conditionVars := filterSQL(expr, compare)
+			conditions = append(conditions, condition)
+			vars = append(vars, conditionVars...)
+		}
 
-			transaction.Joins(
-				fmt.Sprintf("JOIN (?) AS %s ON runs.run_uuid = %s.destination_id", table, table),
-				database.Model(kind).
-					Joins("JOIN inputs ON inputs.source_id = datasets.dataset_uuid").
-					Where(where, value).
-					Select("destination_id", key),
-			)
-		default:
-			where := fmt.Sprintf("value %s ?", comparison)
-			if isSqliteAndILike {
-				where = "LOWER(value) LIKE ?"
-
-				if str, ok := value.(string); ok {
-					value = strings.ToLower(str)
-				}
-			}
+		return "(" + strings.Join(conditions, separator) + ")", vars
+	}
+
+	switch expr := expr.(type) {
+	case *parser.AndExpr:
+		return joinExprs(expr.Exprs, " AND ")
+	case *parser.OrExpr:
+		return joinExprs(expr.Exprs, " OR ")
+	case *parser.NotExpr:
+		condition, vars := filterSQL(expr.Expr, compare)
+
+		return "NOT " + condition, vars
+	case *parser.ValidCompareExpr:
+		return compare(expr)
+	default:
+		// Validated trees only hold the nodes above.
+		return "1 = 1", nil
+	}
+}
 
+// runCompareSQL returns the conditions on runs of the comparisons of filterSQL.
+// Comparisons on related tables become "runs.run_uuid IN (subquery)", as joins can't express OR and NOT.
+func runCompareSQL(database *gorm.DB) func(*parser.ValidCompareExpr) (string, []any) {
+	return func(clause *parser.ValidCompareExpr) (string, []any) {
+		condition, value, subquery := filterCondition(database, clause)
+		if subquery == nil {
+			return condition, []any{value}
+		}
+
+		if subquery.antiJoin {
+			// NOT EXISTS is planned as an anti-join, unlike NOT IN which has to handle NULLs.
+			return "NOT EXISTS (?)", []any{subquery.query.Select("1").Where(subquery.correlation)}
+		}
+
+		return "runs.run_uuid IN (?)", []any{subquery.query.Select(subquery.runIDColumn)}
+	}
+}
+
+func applyFilter(ctx context.Context, database, transaction *gorm.DB, filter string) *contract.Error {
+	filterExpr, err := query.ParseFilter(filter)
+	if err != nil {
+		return query.NewFilterError(err)
+	}
+
+	utils.GetLoggerFromContext(ctx).Debugf("Filter conditions: %v", filterExpr)
+
+	// The comparisons of the root conjunction are joined, like mlflow does.
+	// Only the OR and NOT sub-trees need subqueries.
+	for index, expr := range filterExpr.Exprs {
+		clause, ok := expr.(*parser.ValidCompareExpr)
+		if !ok {
+			condition, vars := filterSQL(expr, runCompareSQL(database))
+			transaction.Where(condition, vars...)
+
+			continue
+		}
+
+		condition, value, subquery := filterCondition(database, clause)
+		if subquery == nil {
+			transaction.Where(condition, value)
+
+			continue
+		}
+
+		table := fmt.Sprintf("filter_%d", index)
+
+		if subquery.antiJoin {
+			// LEFT JOIN (?) AS filter_0 ON runs.run_uuid = filter_0.run_uuid WHERE filter_0.run_uuid IS NULL
 			transaction.Joins(
-				fmt.Sprintf("JOIN (?) AS %s ON runs.run_uuid = %s.run_uuid", table, table),
-				database.Select("run_uuid", "value").Where("key = ?", key).Where(where, value).Model(kind),
-			)
+				fmt.Sprintf(
+					"LEFT JOIN (?) AS %s ON runs.run_uuid = %s.%s",
+					table, table, subquery.joinedRunIDColumn,
+				),
+				subquery.query.Select(subquery.joinedColumns),
+			).Where(fmt.Sprintf("%s.%s IS NULL", table, subquery.joinedRunIDColumn))
+
+			continue
 		}
+
+		transaction.Joins(
+			fmt.Sprintf(
+				"JOIN (?) AS %s ON runs.run_uuid = %s.%s",
+				table, table, subquery.joinedRunIDColumn,
+			),
+			subquery.query.Select(subquery.joinedColumns),
+		)
 	}
 
 	return nil
@@ -336,15 +456,20 @@ func processOrderByClause(input string) (orderByExpr, error) {
 	return expr, nil
 }
 
+// applyOrderBy sorts the runs and returns the keys they are sorted by, from the first to the last.
+//
 //nolint:funlen, cyclop, gocognit
-func applyOrderBy(ctx context.Context, database, transaction *gorm.DB, orderBy []string) *contract.Error {
+func applyOrderBy(
+	ctx context.Context, database, transaction *gorm.DB, orderBy []string,
+) ([]orderKey, *contract.Error) {
 	startTimeOrder := false
 	columnSelection := "runs.*"
+	orderKeys := make([]orderKey, 0, 2*len(orderBy)+2)
 
 	for index, orderByClause := range orderBy {
 		orderByExpr, err := processOrderByClause(orderByClause)
 		if err != nil {
-			return contract.NewError(
+			return nil, contract.NewError(
 				protos.ErrorCode_INVALID_PARAMETER_VALUE,
 				fmt.Sprintf(
 					"invalid order_by clause %q.",
@@ -380,6 +505,7 @@ func applyOrderBy(ctx context.Context, database, transaction *gorm.DB, orderBy [
 		}
 
 		table := fmt.Sprintf("order_%d", index)
+		key := newOrderKey(orderByExpr)
 
 		if kind != nil {
 			columnsInJoin := []string{"run_uuid", "value"}
@@ -393,6 +519,7 @@ func applyOrderBy(ctx context.Context, database, transaction *gorm.DB, orderBy [
 			)
 
 			orderByExpr.key = table + ".value"
+			key.column = orderByExpr.key
 		}
 
 		desc := false
@@ -400,6 +527,8 @@ func applyOrderBy(ctx context.Context, database, transaction *gorm.DB, orderBy [
 			desc = *orderByExpr.order == "DESC"
 		}
 
+		key.desc = desc
+
 		nullableColumnAlias := fmt.Sprintf("order_null_%d", index)
 
 		if orderByExpr.identifier == nil || *orderByExpr.identifier != metric {
@@ -414,14 +543,12 @@ func applyOrderBy(ctx context.Context, database, transaction *gorm.DB, orderBy [
 				originalColumn = orderByExpr.key
 			}
 
-			columnSelection = fmt.Sprintf(
-				"%s, (CASE WHEN (%s IS NULL) THEN 1 ELSE 0 END) AS %s",
-				columnSelection,
-				originalColumn,
-				nullableColumnAlias,
-			)
+			nullableColumn := fmt.Sprintf("(CASE WHEN (%s IS NULL) THEN 1 ELSE 0 END)", originalColumn)
+			columnSelection = fmt.Sprintf("%s, %s AS %s", columnSelection, nullableColumn, nullableColumnAlias)
 
 			transaction.Order(nullableColumnAlias)
+
+			orderKeys = append(orderKeys, key.nullOrderKey(nullableColumn))
 		}
 
 		// the metric table has the is_nan column
@@ -431,16 +558,17 @@ func applyOrderBy(ctx context.Context, database, transaction *gorm.DB, orderBy [
 				trueColumnValue = "1"
 			}
 
-			columnSelection = fmt.Sprintf(
-				"%s, (CASE WHEN (%s.is_nan = %s) THEN 1 WHEN (%s.value IS NULL) THEN 2 ELSE 0 END) AS %s",
-				columnSelection,
+			nullableColumn := fmt.Sprintf(
+				"(CASE WHEN (%s.is_nan = %s) THEN 1 WHEN (%s.value IS NULL) THEN 2 ELSE 0 END)",
 				table,
 				trueColumnValue,
 				table,
-				nullableColumnAlias,
 			)
+			columnSelection = fmt.Sprintf("%s, %s AS %s", columnSelection, nullableColumn, nullableColumnAlias)
 
 			transaction.Order(nullableColumnAlias)
+
+			orderKeys = append(orderKeys, key.nullOrderKey(nullableColumn))
 		}
 
 		transaction.Order(clause.OrderByColumn{
@@ -449,20 +577,26 @@ func applyOrderBy(ctx context.Context, database, transaction *gorm.DB, orderBy [
 			},
 			Desc: desc,
 		})
+
+		orderKeys = append(orderKeys, key)
 	}
 
 	if !startTimeOrder {
 		transaction.Order("runs.start_time DESC")
+
+		orderKeys = append(orderKeys, newAttributeOrderKey(startTime, true))
 	}
 
 	transaction.Order("runs.run_uuid")
 
+	orderKeys = append(orderKeys, newAttributeOrderKey("run_uuid", false))
+
 	// mlflow orders all nullable columns to have null last.
 	// For each order by clause, an additional dynamic order clause was added.
 	// We need to include these columns in the select clause.
 	transaction.Select(columnSelection)
 
-	return nil
+	return orderKeys, nil
 }
 
 //nolint:gosec // disable G115
@@ -471,9 +605,9 @@ func mkNextPageToken(runLength, maxResults, offset int) (string, *contract.Error
 
 	if runLength == maxResults {
 		var token strings.Builder
-		if err := json.NewEncoder(
-			base64.NewEncoder(base64.StdEncoding, &token),
-		).Encode(PageToken{
+
+		encoder := base64.NewEncoder(base64.StdEncoding, &token)
+		if err := json.NewEncoder(encoder).Encode(PageToken{
 			Offset: int32(offset + maxResults),
 		}); err != nil {
 			return "", contract.NewErrorWith(
@@ -483,6 +617,15 @@ func mkNextPageToken(runLength, maxResults, offset int) (string, *contract.Error
 			)
 		}
 
+		// Flush the last partial block of the encoding, which would otherwise be cut from the token.
+		if err := encoder.Close(); err != nil {
+			return "", contract.NewErrorWith(
+				protos.ErrorCode_INTERNAL_ERROR,
+				"error encoding 'nextPageToken' value",
+				err,
+			)
+		}
+
 		nextPageToken = token.String()
 	}
 
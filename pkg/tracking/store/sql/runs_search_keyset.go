@@ -0,0 +1,316 @@
+package sql
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store/sql/models"
+)
+
+// runsPageToken is the page token of SearchRuns.
+// Offset tokens skip the runs of the previous pages, keyset tokens hold the sort keys of
+// the last run of the previous page in After, so that the next page seeks past it instead.
+type runsPageToken struct {
+	Offset int32 `json:"offset,omitempty"`
+	After  []any `json:"after,omitempty"`
+}
+
+func parseRunsPageToken(pageToken string) (*runsPageToken, *contract.Error) {
+	var token runsPageToken
+
+	if pageToken == "" {
+		return &token, nil
+	}
+
+	decoder := json.NewDecoder(
+		base64.NewDecoder(
+			base64.StdEncoding,
+			strings.NewReader(pageToken),
+		),
+	)
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&token); err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("invalid page_token: %q", pageToken),
+			err,
+		)
+	}
+
+	for index, value := range token.After {
+		number, ok := value.(json.Number)
+		if !ok {
+			continue
+		}
+
+		if integer, err := number.Int64(); err == nil {
+			token.After[index] = integer
+		} else if float, err := number.Float64(); err == nil {
+			token.After[index] = float
+		}
+	}
+
+	return &token, nil
+}
+
+// orderKey is one of the expressions runs are sorted by.
+type orderKey struct {
+	// column is the SQL expression of the key, usable in a WHERE clause.
+	column string
+	desc   bool
+	// value reads the key of a run, ok being false when it can't be read back from the run.
+	value func(run *models.Run) (any, bool)
+	// nullValue reads the key of the expression sorting the NULL values of column last.
+	nullValue func(run *models.Run) (any, bool)
+	// section is the section of the run the key is read from, if it isn't part of the run info.
+	section store.RunSections
+}
+
+func (key orderKey) nullOrderKey(column string) orderKey {
+	return orderKey{
+		column:  column,
+		desc:    false,
+		value:   key.nullValue,
+		section: key.section,
+	}
+}
+
+// isNullValue is the nullValue of the columns sorted with (CASE WHEN (column IS NULL) THEN 1 ELSE 0 END).
+func isNullValue(value func(run *models.Run) (any, bool)) func(run *models.Run) (any, bool) {
+	return func(run *models.Run) (any, bool) {
+		runValue, ok := value(run)
+		if !ok {
+			return nil, false
+		}
+
+		if runValue == nil {
+			return 1, true
+		}
+
+		return 0, true
+	}
+}
+
+//nolint:cyclop
+func runAttributeValue(run *models.Run, column string) (any, bool) {
+	switch column {
+	case "run_uuid":
+		return run.ID, true
+	case name:
+		return run.Name, true
+	case "source_type":
+		return string(run.SourceType), true
+	case "source_name":
+		return run.SourceName, true
+	case "entry_point_name":
+		return run.EntryPointName, true
+	case "user_id":
+		return run.UserID, true
+	case "status":
+		return string(run.Status), true
+	case startTime:
+		return run.StartTime, true
+	case "end_time":
+		if !run.EndTime.Valid {
+			return nil, true
+		}
+
+		return run.EndTime.Int64, true
+	case "source_version":
+		return run.SourceVersion, true
+	case "lifecycle_stage":
+		return string(run.LifecycleStage), true
+	case "artifact_uri":
+		return run.ArtifactURI, true
+	case "experiment_id":
+		return int64(run.ExperimentID), true
+	default:
+		return nil, false
+	}
+}
+
+func newAttributeOrderKey(column string, desc bool) orderKey {
+	value := func(run *models.Run) (any, bool) {
+		return runAttributeValue(run, column)
+	}
+
+	return orderKey{
+		column:    "runs." + column,
+		desc:      desc,
+		value:     value,
+		nullValue: isNullValue(value),
+		section:   0,
+	}
+}
+
+// newOrderKey returns the key of an order by clause, the column of metrics, params and tags
+// being the one of the joined table.
+func newOrderKey(expr orderByExpr) orderKey {
+	if expr.identifier == nil || *expr.identifier == attribute {
+		return newAttributeOrderKey(expr.key, false)
+	}
+
+	key := orderKey{
+		column: "",
+		desc:   false,
+		value: func(*models.Run) (any, bool) {
+			return nil, false
+		},
+		nullValue: func(*models.Run) (any, bool) {
+			return nil, false
+		},
+		section: 0,
+	}
+
+	switch *expr.identifier {
+	case metric:
+		key.section = store.RunSectionMetrics
+		key.value = func(run *models.Run) (any, bool) {
+			for _, latestMetric := range run.LatestMetrics {
+				if latestMetric.Key == expr.key {
+					return latestMetric.Value, true
+				}
+			}
+
+			return nil, true
+		}
+		key.nullValue = func(run *models.Run) (any, bool) {
+			for _, latestMetric := range run.LatestMetrics {
+				if latestMetric.Key == expr.key {
+					if latestMetric.IsNaN {
+						return 1, true
+					}
+
+					return 0, true
+				}
+			}
+
+			return 2, true
+		}
+	case "parameter":
+		key.section = store.RunSectionParams
+		key.value = func(run *models.Run) (any, bool) {
+			for _, param := range run.Params {
+				if param.Key == expr.key && param.Value.Valid {
+					return param.Value.String, true
+				}
+			}
+
+			return nil, true
+		}
+		key.nullValue = isNullValue(key.value)
+	case "tag":
+		key.section = store.RunSectionTags
+		key.value = func(run *models.Run) (any, bool) {
+			for _, tag := range run.Tags {
+				if tag.Key == expr.key {
+					return tag.Value, true
+				}
+			}
+
+			return nil, true
+		}
+		key.nullValue = isNullValue(key.value)
+	}
+
+	return key
+}
+
+// seekAfter restricts the runs to the ones sorted after the run whose keys are after:
+//
+//	(k1 > ?) OR (k1 = ? AND k2 > ?) OR ... OR (k1 = ? AND ... AND run_uuid > ?)
+//
+// The NULL values of a key are never sorted before or after each other, they share the same NULL order key.
+func seekAfter(transaction *gorm.DB, orderKeys []orderKey, after []any) *contract.Error {
+	if len(after) != len(orderKeys) {
+		return contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			"invalid page_token: it doesn't match the order_by of the request",
+		)
+	}
+
+	disjuncts := make([]string, 0, len(orderKeys))
+	disjunctVars := make([]any, 0)
+	equalities := make([]string, 0, len(orderKeys))
+	equalityVars := make([]any, 0, len(orderKeys))
+
+	for index, key := range orderKeys {
+		value := after[index]
+		if value == nil {
+			equalities = append(equalities, key.column+" IS NULL")
+
+			continue
+		}
+
+		comparison := ">"
+		if key.desc {
+			comparison = "<"
+		}
+
+		conditions := append(append([]string{}, equalities...), fmt.Sprintf("%s %s ?", key.column, comparison))
+		disjuncts = append(disjuncts, "("+strings.Join(conditions, " AND ")+")")
+		disjunctVars = append(disjunctVars, equalityVars...)
+		disjunctVars = append(disjunctVars, value)
+
+		equalities = append(equalities, key.column+" = ?")
+		equalityVars = append(equalityVars, value)
+	}
+
+	transaction.Where("("+strings.Join(disjuncts, " OR ")+")", disjunctVars...)
+
+	return nil
+}
+
+// mkKeysetPageToken returns the keyset token of the page ending with run, loaded with sections,
+// ok being false when the keys can't be read back from the run and an offset token is needed instead.
+func mkKeysetPageToken(
+	orderKeys []orderKey, run *models.Run, sections store.RunSections,
+) (string, bool, *contract.Error) {
+	after := make([]any, 0, len(orderKeys))
+
+	for _, key := range orderKeys {
+		if !sections.Has(key.section) {
+			return "", false, nil
+		}
+
+		value, ok := key.value(run)
+		if !ok {
+			return "", false, nil
+		}
+
+		after = append(after, value)
+	}
+
+	var token strings.Builder
+
+	encoder := base64.NewEncoder(base64.StdEncoding, &token)
+	if err := json.NewEncoder(encoder).Encode(runsPageToken{
+		Offset: 0,
+		After:  after,
+	}); err != nil {
+		return "", false, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			"error encoding 'nextPageToken' value",
+			err,
+		)
+	}
+
+	// Flush the last partial block of the encoding, which would otherwise be cut from the token.
+	if err := encoder.Close(); err != nil {
+		return "", false, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			"error encoding 'nextPageToken' value",
+			err,
+		)
+	}
+
+	return token.String(), true, nil
+}
@@ -0,0 +1,104 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go/pkg/tracking/store"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store/sql/models"
+)
+
+func TestSeekAfter(t *testing.T) {
+	t.Parallel()
+
+	database, err := gorm.Open(newPostgresDialector(), &gorm.Config{DryRun: true})
+	require.NoError(t, err)
+
+	transaction := database.Model(&models.Run{})
+
+	orderKeys, contractErr := applyOrderBy(context.Background(), database, transaction, []string{"params.lr DESC"})
+	require.Nil(t, contractErr)
+
+	// The last run of the previous page has no lr param.
+	contractErr = seekAfter(transaction, orderKeys, []any{int64(1), nil, int64(1700000000000), "abc"})
+	require.Nil(t, contractErr)
+
+	require.NoError(t, transaction.Find(&models.Run{}).Error)
+
+	expectedSQL := `
+	SELECT runs.*, (CASE WHEN (order_0.value IS NULL) THEN 1 ELSE 0 END) AS order_null_0 FROM "runs"
+	LEFT OUTER JOIN (SELECT "run_uuid","value" FROM "params" WHERE key = $1)
+	AS order_0 ON runs.run_uuid = order_0.run_uuid
+	WHERE (
+		((CASE WHEN (order_0.value IS NULL) THEN 1 ELSE 0 END) > $2)
+		OR ((CASE WHEN (order_0.value IS NULL) THEN 1 ELSE 0 END) = $3
+			AND order_0.value IS NULL AND runs.start_time < $4)
+		OR ((CASE WHEN (order_0.value IS NULL) THEN 1 ELSE 0 END) = $5
+			AND order_0.value IS NULL AND runs.start_time = $6 AND runs.run_uuid > $7)
+	)
+	ORDER BY order_null_0,"order_0"."value" DESC,runs.start_time DESC,runs.run_uuid`
+
+	assert.Equal(t, removeWhitespace(expectedSQL), removeWhitespace(transaction.Statement.SQL.String()))
+	assert.Equal(
+		t,
+		[]any{"lr", int64(1), int64(1), int64(1700000000000), int64(1), int64(1700000000000), "abc"},
+		transaction.Statement.Vars,
+	)
+
+	contractErr = seekAfter(database.Model(&models.Run{}), orderKeys, []any{"abc"})
+	require.NotNil(t, contractErr)
+}
+
+func TestKeysetPageToken(t *testing.T) {
+	t.Parallel()
+
+	database, err := gorm.Open(newPostgresDialector(), &gorm.Config{DryRun: true})
+	require.NoError(t, err)
+
+	orderKeys, contractErr := applyOrderBy(
+		context.Background(), database, database.Model(&models.Run{}),
+		[]string{"metrics.acc DESC", "attributes.end_time"},
+	)
+	require.Nil(t, contractErr)
+
+	run := models.Run{
+		ID:            "abc",
+		StartTime:     1700000000000,
+		EndTime:       sql.NullInt64{Valid: false},
+		LatestMetrics: []models.LatestMetric{{Key: "acc", Value: 0.25}},
+	}
+
+	token, ok, contractErr := mkKeysetPageToken(orderKeys, &run, store.AllRunSections)
+	require.Nil(t, contractErr)
+	require.True(t, ok)
+
+	// Without the metrics of the run, its metric keys are unknown rather than NULL.
+	_, ok, contractErr = mkKeysetPageToken(orderKeys, &run, store.RunSectionParams|store.RunSectionTags)
+	require.Nil(t, contractErr)
+	assert.False(t, ok)
+
+	pageToken, contractErr := parseRunsPageToken(token)
+	require.Nil(t, contractErr)
+	assert.Equal(t, []any{int64(0), 0.25, int64(1), nil, int64(1700000000000), "abc"}, pageToken.After)
+
+	// Offset tokens are still accepted.
+	pageToken, contractErr = parseRunsPageToken("eyJvZmZzZXQiOjEwMH0K")
+	require.Nil(t, contractErr)
+	assert.Equal(t, int32(100), pageToken.Offset)
+	assert.Nil(t, pageToken.After)
+
+	// The keys of unknown attributes can't be read back from the run.
+	orderKeys, contractErr = applyOrderBy(
+		context.Background(), database, database.Model(&models.Run{}), []string{"attributes.unknown"},
+	)
+	require.Nil(t, contractErr)
+
+	_, ok, contractErr = mkKeysetPageToken(orderKeys, &run, store.AllRunSections)
+	require.Nil(t, contractErr)
+	assert.False(t, ok)
+}
@@ -3,28 +3,175 @@ package sql
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 
 	"gorm.io/gorm"
 
 	"github.com/mlflow/mlflow-go/pkg/config"
 	"github.com/mlflow/mlflow-go/pkg/sql"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store/sql/models"
+	"github.com/mlflow/mlflow-go/pkg/utils"
 )
 
+const (
+	defaultExperimentID   = 0
+	defaultExperimentName = "Default"
+)
+
+// Like database/sql drivers, the SQL stores are registered when the package is imported.
+//
+//nolint:gochecknoinits
+func init() {
+	for _, scheme := range sql.Schemes() {
+		store.RegisterTrackingStore(scheme, func(ctx context.Context, config *config.Config) (store.TrackingStore, error) {
+			return NewTrackingSQLStore(ctx, config)
+		})
+	}
+}
+
 type TrackingSQLStore struct {
 	config *config.Config
 	db     *gorm.DB
+	// replica is the read replica of the database with the tracking_store_read_replica_uri option,
+	// or the read pool of a SQLite database.
+	replica     *gorm.DB
+	schemaCheck *sql.SchemaCheck
 }
 
 func NewTrackingSQLStore(ctx context.Context, config *config.Config) (*TrackingSQLStore, error) {
-	database, err := sql.NewDatabase(ctx, config.TrackingStoreURI)
+	database, err := sql.NewDatabase(ctx, config.TrackingStoreURI, sql.NewOptions(config))
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database %q: %w", config.TrackingStoreURI, err)
 	}
 
-	return &TrackingSQLStore{
+	trackingStore := &TrackingSQLStore{
 		config: config,
 		db:     database,
-	}, nil
+	}
+
+	if trackingStore.schemaCheck, err = trackingStore.initDatabase(ctx); err != nil {
+		_ = sql.CloseDatabase(database)
+
+		return nil, err
+	}
+
+	switch {
+	case config.TrackingStoreReadReplicaURI != "":
+		trackingStore.replica, err = sql.NewReplica(
+			ctx, database, config.TrackingStoreReadReplicaURI, sql.NewOptions(config),
+		)
+		if err != nil {
+			_ = sql.CloseDatabase(database)
+
+			return nil, fmt.Errorf("failed to connect to read replica %q: %w", config.TrackingStoreReadReplicaURI, err)
+		}
+	case database.Dialector.Name() == "sqlite":
+		if trackingStore.replica, err = sql.NewReadPool(ctx, config.TrackingStoreURI, sql.NewOptions(config)); err != nil {
+			_ = sql.CloseDatabase(database)
+
+			return nil, err
+		}
+	}
+
+	return trackingStore, nil
+}
+
+// initDatabase creates the schema of an empty database with the create_database_schema option, then checks it.
+func (s TrackingSQLStore) initDatabase(ctx context.Context) (*sql.SchemaCheck, error) {
+	if s.config.CreateDatabaseSchema {
+		if err := sql.CreateSchema(ctx, s.db); err != nil {
+			return nil, err
+		}
+
+		if err := s.createDefaultExperiment(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	check, err := sql.EnforceSchema(ctx, s.db, s.config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check schema of database %q: %w", s.config.TrackingStoreURI, err)
+	}
+
+	return check, nil
+}
+
+// transaction runs fn in a transaction, which is retried after a conflict with a concurrent transaction.
+func (s TrackingSQLStore) transaction(ctx context.Context, fn func(transaction *gorm.DB) error) error {
+	return sql.Transaction(s.db.WithContext(ctx), fn) //nolint:wrapcheck
+}
+
+// reader returns the database of the read-only operations, which may be the read replica or read pool.
+func (s TrackingSQLStore) reader(ctx context.Context) *gorm.DB {
+	return sql.Reader(ctx, s.db, s.replica)
+}
+
+// writeContext returns the context of the reads of the operations which write, which go to the primary database.
+func (s TrackingSQLStore) writeContext(ctx context.Context) context.Context {
+	return sql.NewContextWithPrimary(ctx)
+}
+
+// SchemaCheck returns the outcome of the check of the schema of the database when the store was created.
+func (s TrackingSQLStore) SchemaCheck() *sql.SchemaCheck {
+	return s.schemaCheck
+}
+
+// createDefaultExperiment creates the experiment 0 of a database without experiments, like the SQL store of MLflow.
+func (s TrackingSQLStore) createDefaultExperiment(ctx context.Context) error {
+	artifactLocation, err := utils.AppendToURIPath(s.config.DefaultArtifactRoot, strconv.Itoa(defaultExperimentID))
+	if err != nil {
+		return fmt.Errorf("failed to join artifact location: %w", err)
+	}
+
+	if err := s.transaction(ctx, func(transaction *gorm.DB) error {
+		var count int64
+		if err := transaction.Model(&models.Experiment{}).Count(&count).Error; err != nil || count > 0 {
+			return err
+		}
+
+		// The ID 0 is taken as a missing ID by the auto increment of MySQL and SQL Server,
+		// which must allow it in the session of the transaction.
+		var before, after string
+
+		switch transaction.Dialector.Name() {
+		case "mysql":
+			before = "SET @saved_sql_mode = @@SESSION.sql_mode, @@SESSION.sql_mode = 'NO_AUTO_VALUE_ON_ZERO'"
+			after = "SET @@SESSION.sql_mode = @saved_sql_mode"
+		case "sqlserver":
+			before = "SET IDENTITY_INSERT experiments ON"
+			after = "SET IDENTITY_INSERT experiments OFF"
+		}
+
+		if before != "" {
+			if err := transaction.Exec(before).Error; err != nil {
+				return err
+			}
+		}
+
+		creationTime := time.Now().UnixMilli()
+
+		if err := transaction.Exec(
+			"INSERT INTO experiments "+
+				"(experiment_id, name, artifact_location, lifecycle_stage, creation_time, last_update_time) "+
+				"VALUES (?, ?, ?, ?, ?, ?)",
+			defaultExperimentID, defaultExperimentName, artifactLocation, models.LifecycleStageActive,
+			creationTime, creationTime,
+		).Error; err != nil {
+			return err
+		}
+
+		if after != "" {
+			return transaction.Exec(after).Error
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to create default experiment: %w", err)
+	}
+
+	return nil
 }
 
 func (s TrackingSQLStore) Destroy() error {
@@ -32,5 +179,11 @@ func (s TrackingSQLStore) Destroy() error {
 		return fmt.Errorf("failed to close database: %w", err)
 	}
 
+	if s.replica != nil {
+		if err := sql.CloseDatabase(s.replica); err != nil {
+			return fmt.Errorf("failed to close read replica: %w", err)
+		}
+	}
+
 	return nil
 }
@@ -88,7 +88,7 @@ const badDataMessage = "Bad data in database - tags for a specific run must have
 func (s TrackingSQLStore) DeleteTag(
 	ctx context.Context, runID, key string,
 ) *contract.Error {
-	err := s.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+	err := s.transaction(ctx, func(transaction *gorm.DB) error {
 		contractError := checkRunIsActive(transaction, runID)
 		if contractError != nil {
 			return contractError
@@ -158,7 +158,7 @@ func (s TrackingSQLStore) SetTag(
 		)
 	}
 
-	err = s.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+	err = s.transaction(ctx, func(transaction *gorm.DB) error {
 		contractError := checkRunIsActive(transaction, runID)
 		if contractError != nil {
 			return contractError
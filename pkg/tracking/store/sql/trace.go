@@ -187,7 +187,7 @@ func (s TrackingSQLStore) EndTrace(
 		return nil, err
 	}
 
-	if err := s.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+	if err := s.transaction(ctx, func(transaction *gorm.DB) error {
 		if err := transaction.Model(
 			&models.TraceInfo{},
 		).Where(
@@ -320,3 +320,66 @@ func (s TrackingSQLStore) DeleteTraces(
 	//nolint:gosec
 	return int32(len(traces)), nil
 }
+
+func (s TrackingSQLStore) SearchTraces(
+	ctx context.Context,
+	experimentIDs []string,
+	filter string,
+	maxResults int,
+	orderBy []string,
+	pageToken string,
+) ([]*entities.TraceInfo, string, *contract.Error) {
+	transaction := s.db.WithContext(ctx).Model(
+		&models.TraceInfo{},
+	).Where(
+		"trace_info.experiment_id IN ?", experimentIDs,
+	)
+
+	// MaxResults
+	transaction.Limit(maxResults)
+
+	// PageToken
+	offset, contractError := getOffset(pageToken)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	transaction.Offset(offset)
+
+	// Filter
+	contractError = applyTracesFilter(ctx, s.db, transaction, filter)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	// OrderBy
+	contractError = applyTracesOrderBy(ctx, s.db, transaction, orderBy)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	// Actual query
+	var traces []models.TraceInfo
+
+	transaction.Preload("Tags").Preload("TraceRequestMetadata").Find(&traces)
+
+	if transaction.Error != nil {
+		return nil, "", contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			"Failed to query search traces",
+			transaction.Error,
+		)
+	}
+
+	entityTraces := make([]*entities.TraceInfo, len(traces))
+	for i, trace := range traces {
+		entityTraces[i] = trace.ToEntity()
+	}
+
+	nextPageToken, contractError := mkNextPageToken(len(traces), maxResults, offset)
+	if contractError != nil {
+		return nil, "", contractError
+	}
+
+	return entityTraces, nextPageToken, nil
+}
@@ -0,0 +1,163 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/tracking/service/query"
+	"github.com/mlflow/mlflow-go/pkg/tracking/service/query/parser"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store/sql/models"
+	"github.com/mlflow/mlflow-go/pkg/utils"
+)
+
+//nolint:funlen
+func applyTracesFilter(ctx context.Context, database, transaction *gorm.DB, filter string) *contract.Error {
+	filterConditions, err := query.ParseTraceFilter(filter)
+	if err != nil {
+		return query.NewFilterError(err)
+	}
+
+	utils.GetLoggerFromContext(ctx).Debugf("Filter conditions: %v", filterConditions)
+
+	for index, clause := range filterConditions {
+		comparison := strings.ToUpper(clause.Operator.String())
+		value := clause.Value
+
+		column := "value"
+		if clause.Identifier == parser.Attribute {
+			column = "trace_info." + clause.Key
+		}
+
+		if database.Dialector.Name() == "sqlite" && comparison == "ILIKE" {
+			column = fmt.Sprintf("LOWER(%s)", column)
+			comparison = "LIKE"
+
+			if str, ok := value.(string); ok {
+				value = strings.ToLower(str)
+			}
+		}
+
+		where := fmt.Sprintf("%s %s ?", column, comparison)
+
+		var kind any
+
+		//nolint:exhaustive
+		switch clause.Identifier {
+		case parser.Tag:
+			kind = &models.TraceTag{}
+		case parser.RequestMetadata:
+			kind = &models.TraceRequestMetadata{}
+		default:
+			transaction.Where(where, value)
+
+			continue
+		}
+
+		// JOIN (
+		//   SELECT request_id
+		//   FROM trace_tags
+		//   WHERE key = ? AND value %s ?
+		// ) AS filter_0
+		// ON trace_info.request_id = filter_0.request_id
+		table := fmt.Sprintf("filter_%d", index)
+		transaction.Joins(
+			fmt.Sprintf("JOIN (?) AS %s ON trace_info.request_id = %s.request_id", table, table),
+			database.Select("request_id").Where("key = ?", clause.Key).Where(where, value).Model(kind),
+		)
+	}
+
+	return nil
+}
+
+// Matches `[identifier.]key [ASC|DESC]` where the key may be wrapped in quotes or backticks.
+var traceOrderByRegexp = regexp.MustCompile(
+	"^(?:([a-zA-Z_]+)\\.)?(\"[^\"]+\"|`[^`]+`|'[^']+'|[\\w.]+)(?:\\s+(?i:(ASC|DESC)))?$",
+)
+
+//nolint:funlen,cyclop
+func applyTracesOrderBy(ctx context.Context, database, transaction *gorm.DB, orderBy []string) *contract.Error {
+	timestampOrder := false
+	columnSelection := "trace_info.*"
+
+	for index, orderByClause := range orderBy {
+		matches := traceOrderByRegexp.FindStringSubmatch(strings.TrimSpace(orderByClause))
+		if matches == nil {
+			return contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("invalid order_by clause %q.", orderByClause),
+			)
+		}
+
+		identifier, key, order := matches[1], strings.Trim(matches[2], "\"'`"), strings.ToUpper(matches[3])
+
+		utils.GetLoggerFromContext(ctx).Debugf(
+			"OrderByExpr: identifier: %v, key: %v, order: %v", identifier, key, order,
+		)
+
+		var column string
+
+		switch identifier {
+		case "", attribute, "attr", "attributes", "trace":
+			switch key {
+			case "timestamp", parser.TraceTimestampMS:
+				timestampOrder = true
+				column = "trace_info." + parser.TraceTimestampMS
+			case "execution_time", parser.TraceExecutionTimeMS:
+				column = "trace_info." + parser.TraceExecutionTimeMS
+			case parser.TraceStatus, parser.TraceRequestID:
+				column = "trace_info." + key
+			default:
+				return contract.NewError(
+					protos.ErrorCode_INVALID_PARAMETER_VALUE,
+					fmt.Sprintf("invalid order_by attribute %q.", key),
+				)
+			}
+		case "tag", "tags":
+			table := fmt.Sprintf("order_%d", index)
+			transaction.Joins(
+				fmt.Sprintf("LEFT OUTER JOIN (?) AS %s ON trace_info.request_id = %s.request_id", table, table),
+				database.Select("request_id", "value").Where("key = ?", key).Model(&models.TraceTag{}),
+			)
+
+			column = table + ".value"
+		default:
+			return contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("invalid order_by identifier %q.", identifier),
+			)
+		}
+
+		// mlflow orders null values last.
+		nullableColumnAlias := fmt.Sprintf("order_null_%d", index)
+		columnSelection = fmt.Sprintf(
+			"%s, (CASE WHEN (%s IS NULL) THEN 1 ELSE 0 END) AS %s",
+			columnSelection,
+			column,
+			nullableColumnAlias,
+		)
+
+		transaction.Order(nullableColumnAlias)
+		transaction.Order(clause.OrderByColumn{
+			Column: clause.Column{
+				Name: column,
+			},
+			Desc: order == "DESC",
+		})
+	}
+
+	if !timestampOrder {
+		transaction.Order("trace_info.timestamp_ms DESC")
+	}
+
+	transaction.Order("trace_info.request_id")
+	transaction.Select(columnSelection)
+
+	return nil
+}
@@ -62,6 +62,14 @@ type (
 		SetTraceTag(ctx context.Context, requestID, key, value string) error
 		GetTraceTag(ctx context.Context, requestID, key string) (*entities.TraceTag, *contract.Error)
 		DeleteTraceTag(ctx context.Context, tag *entities.TraceTag) *contract.Error
+		SearchTraces(
+			ctx context.Context,
+			experimentIDs []string,
+			filter string,
+			maxResults int,
+			orderBy []string,
+			pageToken string,
+		) ([]*entities.TraceInfo, string, *contract.Error)
 		DeleteTraces(
 			ctx context.Context,
 			experimentID string,
@@ -80,7 +88,20 @@ type (
 
 		LogMetric(ctx context.Context, runID string, metric *entities.Metric) *contract.Error
 		LogParam(ctx context.Context, runID string, metric *entities.Param) *contract.Error
-		GetMetricHistory(ctx context.Context, runID, metricKey string) ([]*entities.Metric, *contract.Error)
+		GetMetricHistory(
+			ctx context.Context,
+			runID string,
+			metricKey string,
+			pageToken string,
+			maxResults int,
+		) ([]*entities.Metric, string, *contract.Error)
+		GetMetricHistoryBulkInterval(
+			ctx context.Context,
+			runIDs []string,
+			metricKey string,
+			startStep, endStep *int64,
+			maxResults int,
+		) ([]*entities.MetricWithRunID, *contract.Error)
 	}
 
 	ExperimentTrackingStore interface {
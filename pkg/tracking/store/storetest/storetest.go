@@ -0,0 +1,760 @@
+// Package storetest is the conformance suite of the tracking stores, which checks that a store
+// behaves like the SQL store the Python server relies on.
+//
+// The tests only create uniquely named experiments, so the suite can run against a database
+// which is shared with other tests.
+package storetest
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go/pkg/contract"
+	"github.com/mlflow/mlflow-go/pkg/entities"
+	"github.com/mlflow/mlflow-go/pkg/protos"
+	"github.com/mlflow/mlflow-go/pkg/tracking/store"
+	"github.com/mlflow/mlflow-go/pkg/utils"
+)
+
+// NewStore returns the store under test, which may be shared by the tests of the suite.
+type NewStore func(t *testing.T) store.TrackingStore
+
+// Run runs the conformance suite against the stores created by newStore.
+func Run(t *testing.T, newStore NewStore) {
+	t.Helper()
+
+	run(t, newStore, true)
+}
+
+// RunFileStore runs the conformance suite against file stores, leaving out the tests of the behaviors
+// of the SQL store which the FileStore of MLflow doesn't share.
+func RunFileStore(t *testing.T, newStore NewStore) {
+	t.Helper()
+
+	run(t, newStore, false)
+}
+
+func run(t *testing.T, newStore NewStore, sqlBehaviors bool) {
+	t.Helper()
+
+	tests := map[string]func(t *testing.T, store store.TrackingStore){
+		"Experiments":             testExperiments,
+		"DeleteExperiment":        testDeleteExperiment,
+		"SearchExperiments":       testSearchExperiments,
+		"Runs":                    testRuns,
+		"LogBatch":                testLogBatch,
+		"MetricHistory":           testMetricHistory,
+		"MetricHistoryPages":      testMetricHistoryPages,
+		"SearchRuns":              testSearchRuns,
+		"InvalidSearchFilters":    testInvalidSearchFilters,
+		"Inputs":                  testInputs,
+		"Traces":                  testTraces,
+		"ConcurrentLogging":       testConcurrentLogging,
+		"RunOfDeletedExperiment":  testRunOfDeletedExperiment,
+		"ChangingParamValue":      testChangingParamValue,
+		"GetMissingRunTag":        testGetMissingRunTag,
+		"DeleteMissingRunTag":     testDeleteMissingRunTag,
+		"DuplicateExperimentName": testDuplicateExperimentName,
+	}
+
+	// The FileStore of MLflow keeps the special values and the duplicates of the metrics,
+	// accepts any experiment ID and has no next page token after a full last page.
+	if sqlBehaviors {
+		tests["SpecialMetricValues"] = testSpecialMetricValues
+		tests["DuplicateMetrics"] = testDuplicateMetrics
+		tests["InvalidExperimentID"] = testInvalidExperimentID
+		tests["SearchRunsLastPageToken"] = testSearchRunsLastPageToken
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			test(t, newStore(t))
+		})
+	}
+}
+
+func requireErrorCode(t *testing.T, err *contract.Error, code protos.ErrorCode) {
+	t.Helper()
+
+	require.NotNil(t, err)
+	assert.Equal(t, code, protos.ErrorCode(err.Code), err.Message)
+}
+
+func createExperiment(t *testing.T, store store.TrackingStore) string {
+	t.Helper()
+
+	experimentID, err := store.CreateExperiment(context.Background(), "storetest-"+utils.NewUUID(), "", nil)
+	require.Nil(t, err)
+
+	return experimentID
+}
+
+func createRun(t *testing.T, store store.TrackingStore, experimentID string) *entities.Run {
+	t.Helper()
+
+	run, err := store.CreateRun(context.Background(), experimentID, "user", 1000, nil, "")
+	require.Nil(t, err)
+
+	return run
+}
+
+func testExperiments(t *testing.T, store store.TrackingStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	name := "storetest-" + utils.NewUUID()
+
+	experimentID, err := store.CreateExperiment(ctx, name, "", []*entities.ExperimentTag{{Key: "team", Value: "a"}})
+	require.Nil(t, err)
+
+	experiment, err := store.GetExperiment(ctx, experimentID)
+	require.Nil(t, err)
+	assert.Equal(t, name, experiment.Name)
+	assert.Equal(t, "active", experiment.LifecycleStage)
+	assert.NotEmpty(t, experiment.ArtifactLocation)
+	assert.Equal(t, []*entities.ExperimentTag{{Key: "team", Value: "a"}}, experiment.Tags)
+
+	require.Nil(t, store.RenameExperiment(ctx, experimentID, name+"-renamed"))
+	require.Nil(t, store.SetExperimentTag(ctx, experimentID, "team", "b"))
+
+	experiment, err = store.GetExperimentByName(ctx, name+"-renamed")
+	require.Nil(t, err)
+	assert.Equal(t, experimentID, experiment.ExperimentID)
+	assert.Equal(t, []*entities.ExperimentTag{{Key: "team", Value: "b"}}, experiment.Tags)
+
+	_, err = store.GetExperimentByName(ctx, name)
+	requireErrorCode(t, err, protos.ErrorCode_RESOURCE_DOES_NOT_EXIST)
+}
+
+func testDuplicateExperimentName(t *testing.T, store store.TrackingStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	name := "storetest-" + utils.NewUUID()
+
+	experimentID, err := store.CreateExperiment(ctx, name, "", nil)
+	require.Nil(t, err)
+
+	// Deleted experiments keep their name.
+	require.Nil(t, store.DeleteExperiment(ctx, experimentID))
+
+	_, err = store.CreateExperiment(ctx, name, "", nil)
+	requireErrorCode(t, err, protos.ErrorCode_RESOURCE_ALREADY_EXISTS)
+}
+
+func testInvalidExperimentID(t *testing.T, store store.TrackingStore) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	_, err := store.GetExperiment(ctx, "not-a-number")
+	requireErrorCode(t, err, protos.ErrorCode_INVALID_PARAMETER_VALUE)
+
+	_, err = store.GetExperiment(ctx, "2147483000")
+	requireErrorCode(t, err, protos.ErrorCode_RESOURCE_DOES_NOT_EXIST)
+}
+
+func testDeleteExperiment(t *testing.T, store store.TrackingStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	experimentID := createExperiment(t, store)
+	run := createRun(t, store, experimentID)
+
+	requireErrorCode(t, store.RestoreExperiment(ctx, experimentID), protos.ErrorCode_RESOURCE_DOES_NOT_EXIST)
+	require.Nil(t, store.DeleteExperiment(ctx, experimentID))
+
+	experiment, err := store.GetExperiment(ctx, experimentID)
+	require.Nil(t, err)
+	assert.Equal(t, "deleted", experiment.LifecycleStage)
+
+	deletedRun, err := store.GetRun(ctx, run.Info.RunID)
+	require.Nil(t, err)
+	assert.Equal(t, "deleted", deletedRun.Info.LifecycleStage)
+
+	requireErrorCode(
+		t, store.SetExperimentTag(ctx, experimentID, "key", "value"), protos.ErrorCode_INVALID_PARAMETER_VALUE,
+	)
+
+	require.Nil(t, store.RestoreExperiment(ctx, experimentID))
+
+	restoredRun, err := store.GetRun(ctx, run.Info.RunID)
+	require.Nil(t, err)
+	assert.Equal(t, "active", restoredRun.Info.LifecycleStage)
+}
+
+func testSearchExperiments(t *testing.T, store store.TrackingStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	prefix := "storetest-" + utils.NewUUID()
+
+	for _, suffix := range []string{"-b", "-a", "-c"} {
+		_, err := store.CreateExperiment(ctx, prefix+suffix, "", nil)
+		require.Nil(t, err)
+	}
+
+	deleted, err := store.GetExperimentByName(ctx, prefix+"-c")
+	require.Nil(t, err)
+	require.Nil(t, store.DeleteExperiment(ctx, deleted.ExperimentID))
+
+	filter := "name LIKE '" + prefix + "%'"
+
+	experiments, token, err := store.SearchExperiments(
+		ctx, protos.ViewType_ACTIVE_ONLY, 1, filter, []string{"name DESC"}, "",
+	)
+	require.Nil(t, err)
+	require.Len(t, experiments, 1)
+	assert.Equal(t, prefix+"-b", experiments[0].Name)
+	require.NotEmpty(t, token)
+
+	experiments, token, err = store.SearchExperiments(
+		ctx, protos.ViewType_ACTIVE_ONLY, 1, filter, []string{"name DESC"}, token,
+	)
+	require.Nil(t, err)
+	require.Len(t, experiments, 1)
+	assert.Equal(t, prefix+"-a", experiments[0].Name)
+	assert.Empty(t, token)
+
+	experiments, _, err = store.SearchExperiments(ctx, protos.ViewType_DELETED_ONLY, 10, filter, nil, "")
+	require.Nil(t, err)
+	require.Len(t, experiments, 1)
+	assert.Equal(t, prefix+"-c", experiments[0].Name)
+}
+
+func testRuns(t *testing.T, store store.TrackingStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	experimentID := createExperiment(t, store)
+
+	run, err := store.CreateRun(
+		ctx, experimentID, "user", 1000, []*entities.RunTag{{Key: "team", Value: "a"}}, "my-run",
+	)
+	require.Nil(t, err)
+	assert.Equal(t, "my-run", run.Info.RunName)
+	assert.Equal(t, protos.RunStatus_RUNNING.String(), run.Info.Status)
+	assert.Equal(t, experimentID, run.Info.ExperimentID)
+	assert.Contains(t, run.Info.ArtifactURI, run.Info.RunID)
+
+	endTime := int64(2000)
+	require.Nil(t, store.UpdateRun(ctx, run.Info.RunID, protos.RunStatus_FINISHED.String(), &endTime, ""))
+
+	run, err = store.GetRun(ctx, run.Info.RunID)
+	require.Nil(t, err)
+	assert.Equal(t, "my-run", run.Info.RunName)
+	assert.Equal(t, protos.RunStatus_FINISHED.String(), run.Info.Status)
+	assert.Equal(t, &endTime, run.Info.EndTime)
+	assert.ElementsMatch(t, []*entities.RunTag{
+		{Key: "team", Value: "a"},
+		{Key: utils.TagRunName, Value: "my-run"},
+	}, run.Data.Tags)
+
+	tag, err := store.GetRunTag(ctx, run.Info.RunID, "team")
+	require.Nil(t, err)
+	assert.Equal(t, &entities.RunTag{Key: "team", Value: "a"}, tag)
+
+	require.Nil(t, store.DeleteRun(ctx, run.Info.RunID))
+
+	run, err = store.GetRun(ctx, run.Info.RunID)
+	require.Nil(t, err)
+	assert.Equal(t, "deleted", run.Info.LifecycleStage)
+
+	requireErrorCode(t, store.SetTag(ctx, run.Info.RunID, "key", "value"), protos.ErrorCode_INVALID_PARAMETER_VALUE)
+
+	require.Nil(t, store.RestoreRun(ctx, run.Info.RunID))
+	require.Nil(t, store.SetTag(ctx, run.Info.RunID, "key", "value"))
+
+	_, err = store.GetRun(ctx, utils.NewUUID())
+	requireErrorCode(t, err, protos.ErrorCode_RESOURCE_DOES_NOT_EXIST)
+}
+
+func testRunOfDeletedExperiment(t *testing.T, store store.TrackingStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	experimentID := createExperiment(t, store)
+
+	require.Nil(t, store.DeleteExperiment(ctx, experimentID))
+
+	_, err := store.CreateRun(ctx, experimentID, "user", 1000, nil, "")
+	requireErrorCode(t, err, protos.ErrorCode_INVALID_PARAMETER_VALUE)
+}
+
+func testLogBatch(t *testing.T, store store.TrackingStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	run := createRun(t, store, createExperiment(t, store))
+
+	require.Nil(t, store.LogBatch(
+		ctx,
+		run.Info.RunID,
+		[]*entities.Metric{
+			{Key: "loss", Value: 3, Timestamp: 1, Step: 0},
+			{Key: "loss", Value: 1, Timestamp: 2, Step: 2},
+			{Key: "loss", Value: 2, Timestamp: 3, Step: 1},
+		},
+		[]*entities.Param{{Key: "optimizer", Value: utils.PtrTo("adam")}},
+		[]*entities.RunTag{{Key: utils.TagRunName, Value: "renamed"}},
+	))
+	require.Nil(t, store.LogParam(ctx, run.Info.RunID, &entities.Param{Key: "optimizer", Value: utils.PtrTo("adam")}))
+
+	run, err := store.GetRun(ctx, run.Info.RunID)
+	require.Nil(t, err)
+	assert.Equal(t, "renamed", run.Info.RunName)
+	assert.Equal(t, []*entities.Param{{Key: "optimizer", Value: utils.PtrTo("adam")}}, run.Data.Params)
+
+	// The latest metric is the one of the highest step.
+	assert.Equal(t, []*entities.Metric{{Key: "loss", Value: 1, Timestamp: 2, Step: 2}}, run.Data.Metrics)
+}
+
+func testSpecialMetricValues(t *testing.T, store store.TrackingStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	run := createRun(t, store, createExperiment(t, store))
+
+	require.Nil(t, store.LogBatch(
+		ctx, run.Info.RunID, []*entities.Metric{{Key: "accuracy", Value: math.Inf(1), Timestamp: 1}}, nil, nil,
+	))
+	require.Nil(t, store.LogMetric(ctx, run.Info.RunID, &entities.Metric{Key: "nan", Value: math.NaN(), Timestamp: 1}))
+
+	run, err := store.GetRun(ctx, run.Info.RunID)
+	require.Nil(t, err)
+
+	// The special values are stored like in SQL.
+	assert.ElementsMatch(t, []*entities.Metric{
+		{Key: "accuracy", Value: math.MaxFloat64, Timestamp: 1, Step: 0},
+		{Key: "nan", Value: 0, Timestamp: 1, Step: 0, IsNaN: true},
+	}, run.Data.Metrics)
+}
+
+func testChangingParamValue(t *testing.T, store store.TrackingStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	run := createRun(t, store, createExperiment(t, store))
+
+	require.Nil(t, store.LogParam(ctx, run.Info.RunID, &entities.Param{Key: "lr", Value: utils.PtrTo("0.1")}))
+
+	// The batch is rejected as a whole.
+	requireErrorCode(t, store.LogBatch(
+		ctx,
+		run.Info.RunID,
+		[]*entities.Metric{{Key: "loss", Value: 1, Timestamp: 1}},
+		[]*entities.Param{{Key: "lr", Value: utils.PtrTo("0.2")}},
+		nil,
+	), protos.ErrorCode_INVALID_PARAMETER_VALUE)
+
+	run, err := store.GetRun(ctx, run.Info.RunID)
+	require.Nil(t, err)
+	assert.Equal(t, []*entities.Param{{Key: "lr", Value: utils.PtrTo("0.1")}}, run.Data.Params)
+	assert.Empty(t, run.Data.Metrics)
+}
+
+func testGetMissingRunTag(t *testing.T, store store.TrackingStore) {
+	t.Helper()
+
+	run := createRun(t, store, createExperiment(t, store))
+
+	tag, err := store.GetRunTag(context.Background(), run.Info.RunID, "missing")
+	require.Nil(t, err)
+	assert.Nil(t, tag)
+}
+
+func testDeleteMissingRunTag(t *testing.T, store store.TrackingStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	run := createRun(t, store, createExperiment(t, store))
+
+	require.Nil(t, store.SetTag(ctx, run.Info.RunID, "key", "value"))
+	require.Nil(t, store.DeleteTag(ctx, run.Info.RunID, "key"))
+	requireErrorCode(t, store.DeleteTag(ctx, run.Info.RunID, "key"), protos.ErrorCode_RESOURCE_DOES_NOT_EXIST)
+}
+
+func testMetricHistory(t *testing.T, store store.TrackingStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	experimentID := createExperiment(t, store)
+	runs := []*entities.Run{createRun(t, store, experimentID), createRun(t, store, experimentID)}
+
+	for index, run := range runs {
+		for step := range int64(5) {
+			metric := &entities.Metric{Key: "loss", Value: float64(index), Timestamp: 1, Step: step}
+			require.Nil(t, store.LogMetric(ctx, run.Info.RunID, metric))
+		}
+	}
+
+	history, _, err := store.GetMetricHistory(ctx, runs[0].Info.RunID, "loss", "", 0)
+	require.Nil(t, err)
+	require.Len(t, history, 5)
+
+	for step, metric := range history {
+		assert.Equal(t, int64(step), metric.Step)
+	}
+
+	metrics, err := store.GetMetricHistoryBulkInterval(
+		ctx,
+		[]string{runs[0].Info.RunID, runs[1].Info.RunID},
+		"loss",
+		utils.PtrTo(int64(1)),
+		utils.PtrTo(int64(3)),
+		10,
+	)
+	require.Nil(t, err)
+	require.Len(t, metrics, 6)
+
+	for _, metric := range metrics {
+		assert.GreaterOrEqual(t, metric.Metric.Step, int64(1))
+		assert.LessOrEqual(t, metric.Metric.Step, int64(3))
+	}
+}
+
+func testDuplicateMetrics(t *testing.T, store store.TrackingStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	run := createRun(t, store, createExperiment(t, store))
+	metric := &entities.Metric{Key: "loss", Value: 1, Timestamp: 1, Step: 1}
+
+	// Logging the same value twice keeps a single one.
+	require.Nil(t, store.LogMetric(ctx, run.Info.RunID, metric))
+	require.Nil(t, store.LogMetric(ctx, run.Info.RunID, metric))
+
+	history, _, err := store.GetMetricHistory(ctx, run.Info.RunID, "loss", "", 0)
+	require.Nil(t, err)
+	assert.Equal(t, []*entities.Metric{metric}, history)
+}
+
+func testMetricHistoryPages(t *testing.T, store store.TrackingStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	run := createRun(t, store, createExperiment(t, store))
+
+	// The metrics are logged in the reverse of their order by step, timestamp and value.
+	for step := int64(3); step >= 0; step-- {
+		for _, metric := range []*entities.Metric{
+			{Key: "loss", Value: 1, Timestamp: 2, Step: step},
+			{Key: "loss", Value: 3, Timestamp: 1, Step: step},
+			{Key: "loss", Value: 2, Timestamp: 1, Step: step},
+		} {
+			require.Nil(t, store.LogMetric(ctx, run.Info.RunID, metric))
+		}
+	}
+
+	history := make([]*entities.Metric, 0, 12)
+	pageToken := ""
+
+	for _, pageLength := range []int{5, 5, 2} {
+		page, nextPageToken, err := store.GetMetricHistory(ctx, run.Info.RunID, "loss", pageToken, 5)
+		require.Nil(t, err)
+		require.Len(t, page, pageLength)
+
+		history = append(history, page...)
+		pageToken = nextPageToken
+	}
+
+	// The last page has no token.
+	assert.Empty(t, pageToken)
+
+	for step := range int64(4) {
+		for index, expected := range []struct {
+			timestamp int64
+			value     float64
+		}{{1, 2}, {1, 3}, {2, 1}} {
+			metric := history[step*3+int64(index)]
+			assert.Equal(t, step, metric.Step)
+			assert.Equal(t, expected.timestamp, metric.Timestamp)
+			assert.InDelta(t, expected.value, metric.Value, 0)
+		}
+	}
+
+	_, _, err := store.GetMetricHistory(ctx, run.Info.RunID, "loss", "invalid", 5)
+	requireErrorCode(t, err, protos.ErrorCode_INVALID_PARAMETER_VALUE)
+}
+
+func testSearchRuns(t *testing.T, store store.TrackingStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	experimentID := createExperiment(t, store)
+	runIDs := make([]string, 0, 3)
+
+	for index := range 3 {
+		even := "no"
+		if index%2 == 0 {
+			even = "yes"
+		}
+
+		run := createRun(t, store, experimentID)
+		require.Nil(t, store.LogBatch(
+			ctx,
+			run.Info.RunID,
+			[]*entities.Metric{{Key: "accuracy", Value: float64(index), Timestamp: 1}},
+			[]*entities.Param{{Key: "even", Value: &even}},
+			nil,
+		))
+
+		runIDs = append(runIDs, run.Info.RunID)
+	}
+
+	runs, token, err := store.SearchRuns(
+		ctx,
+		[]string{experimentID},
+		"params.even = 'yes'",
+		protos.ViewType_ACTIVE_ONLY,
+		1,
+		[]string{"metrics.accuracy DESC"},
+		"",
+	)
+	require.Nil(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, runIDs[2], runs[0].Info.RunID)
+	require.NotEmpty(t, token)
+
+	runs, _, err = store.SearchRuns(
+		ctx,
+		[]string{experimentID},
+		"params.even = 'yes'",
+		protos.ViewType_ACTIVE_ONLY,
+		1,
+		[]string{"metrics.accuracy DESC"},
+		token,
+	)
+	require.Nil(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, runIDs[0], runs[0].Info.RunID)
+
+	// The params named like the run_name attribute are params.
+	runName := "name"
+	require.Nil(t, store.LogBatch(ctx, runIDs[0], nil, []*entities.Param{{Key: "run_name", Value: &runName}}, nil))
+
+	searchRunIDs := func(filter string) []string {
+		t.Helper()
+
+		runs, _, err := store.SearchRuns(ctx, []string{experimentID}, filter, protos.ViewType_ALL, 10, nil, "")
+		require.Nil(t, err, filter)
+
+		ids := make([]string, 0, len(runs))
+		for _, run := range runs {
+			ids = append(ids, run.Info.RunID)
+		}
+
+		return ids
+	}
+
+	assert.ElementsMatch(t, runIDs[1:], searchRunIDs("params.run_name IS NULL"))
+	assert.ElementsMatch(t, runIDs, searchRunIDs("params.run_name IS NULL OR metrics.accuracy < 1"))
+	assert.ElementsMatch(t, runIDs[:1], searchRunIDs("params.run_name IS NOT NULL"))
+	assert.ElementsMatch(t, runIDs, searchRunIDs("metrics.run_name IS NULL OR params.run_name = 'name'"))
+
+	require.Nil(t, store.DeleteRun(ctx, runIDs[1]))
+
+	runs, _, err = store.SearchRuns(
+		ctx, []string{experimentID}, "metrics.accuracy >= 1", protos.ViewType_ALL, 10, nil, "",
+	)
+	require.Nil(t, err)
+	assert.Len(t, runs, 2)
+
+	runs, _, err = store.SearchRuns(
+		ctx, []string{experimentID}, "", protos.ViewType_DELETED_ONLY, 10, nil, "",
+	)
+	require.Nil(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, runIDs[1], runs[0].Info.RunID)
+}
+
+// testInvalidSearchFilters checks that the clients are told why their filter is invalid.
+func testInvalidSearchFilters(t *testing.T, store store.TrackingStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	experimentID := createExperiment(t, store)
+
+	requireFilterError := func(err *contract.Error, message string) {
+		t.Helper()
+
+		requireErrorCode(t, err, protos.ErrorCode_INVALID_PARAMETER_VALUE)
+		assert.Contains(t, err.Message, message)
+	}
+
+	_, _, err := store.SearchRuns(
+		ctx, []string{experimentID}, "attributes.foo = 1", protos.ViewType_ALL, 10, nil, "",
+	)
+	requireFilterError(err, "Invalid attribute key '{foo}' specified")
+
+	_, _, err = store.SearchRuns(
+		ctx, []string{experimentID}, "metrics.accuracy = 'high'", protos.ViewType_ALL, 10, nil, "",
+	)
+	requireFilterError(err, "expected numeric value type for metric")
+
+	_, _, err = store.SearchRuns(
+		ctx, []string{experimentID}, "metrics.accuracy 1", protos.ViewType_ALL, 10, nil, "",
+	)
+	requireFilterError(err, "metrics.accuracy 1\n")
+
+	_, _, err = store.SearchExperiments(ctx, protos.ViewType_ALL, 10, "foo = 'bar'", nil, "")
+	requireFilterError(err, "Invalid attribute key 'foo' specified for experiments")
+
+	_, _, err = store.SearchExperiments(ctx, protos.ViewType_ALL, 10, "tags.team > 'ml'", nil, "")
+	requireFilterError(err, "only the =, !=, LIKE and ILIKE comparators are supported for tag.team")
+
+	_, _, err = store.SearchTraces(ctx, []string{experimentID}, "metrics.foo = 1", 10, nil, "")
+	requireFilterError(err, "invalid identifier \"metrics\"")
+}
+
+func testSearchRunsLastPageToken(t *testing.T, store store.TrackingStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	experimentID := createExperiment(t, store)
+	createRun(t, store, experimentID)
+
+	// Like in MLflow, a full page has a next page token, even if it's the last one.
+	runs, token, err := store.SearchRuns(ctx, []string{experimentID}, "", protos.ViewType_ALL, 1, nil, "")
+	require.Nil(t, err)
+	require.Len(t, runs, 1)
+	require.NotEmpty(t, token)
+
+	runs, token, err = store.SearchRuns(ctx, []string{experimentID}, "", protos.ViewType_ALL, 1, nil, token)
+	require.Nil(t, err)
+	assert.Empty(t, runs)
+	assert.Empty(t, token)
+}
+
+func testInputs(t *testing.T, store store.TrackingStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	run := createRun(t, store, createExperiment(t, store))
+	dataset := &entities.Dataset{
+		Name:       "dataset",
+		Digest:     "digest",
+		SourceType: "local",
+		Source:     "{}",
+	}
+
+	require.Nil(t, store.LogInputs(ctx, run.Info.RunID, []*entities.DatasetInput{{
+		Tags:    []*entities.InputTag{{Key: "mlflow.data.context", Value: "training"}},
+		Dataset: dataset,
+	}}))
+
+	// Logging a dataset input again doesn't update its tags.
+	require.Nil(t, store.LogInputs(ctx, run.Info.RunID, []*entities.DatasetInput{{
+		Tags:    []*entities.InputTag{{Key: "mlflow.data.context", Value: "evaluation"}},
+		Dataset: dataset,
+	}}))
+
+	run, err := store.GetRun(ctx, run.Info.RunID)
+	require.Nil(t, err)
+	require.Len(t, run.Inputs.DatasetInputs, 1)
+	assert.Equal(t, "dataset", run.Inputs.DatasetInputs[0].Dataset.Name)
+	assert.Equal(t, "digest", run.Inputs.DatasetInputs[0].Dataset.Digest)
+	assert.Equal(
+		t,
+		[]*entities.InputTag{{Key: "mlflow.data.context", Value: "training"}},
+		run.Inputs.DatasetInputs[0].Tags,
+	)
+}
+
+//nolint:funlen
+func testTraces(t *testing.T, store store.TrackingStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	experimentID := createExperiment(t, store)
+	requestIDs := make([]string, 0, 2)
+
+	for index := range 2 {
+		traceInfo, err := store.SetTrace(
+			ctx,
+			experimentID,
+			int64(1000*(index+1)),
+			[]*entities.TraceRequestMetadata{{Key: "index", Value: strconv.Itoa(index)}},
+			[]*entities.TraceTag{{Key: "team", Value: "a"}},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, protos.TraceStatus_IN_PROGRESS.String(), traceInfo.Status)
+
+		requestIDs = append(requestIDs, traceInfo.RequestID)
+	}
+
+	traceInfo, err := store.EndTrace(ctx, requestIDs[0], 1500, protos.TraceStatus_OK.String(), nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, protos.TraceStatus_OK.String(), traceInfo.Status)
+	assert.Equal(t, utils.PtrTo(int64(500)), traceInfo.ExecutionTimeMS)
+
+	require.NoError(t, store.SetTraceTag(ctx, requestIDs[0], "team", "b"))
+
+	tag, contractError := store.GetTraceTag(ctx, requestIDs[0], "team")
+	require.Nil(t, contractError)
+	assert.Equal(t, "b", tag.Value)
+
+	require.Nil(t, store.DeleteTraceTag(ctx, tag))
+
+	_, contractError = store.GetTraceTag(ctx, requestIDs[0], "team")
+	requireErrorCode(t, contractError, protos.ErrorCode_RESOURCE_DOES_NOT_EXIST)
+
+	traces, _, contractError := store.SearchTraces(
+		ctx, []string{experimentID}, "tags.team = 'a'", 10, []string{"timestamp_ms DESC"}, "",
+	)
+	require.Nil(t, contractError)
+	require.Len(t, traces, 1)
+	assert.Equal(t, requestIDs[1], traces[0].RequestID)
+
+	traces, _, contractError = store.SearchTraces(ctx, []string{experimentID}, "", 10, []string{"timestamp_ms"}, "")
+	require.Nil(t, contractError)
+	require.Len(t, traces, 2)
+	assert.Equal(t, requestIDs[0], traces[0].RequestID)
+
+	deleted, contractError := store.DeleteTraces(ctx, experimentID, 1500, 0, nil)
+	require.Nil(t, contractError)
+	assert.Equal(t, int32(1), deleted)
+
+	_, contractError = store.GetTraceInfo(ctx, requestIDs[0])
+	requireErrorCode(t, contractError, protos.ErrorCode_RESOURCE_DOES_NOT_EXIST)
+
+	traceInfo, contractError = store.GetTraceInfo(ctx, requestIDs[1])
+	require.Nil(t, contractError)
+	assert.Equal(t, []*entities.TraceRequestMetadata{
+		{Key: "index", Value: "1", RequestID: requestIDs[1]},
+	}, traceInfo.TraceRequestMetadata)
+}
+
+func testConcurrentLogging(t *testing.T, store store.TrackingStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	run := createRun(t, store, createExperiment(t, store))
+
+	const goroutines = 8
+
+	errs := make(chan *contract.Error, goroutines)
+
+	for index := range goroutines {
+		go func() {
+			errs <- store.LogMetric(ctx, run.Info.RunID, &entities.Metric{
+				Key: "loss", Value: float64(index), Timestamp: 1, Step: int64(index),
+			})
+		}()
+	}
+
+	for range goroutines {
+		require.Nil(t, <-errs)
+	}
+
+	history, _, err := store.GetMetricHistory(ctx, run.Info.RunID, "loss", "", 0)
+	require.Nil(t, err)
+	assert.Len(t, history, goroutines)
+}
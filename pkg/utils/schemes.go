@@ -0,0 +1,116 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// SchemeRegistry maps the URI schemes of stores, such as "sqlite" or "file", to the factories
+// creating them. Factories are usually registered by the packages of the stores, when imported.
+type SchemeRegistry[F any] struct {
+	name      string
+	mutex     sync.RWMutex
+	factories map[string]F
+}
+
+// NewSchemeRegistry returns an empty registry, name being what it holds in error messages.
+func NewSchemeRegistry[F any](name string) *SchemeRegistry[F] {
+	return &SchemeRegistry[F]{
+		name:      name,
+		mutex:     sync.RWMutex{},
+		factories: make(map[string]F),
+	}
+}
+
+// Register makes factory the one of scheme. Like database/sql drivers,
+// it panics if scheme already has a factory, as it's a programming error.
+func (r *SchemeRegistry[F]) Register(scheme string, factory F) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	scheme = strings.ToLower(scheme)
+	if _, ok := r.factories[scheme]; ok {
+		panic(fmt.Sprintf("%s already registered for scheme %q", r.name, scheme))
+	}
+
+	r.factories[scheme] = factory
+}
+
+// Lookup returns the factory of the scheme of uri.
+func (r *SchemeRegistry[F]) Lookup(uri string) (F, error) {
+	var factory F
+
+	scheme, err := URIScheme(uri)
+	if err != nil {
+		return factory, err
+	}
+
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	factory, ok := r.factories[scheme]
+	if !ok {
+		return factory, fmt.Errorf( //nolint:err113
+			"unsupported %s URI scheme %q, registered schemes are %s",
+			r.name, scheme, strings.Join(r.schemes(), ", "),
+		)
+	}
+
+	return factory, nil
+}
+
+// Supports returns whether the scheme of uri has a factory.
+func (r *SchemeRegistry[F]) Supports(uri string) bool {
+	scheme, err := URIScheme(uri)
+	if err != nil {
+		return false
+	}
+
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	_, ok := r.factories[scheme]
+
+	return ok
+}
+
+// Schemes returns the sorted schemes having a factory.
+func (r *SchemeRegistry[F]) Schemes() []string {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return r.schemes()
+}
+
+func (r *SchemeRegistry[F]) schemes() []string {
+	schemes := make([]string, 0, len(r.factories))
+	for scheme := range r.factories {
+		schemes = append(schemes, scheme)
+	}
+
+	slices.Sort(schemes)
+
+	return schemes
+}
+
+// URIScheme returns the scheme of a store URI, without its driver: "postgresql+psycopg2" is "postgresql".
+// Like in MLflow, plain paths are file URIs.
+func URIScheme(uri string) (string, error) {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse store URI %q: %w", uri, err)
+	}
+
+	scheme, _, _ := strings.Cut(strings.ToLower(parsed.Scheme), "+")
+
+	// Windows paths like C:\mlruns are parsed with the drive as scheme.
+	if scheme == "" || (runtime.GOOS == "windows" && len(scheme) == 1) {
+		return "file", nil
+	}
+
+	return scheme, nil
+}